@@ -1,65 +1,126 @@
 package main
 
 import (
-	"bytes"
 	"context"
-	"net/url"
+	"database/sql"
 	"os"
-	"os/exec"
 	"strconv"
-	"time"
+	"strings"
 
+	"github.com/ernado/tentacle/internal/bot"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/media"
+	"github.com/ernado/tentacle/internal/queue"
 	"github.com/ernado/tentacle/internal/tgpool"
-	"github.com/ernado/tentacle/internal/ytdlp"
-	"github.com/ernado/tentacle/internal/ytio"
 
-	"github.com/ernado/ff/ffprobe"
-	"github.com/ernado/ff/ffrun"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/go-faster/errors"
 	"github.com/go-faster/sdk/app"
 	"github.com/gotd/contrib/middleware/floodwait"
 	"github.com/gotd/td/session"
 	"github.com/gotd/td/telegram"
-	"github.com/gotd/td/telegram/message"
-	"github.com/gotd/td/telegram/uploader"
 	"github.com/gotd/td/tg"
+	_ "github.com/jackc/pgx/v5/stdlib"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
-func createTempFile(pattern string) (string, error) {
-	tempFile, err := os.CreateTemp("", "tentacle-"+pattern)
-	if err != nil {
-		return "", errors.Wrap(err, "create temp file")
-	}
-	tempPath := tempFile.Name()
-	if err := tempFile.Close(); err != nil {
-		return "", errors.Wrap(err, "close temp file")
-	}
-
-	return tempPath, nil
-}
-
 const (
 	EnvBotToken        = "BOT_TOKEN"
 	EnvApplicationID   = "APP_ID"
 	EnvApplicationHash = "APP_HASH"
+
+	EnvDatabaseURL = "DATABASE_URL"
+	EnvWorkDir     = "WORK_DIR"
+
+	EnvQueueWorkers = "QUEUE_WORKERS"
+	EnvQueuePerUser = "QUEUE_PER_USER"
+	EnvQueuePerChat = "QUEUE_PER_CHAT"
+
+	EnvFFmpegConcurrency = "FFMPEG_CONCURRENCY"
+
+	// EnvAdmins is comma-separated list of user ids of bot admins.
+	EnvAdmins = "ADMINS"
+	// EnvPublic allows everyone without grant to use bot with limits of
+	// user role, if set to true. By default only granted users and chats
+	// can use bot.
+	EnvPublic = "PUBLIC"
+	// EnvAdminChat is bot API id of chat for failure reports, like
+	// -1001234567890 for supergroup.
+	EnvAdminChat = "ADMIN_CHAT"
 )
 
-var _ uploader.Progress = (*ZapProgressHandler)(nil)
+// envInt parses integer environment variable, returning def if it is not set.
+func envInt(name string, def int) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse %s", name)
+	}
+	return n, nil
+}
 
-type ZapProgressHandler struct {
-	Logger *zap.Logger
+// envIDs parses comma-separated list of ids from environment variable.
+func envIDs(name string) ([]int64, error) {
+	var ids []int64
+	for _, v := range strings.Split(os.Getenv(name), ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// envBool parses boolean environment variable, returning false if it is
+// not set.
+func envBool(name string) (bool, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, errors.Wrapf(err, "parse %s", name)
+	}
+	return b, nil
 }
 
-func (z ZapProgressHandler) Chunk(_ context.Context, state uploader.ProgressState) error {
-	z.Logger.Info("Upload progress",
-		zap.Int64("id", state.ID),
-		zap.String("name", state.Name),
-		zap.Int64("total", state.Total),
-		zap.Int("part", state.Part),
-	)
-	return nil
+// envID parses id from environment variable, returning zero if it is not
+// set.
+func envID(name string) (int64, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse %s", name)
+	}
+	return id, nil
+}
+
+// openDB opens database and applies schema migrations.
+func openDB(ctx context.Context, dsn string) (*ent.Client, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, errors.Wrap(err, "open")
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
+	if err := client.Schema.Create(ctx); err != nil {
+		_ = client.Close()
+		return nil, errors.Wrap(err, "migrate")
+	}
+	return client, nil
 }
 
 func main() {
@@ -77,6 +138,47 @@ func main() {
 			return errors.New("APP_HASH is empty")
 		}
 
+		dsn := os.Getenv(EnvDatabaseURL)
+		if dsn == "" {
+			return errors.New("DATABASE_URL is empty")
+		}
+		db, err := openDB(ctx, dsn)
+		if err != nil {
+			return errors.Wrap(err, "open database")
+		}
+		defer func() { _ = db.Close() }()
+
+		var queueOptions queue.Options
+		if queueOptions.Workers, err = envInt(EnvQueueWorkers, 2); err != nil {
+			return err
+		}
+		if queueOptions.PerUser, err = envInt(EnvQueuePerUser, 1); err != nil {
+			return err
+		}
+		if queueOptions.PerChat, err = envInt(EnvQueuePerChat, 0); err != nil {
+			return err
+		}
+		jobs := queue.New(queueOptions)
+
+		var mediaOptions media.Options
+		if mediaOptions.Concurrency, err = envInt(EnvFFmpegConcurrency, 0); err != nil {
+			return err
+		}
+		pipeline := media.New(mediaOptions)
+
+		admins, err := envIDs(EnvAdmins)
+		if err != nil {
+			return err
+		}
+		public, err := envBool(EnvPublic)
+		if err != nil {
+			return err
+		}
+		adminChat, err := envID(EnvAdminChat)
+		if err != nil {
+			return err
+		}
+
 		dispatcher := tg.NewUpdateDispatcher()
 
 		proxyURL := os.Getenv("PROXY_URL")
@@ -129,229 +231,40 @@ func main() {
 			Logger:        logger.Named("gotd"),
 		}
 		g.Go(func() error {
+			var b *bot.Bot
 			if err := telegram.BotFromEnvironment(ctx, opt, func(ctx context.Context, client *telegram.Client) error {
-				var (
-					api       = tg.NewClient(client)
-					pooledAPI = tg.NewClient(pool)
-					sender    = message.NewSender(api)
-					i         = ffrun.New(ffrun.Options{})
-				)
-				dispatcher.OnNewMessage(func(ctx context.Context, e tg.Entities, u *tg.UpdateNewMessage) error {
-					ctx, cancel := context.WithTimeout(ctx, time.Minute*30)
-					defer cancel()
-
-					m, ok := u.Message.(*tg.Message)
-					if !ok || m.Out {
-						return nil
-					}
-
-					var (
-						reply  = sender.Reply(e, u)
-						lg     = logger.With(zap.Int("msg_id", m.ID))
-						answer = sender.Answer(e, u)
-						up     = uploader.NewUploader(pooledAPI).
-							WithPartSize(uploader.MaximumPartSize).
-							WithThreads(poolSize).
-							WithProgress(ZapProgressHandler{Logger: lg.Named("uploader")})
-					)
-
-					uri, err := url.Parse(m.Message)
-					if err != nil {
-						return errors.Wrap(err, "parse url")
-					}
+				b = bot.New(bot.Options{
+					API:           client,
+					UploadAPI:     pool,
+					UploadThreads: poolSize,
+					Proxy:         proxyURL,
+					CookiesFile:   cookies,
+					DB:            db,
+					WorkDir:       os.Getenv(EnvWorkDir),
+					Queue:         jobs,
+					Media:         pipeline,
+					Logger:        logger,
+					Admins:        admins,
+					Public:        public,
+					AdminChat:     adminChat,
 
-					httpClient, err := ytdlp.NewHTTPClientWithProxy(proxyURL)
-					if err != nil {
-						return errors.Wrap(err, "create http client")
-					}
-
-					instance := &ytdlp.Instance{
-						CookiesFilePath: cookies,
-						Proxy:           proxyURL,
-					}
-					start := time.Now()
-
-					if _, err := answer.Textf(ctx, "Getting info..."); err != nil {
-						return errors.Wrap(err, "send answer")
-					}
-
-					video, err := instance.Video(ctx, uri.String())
-					if err != nil {
-						return errors.Wrap(err, "fetch video info")
-					}
-
-					duration := time.Since(start)
-					lg.Info("Done",
-						zap.Duration("duration", duration),
-						zap.String("title", video.Title),
-					)
-
-					for _, f := range ytdlp.SelectFormats(video.Formats) {
-						lg.Info("Format",
-							zap.String("id", f.FormatID),
-							zap.String("ext", f.Ext),
-							zap.String("acodec", f.ACodec),
-							zap.String("vcodec", f.VCodec),
-							zap.String("resolution", f.Resolution),
-						)
-					}
-
-					bestVideo := ytdlp.BestVideo(video.Formats)
-					videoPath, err := createTempFile("video-*.mp4")
-					if err != nil {
-						return errors.Wrap(err, "create video temp file")
-					}
-					videoFile := &ytio.File{
-						Path: videoPath,
-					}
-					defer func() { _ = os.Remove(videoPath) }()
-
-					bestAudio := ytdlp.BestAudio(video.Formats)
-					audioPath, err := createTempFile("audio-*.m4a")
-					if err != nil {
-						return errors.Wrap(err, "create audio temp file")
-					}
-					audioFile := &ytio.File{
-						Path: audioPath,
-					}
-					defer func() { _ = os.Remove(audioPath) }()
-
-					if _, err := answer.Text(ctx, "Downloading..."); err != nil {
-						return errors.Wrap(err, "send answer")
-					}
-
-					g, gCtx := errgroup.WithContext(ctx)
-					g.Go(func() error {
-						if err := ytdlp.DownloadChunked(gCtx, bestVideo, videoFile, httpClient); err != nil {
-							lg.Error("Video download error", zap.Error(err))
-							return errors.Wrap(err, "download video")
-						}
-
-						return nil
-					})
-					g.Go(func() error {
-						if err := ytdlp.DownloadChunked(gCtx, bestAudio, audioFile, httpClient); err != nil {
-							lg.Error("Audio download error", zap.Error(err))
-							return errors.Wrap(err, "download audio")
-						}
-
-						return nil
-					})
-
-					if err := g.Wait(); err != nil {
-						return errors.Wrap(err, "download")
-					}
-
-					if _, err := answer.Textf(ctx, "Uploading..."); err != nil {
-						return errors.Wrap(err, "send answer")
-					}
-
-					outputPath, err := createTempFile("output-*.mp4")
-					if err != nil {
-						return errors.Wrap(err, "create output temp file")
-					}
-
-					// TODO: Use ff.
-					ffmpegErrorStream := new(bytes.Buffer)
-					ffmpegCommand := exec.CommandContext(ctx,
-						"ffmpeg",
-						"-i", videoFile.Path,
-						"-i", audioFile.Path,
-						"-c:v", "copy",
-						"-c:a", "copy",
-						"-f", "mp4",
-						"-movflags", "faststart",
-						"-y",
-						"-hide_banner",
-						outputPath,
-					)
-					ffmpegCommand.Env = []string{}
-					ffmpegCommand.Stdout = os.Stdout // TODO: drop
-					ffmpegCommand.Stderr = ffmpegErrorStream
-					lg.Info("Running",
-						zap.String("ffmpegCommand", ffmpegCommand.String()),
-					)
-
-					if err := ffmpegCommand.Run(); err != nil {
-						return errors.Wrapf(err, "ffmpeg: %s", ffmpegErrorStream.String())
-					}
-
-					// Pick first frame of video as thumbnail.
-					previewPath, err := createTempFile("preview-*.jpg")
-					if err != nil {
-						return errors.Wrap(err, "create preview temp file")
-					}
-
-					if err := i.Run(ctx, ffrun.RunOptions{
-						Input:  outputPath,
-						Output: previewPath,
-						Args: []string{
-							"-frames:v", "1",
-						},
-					}); err != nil {
-						return errors.Wrap(err, "preview")
-					}
-
-					summary, err := i.Probe(ctx, outputPath)
-					if err != nil {
-						return errors.Wrap(err, "probe for output")
-					}
-					parsedSummary, err := ffprobe.ParseSummary(summary)
-					if err != nil {
-						return errors.Wrap(err, "parse summary for output")
-					}
-
-					thumbnail, err := up.FromPath(ctx, previewPath)
-					if err != nil {
-						return errors.Wrap(err, "upload")
-					}
-
-					lg.Info("Got summary",
-						zap.Duration("duration", parsedSummary.Duration),
-						zap.Int("width", parsedSummary.Width),
-						zap.Int("height", parsedSummary.Height),
-					)
-
-					outputFile, err := os.Open(outputPath)
-					if err != nil {
-						return errors.Wrap(err, "open output")
-					}
-					defer func() { _ = outputFile.Close() }()
-
-					stat, err := outputFile.Stat()
-					if err != nil {
-						return errors.Wrap(err, "stat output")
-					}
-
-					inputClass, err := up.
-						Upload(ctx, uploader.NewUpload("output.mp4", outputFile, stat.Size()))
-					if err != nil {
-						return errors.Wrap(err, "upload")
-					}
-
-					if err != nil {
-						return errors.Wrap(err, "upload")
-					}
-					lg.Info("Uploaded")
-
-					uploadedDocument := message.UploadedDocument(inputClass).
-						Filename("output.mp4").
-						MIME("video/mp4").
-						Thumb(thumbnail).
-						Video().
-						Duration(parsedSummary.Duration).
-						Resolution(parsedSummary.Width, parsedSummary.Height).
-						SupportsStreaming()
-
-					if _, err := reply.Media(ctx, uploadedDocument); err != nil {
-						return err
-					}
-
-					return nil
+					TracerProvider: t.TracerProvider(),
 				})
+				b.Register(dispatcher)
 
 				return nil
-			}, telegram.RunUntilCanceled); err != nil {
+			}, func(ctx context.Context, client *telegram.Client) error {
+				if err := b.SeedRoles(ctx); err != nil {
+					return errors.Wrap(err, "seed roles")
+				}
+				if err := b.SetCommands(ctx); err != nil {
+					return errors.Wrap(err, "set commands")
+				}
+				if err := b.Resume(ctx); err != nil {
+					return errors.Wrap(err, "resume jobs")
+				}
+				return telegram.RunUntilCanceled(ctx, client)
+			}); err != nil {
 				return errors.Wrap(err, "run bot")
 			}
 
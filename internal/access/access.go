@@ -0,0 +1,190 @@
+// Package access defines roles of bot users and limits of their requests.
+package access
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+)
+
+// Features that can be allowed by role.
+const (
+	FeatureVideo     = "video"
+	FeatureAudio     = "audio"
+	FeatureClip      = "clip"
+	FeatureAnimation = "gif"
+	FeatureNote      = "note"
+	FeatureSticker   = "sticker"
+)
+
+// Features are every feature.
+var Features = []string{
+	FeatureVideo,
+	FeatureAudio,
+	FeatureClip,
+	FeatureAnimation,
+	FeatureNote,
+	FeatureSticker,
+}
+
+// Built-in roles.
+const (
+	// RoleUser is default role of allowed users and chats.
+	RoleUser = "user"
+	// RoleAdmin manages access.
+	RoleAdmin = "admin"
+)
+
+// Limits of requests of role.
+type Limits struct {
+	// MaxDuration of requested media, zero if unlimited.
+	MaxDuration time.Duration `json:"max_duration,omitempty"`
+	// MaxSize of requested media in bytes, zero if unlimited.
+	MaxSize int64 `json:"max_size,omitempty"`
+	// JobsPerHour limits count of jobs in JobsWindow, zero if unlimited.
+	JobsPerHour int `json:"jobs_per_hour,omitempty"`
+	// BytesPerDay limits bytes downloaded by jobs in BytesWindow, zero if
+	// unlimited.
+	BytesPerDay int64 `json:"bytes_per_day,omitempty"`
+	// Features that are allowed, every feature if empty.
+	Features []string `json:"features,omitempty"`
+}
+
+// Exceeded is error of limit that request exceeds.
+type Exceeded struct {
+	// Key is catalog key of message that describes limit.
+	Key string
+	// Args of message.
+	Args []any
+}
+
+// Error returns message in default language.
+func (e *Exceeded) Error() string {
+	return e.Text(i18n.Lookup(i18n.Default))
+}
+
+// Text returns message in language of locale.
+func (e *Exceeded) Text(loc *i18n.Locale) string {
+	return loc.T(e.Key, e.Args...)
+}
+
+// Allows reports whether feature is allowed.
+func (l Limits) Allows(feature string) bool {
+	return len(l.Features) == 0 || slices.Contains(l.Features, feature)
+}
+
+// Check returns error that describes exceeded limit of request of feature
+// with duration and size, where zero duration or size is unknown.
+func (l Limits) Check(feature string, duration time.Duration, size int64) error {
+	if !l.Allows(feature) {
+		return &Exceeded{Key: "limit.feature", Args: []any{feature}}
+	}
+	if l.MaxDuration > 0 && duration > l.MaxDuration {
+		return &Exceeded{Key: "limit.duration", Args: []any{
+			duration.Round(time.Second), l.MaxDuration,
+		}}
+	}
+	if l.MaxSize > 0 && size > l.MaxSize {
+		return &Exceeded{Key: "limit.size", Args: []any{
+			humanize.Bytes(uint64(size)), humanize.Bytes(uint64(l.MaxSize)),
+		}}
+	}
+	return nil
+}
+
+// Format returns description of limits in language of locale.
+func (l Limits) Format(loc *i18n.Locale) string {
+	var parts []string
+	if l.MaxDuration > 0 {
+		parts = append(parts, loc.T("limits.duration", l.MaxDuration))
+	}
+	if l.MaxSize > 0 {
+		parts = append(parts, loc.T("limits.size", humanize.Bytes(uint64(l.MaxSize))))
+	}
+	if l.JobsPerHour > 0 {
+		parts = append(parts, loc.T("limits.jobs", l.JobsPerHour))
+	}
+	if l.BytesPerDay > 0 {
+		parts = append(parts, loc.T("limits.traffic", humanize.Bytes(uint64(l.BytesPerDay))))
+	}
+	if len(l.Features) > 0 {
+		parts = append(parts, loc.T("limits.features", strings.Join(l.Features, ",")))
+	}
+	if len(parts) == 0 {
+		return loc.T("limits.none")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ParseLimits applies "duration=30m", "size=500MB", "jobs=10", "traffic=2GB"
+// and "features=video,clip" arguments to base limits, where zero value and
+// "features=all" remove the limit.
+func ParseLimits(base Limits, args []string) (Limits, error) {
+	l := base
+	for _, arg := range args {
+		k, v, ok := strings.Cut(arg, "=")
+		if !ok {
+			return Limits{}, errors.Errorf("bad argument %q", arg)
+		}
+		switch k {
+		case "duration":
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return Limits{}, errors.Wrap(err, "parse duration")
+			}
+			l.MaxDuration = d
+		case "size":
+			size, err := humanize.ParseBytes(v)
+			if err != nil {
+				return Limits{}, errors.Wrap(err, "parse size")
+			}
+			l.MaxSize = int64(size)
+		case "jobs":
+			jobs, err := strconv.Atoi(v)
+			if err != nil || jobs < 0 {
+				return Limits{}, errors.Errorf("bad jobs count %q", v)
+			}
+			l.JobsPerHour = jobs
+		case "traffic":
+			size, err := humanize.ParseBytes(v)
+			if err != nil {
+				return Limits{}, errors.Wrap(err, "parse traffic")
+			}
+			l.BytesPerDay = int64(size)
+		case "features":
+			l.Features = nil
+			if v == "all" {
+				continue
+			}
+			for _, f := range strings.Split(v, ",") {
+				if !slices.Contains(Features, f) {
+					return Limits{}, errors.Errorf("unknown feature %q", f)
+				}
+				l.Features = append(l.Features, f)
+			}
+		default:
+			return Limits{}, errors.Errorf("unknown limit %q", k)
+		}
+	}
+	return l, nil
+}
+
+// codeEncoding is encoding of invite codes, that are easy to type.
+var codeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewCode returns random invite code.
+func NewCode() (string, error) {
+	buf := make([]byte, 10)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Wrap(err, "read random")
+	}
+	return strings.ToLower(codeEncoding.EncodeToString(buf)), nil
+}
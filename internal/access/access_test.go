@@ -0,0 +1,64 @@
+package access
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimits(t *testing.T) {
+	l, err := ParseLimits(Limits{MaxSize: 1 << 20}, []string{
+		"duration=30m",
+		"jobs=10",
+		"traffic=2GB",
+		"features=video,clip",
+	})
+	require.NoError(t, err)
+	require.Equal(t, Limits{
+		MaxDuration: time.Minute * 30,
+		MaxSize:     1 << 20,
+		JobsPerHour: 10,
+		BytesPerDay: 2_000_000_000,
+		Features:    []string{FeatureVideo, FeatureClip},
+	}, l)
+	require.Equal(t, "duration ≤ 30m0s, size ≤ 1.0 MB, jobs ≤ 10/h, traffic ≤ 2.0 GB/day, features: video,clip",
+		l.Format(i18n.Lookup("en")))
+	require.Equal(t, "длительность ≤ 30m0s, размер ≤ 1.0 MB, задач ≤ 10/ч, трафик ≤ 2.0 GB/сутки, функции: video,clip",
+		l.Format(i18n.Lookup("ru")))
+
+	require.NoError(t, l.Check(FeatureClip, time.Minute, 1000))
+	require.NoError(t, l.Check(FeatureVideo, 0, 0))
+	require.EqualError(t, l.Check(FeatureAudio, time.Minute, 1000), "audio is not allowed.")
+	require.EqualError(t, l.Check(FeatureVideo, time.Hour, 0), "Duration 1h0m0s exceeds limit of 30m0s.")
+	require.EqualError(t, l.Check(FeatureVideo, 0, 2<<20), "Size 2.1 MB exceeds limit of 1.0 MB.")
+
+	l, err = ParseLimits(l, []string{"duration=0", "size=0", "jobs=0", "traffic=0", "features=all"})
+	require.NoError(t, err)
+	require.Equal(t, Limits{}, l)
+	require.Equal(t, "no limits", l.Format(i18n.Lookup("en")))
+
+	for _, args := range [][]string{
+		{"duration"},
+		{"duration=long"},
+		{"size=big"},
+		{"jobs=-1"},
+		{"traffic=lots"},
+		{"features=video,teleport"},
+		{"speed=1"},
+	} {
+		_, err := ParseLimits(Limits{}, args)
+		require.Error(t, err, args)
+	}
+}
+
+func TestNewCode(t *testing.T) {
+	a, err := NewCode()
+	require.NoError(t, err)
+	b, err := NewCode()
+	require.NoError(t, err)
+	require.Len(t, a, 16)
+	require.NotEqual(t, a, b)
+}
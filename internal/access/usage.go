@@ -0,0 +1,97 @@
+package access
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/dustin/go-humanize"
+)
+
+// Windows of quotas.
+const (
+	// JobsWindow is window of Limits.JobsPerHour.
+	JobsWindow = time.Hour
+	// BytesWindow is window of Limits.BytesPerDay.
+	BytesWindow = time.Hour * 24
+)
+
+// Record is usage by single job.
+type Record struct {
+	At    time.Time
+	Bytes int64
+}
+
+// Usage of user or chat in quota windows.
+type Usage struct {
+	// Jobs in JobsWindow.
+	Jobs int
+	// JobsFreeAt is time when oldest of jobs leaves window.
+	JobsFreeAt time.Time
+	// Bytes in BytesWindow.
+	Bytes int64
+	// BytesFreeAt is time when oldest of bytes leave window.
+	BytesFreeAt time.Time
+}
+
+// UsageOf returns usage of records at now.
+func UsageOf(records []Record, now time.Time) Usage {
+	var u Usage
+	for _, r := range records {
+		if r.At.After(now.Add(-JobsWindow)) {
+			u.Jobs++
+			if free := r.At.Add(JobsWindow); u.JobsFreeAt.IsZero() || free.Before(u.JobsFreeAt) {
+				u.JobsFreeAt = free
+			}
+		}
+		if r.At.After(now.Add(-BytesWindow)) && r.Bytes > 0 {
+			u.Bytes += r.Bytes
+			if free := r.At.Add(BytesWindow); u.BytesFreeAt.IsZero() || free.Before(u.BytesFreeAt) {
+				u.BytesFreeAt = free
+			}
+		}
+	}
+	return u
+}
+
+// CheckUsage returns error that describes exhausted quota of limits, so
+// new job can't be started at now.
+func (l Limits) CheckUsage(u Usage, now time.Time) error {
+	if l.JobsPerHour > 0 && u.Jobs >= l.JobsPerHour {
+		return &Exceeded{Key: "limit.jobs", Args: []any{
+			l.JobsPerHour, waitFor(u.JobsFreeAt, now),
+		}}
+	}
+	if l.BytesPerDay > 0 && u.Bytes >= l.BytesPerDay {
+		return &Exceeded{Key: "limit.traffic", Args: []any{
+			humanize.Bytes(uint64(l.BytesPerDay)), waitFor(u.BytesFreeAt, now),
+		}}
+	}
+	return nil
+}
+
+// waitFor returns rounded duration till t.
+func waitFor(t, now time.Time) time.Duration {
+	d := t.Sub(now)
+	if d < time.Minute {
+		return time.Minute
+	}
+	return d.Round(time.Minute)
+}
+
+// Format returns human-readable usage in relation to limits in language of
+// locale.
+func (u Usage) Format(loc *i18n.Locale, l Limits) string {
+	var s strings.Builder
+	s.WriteString(loc.T("usage.jobs", u.Jobs))
+	if l.JobsPerHour > 0 {
+		s.WriteString(loc.T("usage.of", l.JobsPerHour))
+	}
+	s.WriteString("\n")
+	s.WriteString(loc.T("usage.traffic", humanize.Bytes(uint64(u.Bytes))))
+	if l.BytesPerDay > 0 {
+		s.WriteString(loc.T("usage.of", humanize.Bytes(uint64(l.BytesPerDay))))
+	}
+	return s.String()
+}
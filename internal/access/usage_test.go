@@ -0,0 +1,38 @@
+package access
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsage(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	u := UsageOf([]Record{
+		{At: now.Add(-time.Minute * 50), Bytes: 100},
+		{At: now.Add(-time.Minute * 10), Bytes: 200},
+		{At: now.Add(-time.Hour * 5), Bytes: 300},
+		{At: now.Add(-time.Hour * 30), Bytes: 400},
+	}, now)
+	require.Equal(t, Usage{
+		Jobs:        2,
+		JobsFreeAt:  now.Add(time.Minute * 10),
+		Bytes:       600,
+		BytesFreeAt: now.Add(time.Hour * 19),
+	}, u)
+
+	require.NoError(t, Limits{}.CheckUsage(u, now))
+	require.NoError(t, Limits{JobsPerHour: 3, BytesPerDay: 1000}.CheckUsage(u, now))
+	require.EqualError(t, Limits{JobsPerHour: 2}.CheckUsage(u, now),
+		"Limit of 2 jobs per hour is reached, try again in 10m0s.")
+	require.EqualError(t, Limits{BytesPerDay: 600}.CheckUsage(u, now),
+		"Limit of 600 B per day is reached, try again in 19h0m0s.")
+
+	require.Equal(t, "Jobs in last hour: 2 of 3\nTraffic in last day: 600 B",
+		u.Format(i18n.Lookup("en"), Limits{JobsPerHour: 3}))
+	require.Equal(t, "Задач за последний час: 2 из 3\nТрафик за последние сутки: 600 B",
+		u.Format(i18n.Lookup("ru"), Limits{JobsPerHour: 3}))
+}
@@ -0,0 +1,546 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ernado/tentacle/internal/access"
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/grant"
+	"github.com/ernado/tentacle/internal/ent/invite"
+	"github.com/ernado/tentacle/internal/ent/role"
+	"github.com/ernado/tentacle/internal/ent/schema"
+	"github.com/ernado/tentacle/internal/i18n"
+	"github.com/ernado/tentacle/internal/ytdlp"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+)
+
+// ownerRole is role of admins from options, that is not limited.
+var ownerRole = &ent.Role{Name: access.RoleAdmin, Admin: true}
+
+// SeedRoles creates built-in roles, keeping existing ones.
+func (b *Bot) SeedRoles(ctx context.Context) error {
+	switch {
+	case b.public:
+		b.lg.Warn("Bot is public, everyone without grant has user role")
+	case len(b.admins) == 0:
+		b.lg.Warn("No admins configured, only granted users and chats can use bot")
+	}
+	for _, r := range []struct {
+		Name  string
+		Admin bool
+	}{
+		{Name: access.RoleUser},
+		{Name: access.RoleAdmin, Admin: true},
+	} {
+		if err := b.db.Role.Create().
+			SetName(r.Name).
+			SetAdmin(r.Admin).
+			OnConflictColumns(role.FieldName).
+			Ignore().
+			Exec(ctx); err != nil {
+			return errors.Wrapf(err, "create role %s", r.Name)
+		}
+	}
+	return nil
+}
+
+// grantPeer returns peer type and id of grant subject.
+func grantPeer(p tg.PeerClass) (grant.PeerType, int64) {
+	switch p := p.(type) {
+	case *tg.PeerChat:
+		return grant.PeerTypeChat, p.ChatID
+	case *tg.PeerChannel:
+		return grant.PeerTypeChannel, p.ChannelID
+	case *tg.PeerUser:
+		return grant.PeerTypeUser, p.UserID
+	default:
+		return "", 0
+	}
+}
+
+// subjectPeer returns peer of grant subject.
+func subjectPeer(peerType grant.PeerType, peerID int64) tg.PeerClass {
+	switch peerType {
+	case grant.PeerTypeChat:
+		return &tg.PeerChat{ChatID: peerID}
+	case grant.PeerTypeChannel:
+		return &tg.PeerChannel{ChannelID: peerID}
+	default:
+		return &tg.PeerUser{UserID: peerID}
+	}
+}
+
+// roleOf returns role of author of message, or role of chat if author has
+// no grant, nil if neither is allowed. If bot is public, everyone else has
+// user role.
+func (b *Bot) roleOf(ctx context.Context, in command.Input) (*ent.Role, error) {
+	m := in.Message
+	if user := senderUser(m); user != 0 && slices.Contains(b.admins, user) {
+		return ownerRole, nil
+	}
+
+	subjects := []tg.PeerClass{m.PeerID}
+	if from, ok := m.GetFromID(); ok {
+		subjects = append([]tg.PeerClass{from}, subjects...)
+	}
+	for _, p := range subjects {
+		r, err := b.grantRole(ctx, p)
+		if err != nil || r != nil {
+			return r, err
+		}
+	}
+	return b.publicRole(ctx)
+}
+
+// isBotAdmin reports whether author of message is admin from options or
+// user with grant of admin role. Grants of chats are ignored, so members of
+// group with admin role are not admins.
+func (b *Bot) isBotAdmin(ctx context.Context, m *tg.Message) (bool, error) {
+	user := senderUser(m)
+	if user == 0 {
+		return false, nil
+	}
+	if slices.Contains(b.admins, user) {
+		return true, nil
+	}
+	r, err := b.grantRole(ctx, &tg.PeerUser{UserID: user})
+	if err != nil {
+		return false, err
+	}
+	return r != nil && r.Admin, nil
+}
+
+// chatRoleOf returns role of group of message, nil if message is private
+// or group has no grant and bot is not public.
+func (b *Bot) chatRoleOf(ctx context.Context, m *tg.Message) (*ent.Role, error) {
+	if isPrivate(m) {
+		return nil, nil
+	}
+	r, err := b.grantRole(ctx, m.PeerID)
+	if err != nil || r != nil {
+		return r, err
+	}
+	return b.publicRole(ctx)
+}
+
+// publicRole returns seeded user role if bot is public, nil otherwise.
+func (b *Bot) publicRole(ctx context.Context) (*ent.Role, error) {
+	if !b.public {
+		return nil, nil
+	}
+	r, err := b.db.Role.Query().Where(role.Name(access.RoleUser)).Only(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "query user role")
+	}
+	return r, nil
+}
+
+// grantRole returns role of grant of peer, nil if peer has no grant.
+func (b *Bot) grantRole(ctx context.Context, p tg.PeerClass) (*ent.Role, error) {
+	peerType, peerID := grantPeer(p)
+	g, err := b.db.Grant.Query().
+		Where(grant.PeerTypeEQ(peerType), grant.PeerID(peerID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query grant")
+	}
+	r, err := b.db.Role.Query().Where(role.Name(g.Role)).Only(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "query role %s", g.Role)
+	}
+	return r, nil
+}
+
+// authorized returns handler that allows only granted users and chats to
+// run h.
+func (b *Bot) authorized(h command.Handler) command.Handler {
+	return func(ctx context.Context, in command.Input) error {
+		r, err := b.roleOf(ctx, in)
+		if err != nil {
+			return errors.Wrap(err, "role")
+		}
+		if r != nil {
+			return h(ctx, in)
+		}
+		if !b.addressed(in) {
+			return nil
+		}
+		return b.replyT(ctx, in, "access.denied")
+	}
+}
+
+// botAdminOnly returns handler that allows only bot admins to run h.
+func (b *Bot) botAdminOnly(h command.Handler) command.Handler {
+	return func(ctx context.Context, in command.Input) error {
+		admin, err := b.isBotAdmin(ctx, in.Message)
+		if err != nil {
+			return errors.Wrap(err, "admin")
+		}
+		if !admin {
+			return b.replyT(ctx, in, "access.bot_admin_only")
+		}
+		return h(ctx, in)
+	}
+}
+
+// featureOf returns feature of request with options.
+func featureOf(opt schema.JobOptions, audioOnly bool) string {
+	switch {
+	case opt.Mode == modeAnimation:
+		return access.FeatureAnimation
+	case opt.Mode == modeNote:
+		return access.FeatureNote
+	case opt.Mode == modeSticker:
+		return access.FeatureSticker
+	case audioOnly:
+		return access.FeatureAudio
+	case opt.Clip():
+		return access.FeatureClip
+	default:
+		return access.FeatureVideo
+	}
+}
+
+// checkLimits returns error that describes limit of subject role exceeded
+// by request of entries.
+func checkLimits(subjects []usageSubject, opt schema.JobOptions, entries []*ytdlp.Video, audioOnly bool, size int64) error {
+	feature := featureOf(opt, audioOnly)
+	for _, s := range subjects {
+		if s.Role == nil {
+			continue
+		}
+		for _, v := range entries {
+			if err := s.Role.Limits.Check(feature, clipDuration(v, opt), size); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// exceededText returns description of limit exceeded by request in language
+// of locale.
+func exceededText(loc *i18n.Locale, err error) string {
+	var e *access.Exceeded
+	if errors.As(err, &e) {
+		return e.Text(loc)
+	}
+	return err.Error()
+}
+
+// onJoinCommand handles "/join <code>" command, that redeems invite code
+// for author in private chat or for group.
+func (b *Bot) onJoinCommand(ctx context.Context, in command.Input) error {
+	args := in.Fields()
+	if len(args) != 1 {
+		return command.ErrUsage
+	}
+	code := strings.ToLower(args[0])
+
+	if !isPrivate(in.Message) {
+		ok, err := b.isChatAdmin(ctx, in)
+		if err != nil {
+			return errors.Wrap(err, "check admin")
+		}
+		if !ok {
+			return b.replyT(ctx, in, "access.chat_admin_only")
+		}
+	}
+
+	n, err := b.db.Invite.Update().
+		Where(
+			invite.Code(code),
+			func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(invite.FieldUses), s.C(invite.FieldMaxUses)))
+			},
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		return errors.Wrap(err, "use invite")
+	}
+	if n == 0 {
+		return b.replyT(ctx, in, "join.invalid")
+	}
+	inv, err := b.db.Invite.Query().Where(invite.Code(code)).Only(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query invite")
+	}
+
+	peerType, peerID := grantPeer(in.Message.PeerID)
+	if err := b.db.Grant.Create().
+		SetPeerType(peerType).
+		SetPeerID(peerID).
+		SetRole(inv.Role).
+		SetGrantedBy(0).
+		SetInvite(inv.Code).
+		OnConflictColumns(grant.FieldPeerType, grant.FieldPeerID).
+		UpdateRole().
+		UpdateGrantedBy().
+		UpdateInvite().
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "save grant")
+	}
+	b.lg.Info("Invite redeemed",
+		zap.String("role", inv.Role),
+		zap.String("peer_type", peerType.String()),
+		zap.Int64("peer_id", peerID),
+	)
+
+	if isPrivate(in.Message) {
+		return b.replyT(ctx, in, "join.welcome")
+	}
+	return b.replyT(ctx, in, "join.chat")
+}
+
+// parseSubject parses "<user|chat|channel> <id>" arguments.
+func parseSubject(args []string) (grant.PeerType, int64, error) {
+	if len(args) != 2 {
+		return "", 0, command.ErrUsage
+	}
+	peerType := grant.PeerType(args[0])
+	if err := grant.PeerTypeValidator(peerType); err != nil {
+		return "", 0, command.ErrUsage
+	}
+	id, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil || id <= 0 {
+		return "", 0, command.ErrUsage
+	}
+	return peerType, id, nil
+}
+
+// roleExists reports whether role with name exists.
+func (b *Bot) roleExists(ctx context.Context, name string) (bool, error) {
+	ok, err := b.db.Role.Query().Where(role.Name(name)).Exist(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "query role")
+	}
+	return ok, nil
+}
+
+// onAllowCommand handles "/allow <user|chat|channel> <id> [role]" command.
+func (b *Bot) onAllowCommand(ctx context.Context, in command.Input) error {
+	args := in.Fields()
+	roleName := access.RoleUser
+	if len(args) == 3 {
+		roleName, args = args[2], args[:2]
+	}
+	peerType, peerID, err := parseSubject(args)
+	if err != nil {
+		return err
+	}
+	if ok, err := b.roleExists(ctx, roleName); err != nil {
+		return err
+	} else if !ok {
+		return b.replyT(ctx, in, "access.unknown_role", roleName)
+	}
+
+	if err := b.db.Grant.Create().
+		SetPeerType(peerType).
+		SetPeerID(peerID).
+		SetRole(roleName).
+		SetGrantedBy(senderID(in.Message)).
+		OnConflictColumns(grant.FieldPeerType, grant.FieldPeerID).
+		UpdateRole().
+		UpdateGrantedBy().
+		ClearInvite().
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "save grant")
+	}
+	return b.replyT(ctx, in, "access.allowed", peerType, peerID, roleName)
+}
+
+// onDenyCommand handles "/deny <user|chat|channel> <id>" command.
+func (b *Bot) onDenyCommand(ctx context.Context, in command.Input) error {
+	peerType, peerID, err := parseSubject(in.Fields())
+	if err != nil {
+		return err
+	}
+	n, err := b.db.Grant.Delete().
+		Where(grant.PeerTypeEQ(peerType), grant.PeerID(peerID)).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete grant")
+	}
+	if n == 0 {
+		return b.replyT(ctx, in, "access.not_allowed", peerType, peerID)
+	}
+	return b.replyT(ctx, in, "access.revoked", peerType, peerID)
+}
+
+// onInviteCommand handles "/invite [role] [uses]" command, that creates
+// invite code.
+func (b *Bot) onInviteCommand(ctx context.Context, in command.Input) error {
+	var (
+		args     = in.Fields()
+		roleName = access.RoleUser
+		uses     = 1
+		err      error
+	)
+	if len(args) > 2 {
+		return command.ErrUsage
+	}
+	if len(args) > 0 {
+		roleName = args[0]
+	}
+	if len(args) > 1 {
+		if uses, err = strconv.Atoi(args[1]); err != nil || uses <= 0 {
+			return command.ErrUsage
+		}
+	}
+	if ok, err := b.roleExists(ctx, roleName); err != nil {
+		return err
+	} else if !ok {
+		return b.replyT(ctx, in, "access.unknown_role", roleName)
+	}
+
+	code, err := access.NewCode()
+	if err != nil {
+		return errors.Wrap(err, "generate code")
+	}
+	if err := b.db.Invite.Create().
+		SetCode(code).
+		SetRole(roleName).
+		SetMaxUses(uses).
+		SetCreatedBy(senderID(in.Message)).
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "create invite")
+	}
+	return b.reply(ctx, in, b.locale(ctx, in).N("invite.created", uses, roleName, code))
+}
+
+// onRoleCommand handles "/role [name] [admin=on|off] [limits]" command, that
+// lists roles or creates and updates role.
+func (b *Bot) onRoleCommand(ctx context.Context, in command.Input) error {
+	var (
+		args = in.Fields()
+		loc  = b.locale(ctx, in)
+	)
+	if len(args) == 0 {
+		roles, err := b.db.Role.Query().Order(ent.Asc(role.FieldName)).All(ctx)
+		if err != nil {
+			return errors.Wrap(err, "query roles")
+		}
+		var s strings.Builder
+		s.WriteString(loc.T("role.list"))
+		for _, r := range roles {
+			s.WriteString("\n" + formatRole(loc, r))
+		}
+		s.WriteString("\n\n" + b.router.Usage(loc, in.Name))
+		return b.reply(ctx, in, s.String())
+	}
+
+	name, args := args[0], args[1:]
+	current, err := b.db.Role.Query().Where(role.Name(name)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return errors.Wrap(err, "query role")
+	}
+	var (
+		admin  bool
+		limits access.Limits
+		rest   []string
+	)
+	if current != nil {
+		admin, limits = current.Admin, current.Limits
+	}
+	for _, arg := range args {
+		switch arg {
+		case "admin=on":
+			admin = true
+		case "admin=off":
+			admin = false
+		default:
+			rest = append(rest, arg)
+		}
+	}
+	if limits, err = access.ParseLimits(limits, rest); err != nil {
+		return b.reply(ctx, in, loc.T("role.bad_limits", err.Error())+"\n"+b.router.Usage(loc, in.Name))
+	}
+
+	if err := b.db.Role.Create().
+		SetName(name).
+		SetAdmin(admin).
+		SetLimits(limits).
+		OnConflictColumns(role.FieldName).
+		UpdateAdmin().
+		UpdateLimits().
+		UpdateUpdatedAt().
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "save role")
+	}
+	r, err := b.db.Role.Query().Where(role.Name(name)).Only(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query role")
+	}
+	return b.reply(ctx, in, loc.T("role.saved", formatRole(loc, r)))
+}
+
+// formatRole returns one-line description of role.
+func formatRole(loc *i18n.Locale, r *ent.Role) string {
+	s := r.Name + ": " + r.Limits.Format(loc)
+	if r.Admin {
+		s += ", " + loc.T("role.admin")
+	}
+	return s
+}
+
+// onAccessCommand handles "/access" command, that lists grants and unused
+// invites.
+func (b *Bot) onAccessCommand(ctx context.Context, in command.Input) error {
+	grants, err := b.db.Grant.Query().
+		Order(ent.Asc(grant.FieldPeerType), ent.Asc(grant.FieldPeerID)).
+		All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query grants")
+	}
+	invites, err := b.db.Invite.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(invite.FieldUses), s.C(invite.FieldMaxUses)))
+		}).
+		Order(ent.Asc(invite.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query invites")
+	}
+
+	var (
+		s   strings.Builder
+		loc = b.locale(ctx, in)
+	)
+	s.WriteString(loc.T("access.list.grants"))
+	if len(grants) == 0 {
+		s.WriteString(" " + loc.T("access.list.none"))
+	}
+	for _, g := range grants {
+		fmt.Fprintf(&s, "\n%s %d: %s", g.PeerType, g.PeerID, g.Role)
+		if g.Invite != "" {
+			s.WriteString(", " + loc.T("access.list.invited"))
+		}
+	}
+	s.WriteString("\n\n" + loc.T("access.list.invites"))
+	if len(invites) == 0 {
+		s.WriteString(" " + loc.T("access.list.none"))
+	}
+	for _, inv := range invites {
+		s.WriteString("\n" + loc.T("access.list.invite", inv.Code, inv.Role, inv.Uses, inv.MaxUses))
+	}
+	if len(b.admins) > 0 {
+		s.WriteString("\n\n" + loc.T("access.list.owners"))
+		for _, id := range b.admins {
+			s.WriteString(" " + strconv.FormatInt(id, 10))
+		}
+	}
+	return b.reply(ctx, in, s.String())
+}
@@ -0,0 +1,215 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/schema"
+	"github.com/ernado/tentacle/internal/media"
+	"github.com/ernado/tentacle/internal/ytdlp"
+	"github.com/ernado/tentacle/internal/ytio"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/message/styling"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxAlbumSize is maximum count of media in album.
+const maxAlbumSize = 10
+
+// albumConcurrency limits concurrent downloads of album entries.
+const albumConcurrency = 4
+
+// albumOf reports whether entries are sent as album, which is done only
+// for requests of whole videos.
+func albumOf(entries []*ytdlp.Video, opt schema.JobOptions) bool {
+	return len(entries) > 1 && !opt.Clip() && opt.Mode == "" && opt.Format != ytdlp.FormatBestAudio
+}
+
+// albumItem is entry of album with files to download.
+type albumItem struct {
+	Video *ytdlp.Video
+	// Image reports whether entry is photo, downloaded to the only file.
+	Image   bool
+	Dir     string
+	Files   []*ytio.File
+	Formats []ytdlp.Format
+}
+
+// downloadAlbum downloads best format of every entry concurrently,
+// converting each one to mp4 video or photo that is uploaded as album
+// item. Entries with neither video nor image are skipped.
+func (b *Bot) downloadAlbum(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	j *ent.Job,
+	entries []*ytdlp.Video,
+	httpClient *http.Client,
+	report func(Status),
+) (*result, error) {
+	var (
+		downloaded = new(ytio.Progress)
+		items      []albumItem
+	)
+	for i, entry := range entries {
+		dir := filepath.Join(b.jobDir(j), fmt.Sprintf("entry-%02d", i))
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return nil, errors.Wrap(err, "create entry dir")
+		}
+		if image, ok := entry.BestImage(); ok {
+			items = append(items, albumItem{
+				Video: entry,
+				Image: true,
+				Dir:   dir,
+				Files: []*ytio.File{{
+					Path:     filepath.Join(dir, "image-"+image.FormatID),
+					Progress: downloaded,
+				}},
+				Formats: []ytdlp.Format{image},
+			})
+			continue
+		}
+		choice, err := entry.Select(ytdlp.FormatBest)
+		if err == nil && choice.Plan() == ytdlp.PlanAudio {
+			err = errors.New("no video")
+		}
+		if err != nil {
+			lg.Warn("Skipping entry", zap.Int("entry", i), zap.Error(err))
+			continue
+		}
+		files, formats := choiceFiles(dir, choice, downloaded)
+		items = append(items, albumItem{Video: entry, Dir: dir, Files: files, Formats: formats})
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no entries with video or image")
+	}
+	lg.Info("Downloading album", zap.Int("items", len(items)))
+
+	report(Status{Stage: StageDownload})
+	stopProgress := trackProgress(ctx, StageDownload, downloaded, report)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(albumConcurrency)
+	for _, item := range items {
+		for i, file := range item.Files {
+			format := item.Formats[i]
+			g.Go(func() error {
+				if err := ytdlp.DownloadChunked(gCtx, format, file, httpClient); err != nil {
+					return errors.Wrapf(err, "download %s", format.FormatID)
+				}
+				return nil
+			})
+		}
+	}
+	err := g.Wait()
+	stopProgress()
+	if err != nil {
+		return nil, errors.Wrap(err, "download")
+	}
+
+	report(Status{Stage: StageProcess})
+
+	uploaded := make([]uploadedMedia, len(items))
+	g, gCtx = errgroup.WithContext(ctx)
+	for i, item := range items {
+		g.Go(func() error {
+			if item.Image {
+				name := fileName(item.Video.Title, fmt.Sprintf("image-%d", i+1), "."+item.Formats[0].Ext)
+				photo, err := b.uploadImage(gCtx, lg, reply, item.Files[0].Path, name, report)
+				if err != nil {
+					return errors.Wrapf(err, "entry %d", i)
+				}
+				uploaded[i] = photo
+				return nil
+			}
+			doc, err := b.albumVideo(gCtx, lg, reply, j, i, item, report)
+			if err != nil {
+				return errors.Wrapf(err, "entry %d", i)
+			}
+			uploaded[i] = uploadedMedia{Document: doc}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return &result{Video: entries[0], Media: uploaded, Album: true}, nil
+}
+
+// albumVideo converts downloaded files of album item to mp4 and uploads it.
+func (b *Bot) albumVideo(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	j *ent.Job,
+	i int,
+	item albumItem,
+	report func(Status),
+) (*tg.Document, error) {
+	inputs := make([]string, 0, len(item.Files))
+	for _, file := range item.Files {
+		inputs = append(inputs, file.Path)
+	}
+	var (
+		outputPath         = filepath.Join(item.Dir, "output.mp4")
+		metadata, chapters = containerMetadata(item.Video, j.URL)
+	)
+	if _, err := b.media.ConvertMP4(ctx, media.ConvertOptions{
+		Inputs:   inputs,
+		Output:   outputPath,
+		Metadata: metadata,
+		Chapters: chapters,
+	}); err != nil {
+		return nil, errors.Wrap(err, "mux")
+	}
+
+	// Items of posts are short, so they are not split.
+	stat, err := os.Stat(outputPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "stat output")
+	}
+	if stat.Size() > b.uploadLimit {
+		return nil, errors.Errorf("size %d exceeds upload limit", stat.Size())
+	}
+
+	name := fileName(item.Video.Title, fmt.Sprintf("video-%d", i+1), ".mp4")
+	return b.uploadVideo(ctx, lg, reply, outputPath, name, "", report)
+}
+
+// sendAlbum sends media as albums of up to maxAlbumSize items, where
+// caption of the first item is caption of the whole album.
+func sendAlbum(ctx context.Context, reply *message.Builder, media []uploadedMedia, caption []styling.StyledTextOption) error {
+	for start := 0; start < len(media); start += maxAlbumSize {
+		var (
+			chunk = media[start:min(start+maxAlbumSize, len(media))]
+			items = make([]message.MultiMediaOption, 0, len(chunk))
+		)
+		for i, m := range chunk {
+			if start == 0 && i == 0 {
+				items = append(items, m.option(caption...))
+				continue
+			}
+			items = append(items, m.option())
+		}
+		if len(items) == 1 {
+			// Album needs at least two items.
+			if _, err := reply.Media(ctx, items[0]); err != nil {
+				return errors.Wrap(err, "send media")
+			}
+			continue
+		}
+		if _, err := reply.Album(ctx, items[0], items[1:]...); err != nil {
+			return errors.Wrap(err, "send album")
+		}
+	}
+	return nil
+}
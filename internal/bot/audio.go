@@ -0,0 +1,253 @@
+package bot
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/media"
+	"github.com/ernado/tentacle/internal/ytdlp"
+	"github.com/ernado/tentacle/internal/ytio"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/uploader"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+)
+
+// Audio codecs that audio only download can be converted to.
+const (
+	audioOpus = "opus"
+	audioMP3  = "mp3"
+)
+
+// audioTarget describes output of audio conversion.
+type audioTarget struct {
+	Ext     string
+	MIME    string
+	Codec   string
+	Bitrate int64
+	Args    []string
+	// Cover reports whether container supports embedded cover art.
+	Cover bool
+	// CoverTag reports whether cover art is embedded as tag instead of
+	// attached picture, like in ogg.
+	CoverTag bool
+}
+
+func opusTarget(codec string, bitrate int64) audioTarget {
+	return audioTarget{
+		Ext:      "opus",
+		MIME:     "audio/ogg",
+		Codec:    codec,
+		Bitrate:  bitrate,
+		Cover:    true,
+		CoverTag: true,
+	}
+}
+
+func mp3Target() audioTarget {
+	return audioTarget{
+		Ext:   "mp3",
+		MIME:  "audio/mpeg",
+		Codec: media.MP3,
+		Args:  []string{"-q:a", "2", "-id3v2_version", "3"},
+		Cover: true,
+	}
+}
+
+// audioTargetFor returns conversion of audio format f to codec, keeping
+// original stream if codec is empty and format is playable by telegram.
+func audioTargetFor(codec string, f ytdlp.Format) audioTarget {
+	switch codec {
+	case audioOpus:
+		return opusTarget(media.Opus, 128_000)
+	case audioMP3:
+		return mp3Target()
+	}
+	switch {
+	case f.Ext == "m4a":
+		return audioTarget{Ext: "m4a", MIME: "audio/mp4", Codec: media.Copy, Cover: true}
+	case ytdlp.Codec(f.ACodec) == "opus":
+		return opusTarget(media.Copy, 0)
+	default:
+		return mp3Target()
+	}
+}
+
+// cover downloads thumbnail of video and converts it to jpeg that is
+// suitable both for telegram thumbnail and embedded cover art.
+func (b *Bot) cover(ctx context.Context, dir string, v *ytdlp.Video, httpClient *http.Client) (string, error) {
+	var (
+		thumbnailPath = filepath.Join(dir, "thumbnail")
+		coverPath     = filepath.Join(dir, "cover.jpg")
+	)
+	if err := ytdlp.DownloadThumbnail(ctx, v, thumbnailPath, httpClient); err != nil {
+		return "", errors.Wrap(err, "download thumbnail")
+	}
+	if err := b.media.ExtractFrame(ctx, thumbnailPath, coverPath, 0, thumbSize); err != nil {
+		return "", errors.Wrap(err, "convert thumbnail")
+	}
+
+	return coverPath, nil
+}
+
+// coverTagArgs returns output arguments that embed cover at path as tag of
+// audio stream.
+func coverTagArgs(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read cover")
+	}
+	tag, err := media.PictureComment(data)
+	if err != nil {
+		return nil, err
+	}
+	return []string{"-metadata:s:a:0", media.PictureTag + "=" + tag}, nil
+}
+
+// audio converts downloaded audio only format, tagging it with video
+// metadata, and uploads it as audio documents, split into parts if it
+// exceeds upload limit.
+func (b *Bot) audio(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	j *ent.Job,
+	video *ytdlp.Video,
+	format ytdlp.Format,
+	inputPath string,
+	httpClient *http.Client,
+	report func(Status),
+) ([]uploadedMedia, error) {
+	report(Status{Stage: StageProcess})
+
+	dir := b.jobDir(j)
+	coverPath, err := b.cover(ctx, dir, video, httpClient)
+	if err != nil {
+		// Cover is optional.
+		lg.Warn("Failed to get cover", zap.Error(err))
+	}
+
+	var (
+		target     = audioTargetFor(j.Options.Audio, format)
+		outputPath = filepath.Join(dir, "output."+target.Ext)
+		opt        = media.TranscodeOptions{
+			Inputs:       []string{inputPath},
+			Output:       outputPath,
+			Maps:         []string{"0:a"},
+			AudioCodec:   target.Codec,
+			AudioBitrate: target.Bitrate,
+			Args:         target.Args,
+		}
+	)
+	opt.Metadata, opt.Chapters = containerMetadata(video, j.URL)
+	if j.Options.Clip() {
+		// Clip already has its chapters.
+		opt.Chapters = nil
+	}
+	switch {
+	case coverPath == "" || !target.Cover:
+	case target.CoverTag:
+		args, err := coverTagArgs(coverPath)
+		if err != nil {
+			lg.Warn("Failed to embed cover", zap.Error(err))
+			break
+		}
+		opt.Args = append(opt.Args, args...)
+	default:
+		opt.Inputs = append(opt.Inputs, coverPath)
+		opt.Maps = append(opt.Maps, "1:v")
+		opt.VideoCodec = media.Copy
+		opt.Args = append(opt.Args, "-disposition:v", "attached_pic")
+	}
+	if err := b.media.Transcode(ctx, opt); err != nil {
+		return nil, errors.Wrap(err, "convert audio")
+	}
+
+	// Audio is always split, compression is made for video.
+	paths, err := b.fitLimit(ctx, lg, outputPath, oversizeSplit)
+	if err != nil {
+		return nil, errors.Wrap(err, "fit upload limit")
+	}
+
+	report(Status{Stage: StageUpload})
+
+	docs := make([]uploadedMedia, 0, len(paths))
+	for i, path := range paths {
+		title := partTitle(video.Title, i, len(paths))
+		doc, err := b.uploadAudio(ctx, lg, reply, path, title, video.Uploader, target, coverPath, report)
+		if err != nil {
+			return nil, errors.Wrapf(err, "upload %s", filepath.Base(path))
+		}
+		docs = append(docs, uploadedMedia{Document: doc})
+	}
+
+	return docs, nil
+}
+
+// uploadAudio uploads audio at path as audio document with title and
+// performer, using cover as thumbnail if it is set.
+func (b *Bot) uploadAudio(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	path, title, performer string,
+	target audioTarget,
+	coverPath string,
+	report func(Status),
+) (*tg.Document, error) {
+	// Duration of clip or part differs from duration of video.
+	info, err := b.media.Probe(ctx, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "probe audio")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open audio")
+	}
+	defer func() { _ = f.Close() }()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "stat audio")
+	}
+
+	name := fileName(title, "audio", "."+target.Ext)
+	uploaded := new(ytio.Progress)
+	stopProgress := trackProgress(ctx, StageUpload, uploaded, report)
+	inputClass, err := b.uploader(lg, uploaded).
+		Upload(ctx, uploader.NewUpload(name, f, stat.Size()))
+	stopProgress()
+	if err != nil {
+		return nil, errors.Wrap(err, "upload")
+	}
+	lg.Info("Uploaded")
+
+	doc := message.UploadedDocument(inputClass).
+		Filename(name).
+		MIME(target.MIME)
+	if coverPath != "" {
+		thumbnail, err := b.uploader(lg, nil).FromPath(ctx, coverPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "upload cover")
+		}
+		doc = doc.Thumb(thumbnail)
+	}
+
+	res, err := reply.UploadMedia(ctx, doc.
+		Audio().
+		Title(title).
+		Performer(performer).
+		Duration(info.Duration),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "upload media")
+	}
+
+	return uploadedDocumentFrom(res)
+}
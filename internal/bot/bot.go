@@ -0,0 +1,511 @@
+// Package bot implements telegram bot that downloads videos.
+package bot
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/schema"
+	"github.com/ernado/tentacle/internal/failure"
+	"github.com/ernado/tentacle/internal/i18n"
+	"github.com/ernado/tentacle/internal/inflight"
+	"github.com/ernado/tentacle/internal/media"
+	"github.com/ernado/tentacle/internal/queue"
+	"github.com/ernado/tentacle/internal/settings"
+	"github.com/ernado/tentacle/internal/ytdlp"
+	"github.com/ernado/tentacle/internal/ytio"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/uploader"
+	"github.com/gotd/td/tg"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// Options of Bot.
+type Options struct {
+	// API is used to send messages.
+	API tg.Invoker
+	// UploadAPI is used to upload files, usually pool of clients.
+	UploadAPI tg.Invoker
+	// UploadThreads is count of concurrent upload workers.
+	UploadThreads int
+	// UploadLimit is maximum size of uploaded file, larger videos are
+	// split or compressed.
+	UploadLimit int64
+
+	Proxy       string
+	CookiesFile string
+
+	// DB persists jobs.
+	DB *ent.Client
+	// WorkDir is directory for job files. Files of interrupted jobs are
+	// kept there and reused on resume.
+	WorkDir string
+	// Queue limits concurrent jobs.
+	Queue *queue.Queue
+
+	// Media runs ffmpeg.
+	Media  *media.Pipeline
+	Logger *zap.Logger
+
+	// Admins are ids of users that always have admin role.
+	Admins []int64
+	// Public allows everyone without grant to use bot with limits of user
+	// role. Otherwise only granted users and chats can use bot.
+	Public bool
+	// AdminChat is bot API id of chat that receives failure reports, like
+	// -1001234567890 for supergroup. Reports are disabled if zero.
+	AdminChat int64
+
+	TracerProvider trace.TracerProvider
+}
+
+func (o *Options) setDefaults() {
+	if o.UploadAPI == nil {
+		o.UploadAPI = o.API
+	}
+	if o.UploadThreads == 0 {
+		o.UploadThreads = 1
+	}
+	if o.UploadLimit == 0 {
+		o.UploadLimit = defaultUploadLimit
+	}
+	if o.WorkDir == "" {
+		o.WorkDir = os.TempDir()
+	}
+	if o.Queue == nil {
+		o.Queue = queue.New(queue.Options{})
+	}
+	if o.Media == nil {
+		o.Media = media.New(media.Options{})
+	}
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+	if o.TracerProvider == nil {
+		o.TracerProvider = noop.NewTracerProvider()
+	}
+}
+
+// Bot handles incoming messages.
+type Bot struct {
+	api       *tg.Client
+	sender    *message.Sender
+	uploadAPI *tg.Client
+	threads   int
+	// uploadLimit is maximum size of uploaded file.
+	uploadLimit int64
+
+	proxy   string
+	cookies string
+
+	db      *ent.Client
+	workDir string
+
+	media  *media.Pipeline
+	lg     *zap.Logger
+	tracer trace.Tracer
+
+	queue *queue.Queue
+	// jobs are in-flight downloads keyed by canonical url and format.
+	jobs inflight.Group[Status, *result]
+	// active are jobs of requesters, that can be canceled.
+	active activeJobs
+	// pickers are pending format choices.
+	pickers pickers
+	// retries are failures that can be retried by requester.
+	retries retries
+	// failures deduplicates reports of failures to admin chat.
+	failures  *failure.Deduper
+	adminChat *adminChat
+	// router dispatches commands of messages.
+	router *command.Router
+	// admins are ids of users that always have admin role.
+	admins []int64
+	// public is whether everyone without grant has user role.
+	public bool
+}
+
+// New creates new Bot.
+func New(opt Options) *Bot {
+	opt.setDefaults()
+
+	api := tg.NewClient(slowModeInvoker{next: opt.API})
+	b := &Bot{
+		api:         api,
+		sender:      message.NewSender(api),
+		uploadAPI:   tg.NewClient(opt.UploadAPI),
+		threads:     opt.UploadThreads,
+		uploadLimit: opt.UploadLimit,
+		proxy:       opt.Proxy,
+		cookies:     opt.CookiesFile,
+		db:          opt.DB,
+		workDir:     opt.WorkDir,
+		queue:       opt.Queue,
+		media:       opt.Media,
+		lg:          opt.Logger,
+		tracer:      opt.TracerProvider.Tracer("github.com/ernado/tentacle/internal/bot"),
+		admins:      opt.Admins,
+		public:      opt.Public,
+		failures:    failure.NewDeduper(reportWindow),
+		adminChat:   &adminChat{id: opt.AdminChat},
+	}
+	b.router = b.newRouter()
+
+	return b
+}
+
+// Register bot handlers in dispatcher.
+func (b *Bot) Register(d tg.UpdateDispatcher) {
+	d.OnNewMessage(b.OnNewMessage)
+	d.OnNewChannelMessage(b.OnNewChannelMessage)
+	d.OnBotCallbackQuery(b.OnCallbackQuery)
+}
+
+// OnNewMessage handles new message.
+func (b *Bot) OnNewMessage(ctx context.Context, e tg.Entities, u *tg.UpdateNewMessage) error {
+	b.adminChat.Learn(e)
+	return b.router.Handle(ctx, e, u)
+}
+
+// OnNewChannelMessage handles new message of supergroup.
+func (b *Bot) OnNewChannelMessage(ctx context.Context, e tg.Entities, u *tg.UpdateNewChannelMessage) error {
+	b.adminChat.Learn(e)
+	return b.router.Handle(ctx, e, u)
+}
+
+// onLinks requests every distinct link of message concurrently, so each
+// link gets its own replies. Message without links is ignored, as well as
+// message of group that is not addressed to bot, unless group has auto
+// fetch enabled.
+func (b *Bot) onLinks(ctx context.Context, in command.Input) error {
+	list, err := b.groupLinks(ctx, in)
+	if err != nil {
+		return errors.Wrap(err, "links")
+	}
+	var g errgroup.Group
+	for _, link := range list {
+		g.Go(func() error {
+			if err := b.request(ctx, in, link, schema.JobOptions{}); err != nil {
+				return errors.Wrapf(err, "request %s", link)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// onAudioCommand handles "/audio [opus|mp3] <url>" command.
+func (b *Bot) onAudioCommand(ctx context.Context, in command.Input) error {
+	opt := schema.JobOptions{Format: ytdlp.FormatBestAudio}
+	args := in.Fields()
+	if len(args) == 2 {
+		opt.Audio, args = args[0], args[1:]
+	}
+	if len(args) != 1 || (opt.Audio != "" && opt.Audio != audioOpus && opt.Audio != audioMP3) {
+		return command.ErrUsage
+	}
+
+	return b.request(ctx, in, args[0], opt)
+}
+
+// request creates and runs job for url, asking user to pick format if
+// options have none. Start position of url is clip start, unless options
+// already have clip.
+//
+// Failure is shown to requester with retry button and reported to admin
+// chat.
+func (b *Bot) request(ctx context.Context, in command.Input, rawURL string, opt schema.JobOptions) (err error) {
+	ctx, span := b.tracer.Start(ctx, "Request")
+	defer span.End()
+
+	var (
+		e    = in.Entities
+		u    = in.Update
+		m    = in.Message
+		orig = opt
+	)
+	// URL is fetched as sent, canonical one is only key of shared download.
+	uri := strings.TrimSpace(rawURL)
+	if _, err := ytdlp.CanonicalURL(uri); err != nil {
+		return errors.Wrap(err, "parse url")
+	}
+	if start, ok := ytdlp.StartFromURL(rawURL); ok && !opt.Clip() {
+		opt.Start = start
+	}
+	peer, err := peerFrom(e, m.PeerID)
+	if err != nil {
+		return errors.Wrap(err, "peer")
+	}
+	r, err := b.roleOf(ctx, in)
+	if err != nil {
+		return errors.Wrap(err, "role")
+	}
+	if r == nil {
+		// Handlers are authorized, so grant was revoked meanwhile.
+		return nil
+	}
+	subjects, err := b.usageSubjects(ctx, m, r)
+	if err != nil {
+		return errors.Wrap(err, "usage subjects")
+	}
+
+	answer := b.sender.Answer(e, u)
+	answer.Reply(m.ID)
+	loc := b.locale(ctx, in)
+	status := &statusMessage{
+		answer: answer,
+		quiet:  !b.addressed(in),
+		loc:    loc,
+		lg:     b.lg.With(zap.Int("msg_id", m.ID), zap.String("url", uri)),
+	}
+	defer func() {
+		if err == nil || status.Done() {
+			// Failures of jobs are already shown.
+			return
+		}
+		b.fail(ctx, status, senderUser(m), failure.Report{
+			URL:       uri,
+			Requester: requester(senderPeer(m), peer.Peer()),
+			Err:       err,
+		}, func(ctx context.Context) error {
+			return b.request(ctx, in, rawURL, orig)
+		})
+	}()
+	if text, err := b.quotaExceeded(ctx, loc, subjects); err != nil {
+		return errors.Wrap(err, "check quota")
+	} else if text != "" {
+		status.Finalize(ctx, text)
+		return nil
+	}
+	status.Update(ctx, Status{Stage: StageInfo})
+	entries, err := b.ytdlp().Entries(ctx, uri)
+	if err != nil {
+		return errors.Wrap(err, "fetch video info")
+	}
+	if albumOf(entries, opt) {
+		// Every item of album is downloaded in best format.
+		opt.Format = ytdlp.FormatBest
+		if err := checkLimits(subjects, opt, entries, false, 0); err != nil {
+			status.Finalize(ctx, exceededText(loc, err))
+			return nil
+		}
+		return b.createJob(ctx, m, peer, subjects, uri, opt, entries, status)
+	}
+
+	video := entries[0]
+	if _, ok := video.BestImage(); ok {
+		if opt.Clip() || opt.Mode != "" || opt.Format == ytdlp.FormatBestAudio {
+			status.Finalize(ctx, loc.T("error.image_only"))
+			return nil
+		}
+		opt.Format = ytdlp.FormatBest
+		if err := checkLimits(subjects, opt, entries, false, 0); err != nil {
+			status.Finalize(ctx, exceededText(loc, err))
+			return nil
+		}
+		return b.createJob(ctx, m, peer, subjects, uri, opt, entries, status)
+	}
+
+	p, err := b.requestPreference(ctx, in)
+	if err != nil {
+		return errors.Wrap(err, "preference")
+	}
+	var (
+		s       = settingsOf(p)
+		choices = ytdlp.PreferredChoices(video, formatPreference(s))
+	)
+	if opt.Format == "" {
+		switch {
+		case s.Format == settings.FormatAudio:
+			opt.Format = ytdlp.FormatBestAudio
+		case s.Format == settings.FormatVideo || status.quiet:
+			// Links that are not addressed to bot are fetched without
+			// asking.
+			opt.Format = ytdlp.FormatBest
+		}
+	}
+	if opt.Format == ytdlp.FormatBest && len(choices) > 0 && !choices[0].AudioOnly() {
+		// Best format within preferred quality and codec.
+		opt.Format = choices[0].Selector()
+	}
+	// Size is known only after format is picked, so other limits are
+	// checked before asking.
+	if err := checkLimits(subjects, opt, []*ytdlp.Video{video}, opt.Format == ytdlp.FormatBestAudio, 0); err != nil {
+		status.Finalize(ctx, exceededText(loc, err))
+		return nil
+	}
+	if opt.Format == "" {
+		if opt.Format, err = b.pickFormat(ctx, status, senderUser(m), choices); err != nil {
+			return errors.Wrap(err, "pick format")
+		}
+	}
+	choice, err := video.Select(opt.Format)
+	if err != nil {
+		return failure.Wrap(failure.KindFormat, errors.Wrap(err, "select format"))
+	}
+	if !choice.AudioOnly() && opt.Mode == "" {
+		opt.Document = s.Document
+		opt.Codec = s.Codec
+		if !opt.Clip() {
+			// Subtitles are not cut, so they are sent only with whole
+			// video.
+			opt.Subtitles = s.Subtitles
+		}
+	}
+	size := clipSize(video, choice.Size, opt)
+	if err := checkLimits(subjects, opt, []*ytdlp.Video{video}, choice.AudioOnly(), size); err != nil {
+		status.Finalize(ctx, exceededText(loc, err))
+		return nil
+	}
+	if !choice.AudioOnly() && opt.Mode == "" && size > b.uploadLimit && opt.Oversize == "" && !status.quiet {
+		if opt.Oversize, err = b.pickOversize(ctx, status, senderUser(m), size); err != nil {
+			return errors.Wrap(err, "pick oversize")
+		}
+	}
+
+	return b.createJob(ctx, m, peer, subjects, uri, opt, []*ytdlp.Video{video}, status)
+}
+
+// createJob creates job for message, counts it in usage of subjects and
+// runs it, unless quota was exhausted by concurrent request meanwhile.
+func (b *Bot) createJob(
+	ctx context.Context,
+	m *tg.Message,
+	peer storedPeer,
+	subjects []usageSubject,
+	uri string,
+	opt schema.JobOptions,
+	entries []*ytdlp.Video,
+	status *statusMessage,
+) error {
+	j, exceeded, err := b.reserveJob(ctx, status.loc, m, peer, subjects, uri, opt)
+	if err != nil {
+		return err
+	}
+	if exceeded != "" {
+		status.Finalize(ctx, exceeded)
+		return nil
+	}
+
+	return b.runJob(ctx, j, entries, status)
+}
+
+// reserveJob creates job for message and counts it in usage of subjects in
+// one transaction with quota check, so concurrent requests can not exceed
+// quota. If new job does not fit, description of exhausted quota in
+// language of locale is returned instead.
+func (b *Bot) reserveJob(
+	ctx context.Context,
+	loc *i18n.Locale,
+	m *tg.Message,
+	peer storedPeer,
+	subjects []usageSubject,
+	uri string,
+	opt schema.JobOptions,
+) (_ *ent.Job, exceeded string, rerr error) {
+	tx, err := b.db.Tx(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "begin")
+	}
+	defer func() {
+		if rerr != nil || exceeded != "" {
+			_ = tx.Rollback()
+		}
+	}()
+	db := tx.Client()
+
+	if err := lockUsage(ctx, db, subjects); err != nil {
+		return nil, "", err
+	}
+	if exceeded, err := b.checkQuota(ctx, db, loc, subjects); err != nil || exceeded != "" {
+		return nil, exceeded, err
+	}
+	j, err := db.Job.Create().
+		SetUserID(senderID(m)).
+		SetUserType(senderType(m)).
+		SetPeerType(peer.Type).
+		SetPeerID(peer.ID).
+		SetAccessHash(peer.AccessHash).
+		SetMessageID(m.ID).
+		SetURL(uri).
+		SetOptions(opt).
+		Save(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "create job")
+	}
+	if err := recordUsage(ctx, db, j, subjects); err != nil {
+		return nil, "", errors.Wrap(err, "record usage")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, "", errors.Wrap(err, "commit")
+	}
+
+	return j, "", nil
+}
+
+func (b *Bot) ytdlp() *ytdlp.Instance {
+	return &ytdlp.Instance{
+		CookiesFilePath: b.cookies,
+		Proxy:           b.proxy,
+	}
+}
+
+// OnCallbackQuery handles inline keyboard button press.
+func (b *Bot) OnCallbackQuery(ctx context.Context, e tg.Entities, u *tg.UpdateBotCallbackQuery) error {
+	var (
+		answer string
+		err    error
+		loc    = b.callbackLocale(ctx, e, u)
+	)
+	switch data := string(u.Data); {
+	case strings.HasPrefix(data, cancelPrefix):
+		answer, err = b.onCancelCallback(loc, u)
+	case strings.HasPrefix(data, pickPrefix):
+		answer, err = b.onPickCallback(loc, u)
+	case strings.HasPrefix(data, retryPrefix):
+		answer, err = b.onRetryCallback(ctx, loc, u)
+	case strings.HasPrefix(data, settings.Prefix):
+		answer, err = b.onSettingsCallback(ctx, loc, e, u)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := b.api.MessagesSetBotCallbackAnswer(ctx, &tg.MessagesSetBotCallbackAnswerRequest{
+		QueryID: u.QueryID,
+		Message: answer,
+	}); err != nil {
+		return errors.Wrap(err, "answer callback")
+	}
+
+	return nil
+}
+
+var _ uploader.Progress = (*ZapProgressHandler)(nil)
+
+// ZapProgressHandler logs upload progress and optionally tracks it.
+type ZapProgressHandler struct {
+	Logger   *zap.Logger
+	Progress *ytio.Progress
+}
+
+func (z ZapProgressHandler) Chunk(_ context.Context, state uploader.ProgressState) error {
+	z.Progress.Set(state.Uploaded, state.Total)
+	z.Logger.Debug("Upload progress",
+		zap.Int64("id", state.ID),
+		zap.String("name", state.Name),
+		zap.Int64("total", state.Total),
+		zap.Int("part", state.Part),
+	)
+	return nil
+}
@@ -0,0 +1,119 @@
+package bot
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message/markup"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+)
+
+// errJobCanceled is cancellation cause of job canceled by user.
+var errJobCanceled = errors.New("job canceled by user")
+
+// isCanceled reports whether ctx was canceled by user.
+func isCanceled(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), errJobCanceled)
+}
+
+const cancelPrefix = "cancel:"
+
+// cancelMarkup returns inline keyboard with cancel button for job.
+func cancelMarkup(loc *i18n.Locale, j *ent.Job) tg.ReplyMarkupClass {
+	return markup.InlineRow(
+		markup.Callback(loc.T("cancel.button"), []byte(cancelPrefix+strconv.Itoa(j.ID))),
+	)
+}
+
+// activeJobs is registry of running jobs that can be canceled.
+type activeJobs struct {
+	mux  sync.Mutex
+	jobs map[int]activeJob
+}
+
+type activeJob struct {
+	job    *ent.Job
+	cancel context.CancelCauseFunc
+}
+
+// Add job to registry, returning context that is canceled on Cancel and
+// function to remove job from registry.
+func (a *activeJobs) Add(ctx context.Context, j *ent.Job) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	if a.jobs == nil {
+		a.jobs = make(map[int]activeJob)
+	}
+	a.jobs[j.ID] = activeJob{job: j, cancel: cancel}
+
+	return ctx, func() {
+		a.mux.Lock()
+		delete(a.jobs, j.ID)
+		a.mux.Unlock()
+		cancel(nil)
+	}
+}
+
+// Cancel jobs matching filter, returning count of canceled jobs.
+func (a *activeJobs) Cancel(match func(j *ent.Job) bool) int {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	var n int
+	for _, v := range a.jobs {
+		if !match(v.job) {
+			continue
+		}
+		v.cancel(errJobCanceled)
+		n++
+	}
+
+	return n
+}
+
+// onCancelCallback handles cancel button.
+func (b *Bot) onCancelCallback(loc *i18n.Locale, u *tg.UpdateBotCallbackQuery) (string, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(string(u.Data), cancelPrefix))
+	if err != nil {
+		return "", errors.Wrap(err, "parse job id")
+	}
+
+	n := b.active.Cancel(func(j *ent.Job) bool {
+		// Only requester can cancel the job.
+		return j.ID == id && samePeer(jobSender(j), &tg.PeerUser{UserID: u.UserID})
+	})
+	if n == 0 {
+		return loc.T("cancel.nothing"), nil
+	}
+
+	return loc.T("cancel.done"), nil
+}
+
+// onCancelCommand cancels every active job of message author in chat.
+func (b *Bot) onCancelCommand(ctx context.Context, in command.Input) error {
+	var (
+		from = senderPeer(in.Message)
+		chat = in.Message.PeerID
+	)
+	n := b.active.Cancel(func(j *ent.Job) bool {
+		return samePeer(jobSender(j), from) &&
+			samePeer(storedPeer{Type: j.PeerType, ID: j.PeerID}.Peer(), chat)
+	})
+	b.lg.Info("Canceled jobs", zap.Int64("user_id", senderID(in.Message)), zap.Int("count", n))
+
+	if n == 0 {
+		return b.replyT(ctx, in, "cancel.nothing")
+	}
+	return b.reply(ctx, in, b.locale(ctx, in).N("cancel.jobs", n))
+}
@@ -0,0 +1,122 @@
+package bot
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/schema"
+	"github.com/ernado/tentacle/internal/i18n"
+	"github.com/ernado/tentacle/internal/media"
+	"github.com/ernado/tentacle/internal/ytdlp"
+	"github.com/ernado/tentacle/internal/ytio"
+
+	"go.uber.org/zap"
+)
+
+// onClipCommand handles "/clip <url> <start>-<end>" command.
+func (b *Bot) onClipCommand(ctx context.Context, in command.Input) error {
+	var (
+		args = in.Fields()
+		opt  schema.JobOptions
+		err  error
+	)
+	if len(args) == 2 {
+		opt.Start, opt.End, err = ytdlp.ParseRange(args[1])
+	}
+	if len(args) != 2 || err != nil {
+		return command.ErrUsage
+	}
+
+	return b.request(ctx, in, args[0], opt)
+}
+
+// clipCaption returns caption with original positions of clip in language
+// of locale.
+func clipCaption(loc *i18n.Locale, opt schema.JobOptions) string {
+	if opt.End == 0 {
+		return loc.T("caption.clip_from", ytdlp.FormatTimestamp(opt.Start))
+	}
+	return loc.T("caption.clip", ytdlp.FormatTimestamp(opt.Start), ytdlp.FormatTimestamp(opt.End))
+}
+
+// clipSize estimates size of clip of video with size.
+func clipSize(v *ytdlp.Video, size int64, opt schema.JobOptions) int64 {
+	duration := time.Duration(v.Duration * float64(time.Second))
+	if !opt.Clip() || duration <= 0 {
+		return size
+	}
+	return int64(float64(size) * float64(clipDuration(v, opt)) / float64(duration))
+}
+
+// clipDuration returns duration of clip of video, or duration of whole video
+// if options have no clip. Zero duration is unknown.
+func clipDuration(v *ytdlp.Video, opt schema.JobOptions) time.Duration {
+	duration := time.Duration(v.Duration * float64(time.Second))
+	if !opt.Clip() || duration <= 0 {
+		return duration
+	}
+	end := opt.End
+	if end == 0 || end > duration {
+		end = duration
+	}
+	if end <= opt.Start {
+		return 0
+	}
+	return end - opt.Start
+}
+
+// clipRanges returns byte ranges of format that are needed for clip and
+// position in video where they start, or no ranges if format should be
+// downloaded entirely.
+func clipRanges(
+	ctx context.Context,
+	lg *zap.Logger,
+	format ytdlp.Format,
+	opt schema.JobOptions,
+	httpClient *http.Client,
+) ([]ytio.Range, time.Duration) {
+	if !opt.Clip() || !format.Indexed() {
+		return nil, 0
+	}
+	idx, err := ytdlp.FetchIndex(ctx, format, httpClient)
+	if err != nil {
+		// Downloading whole format is slower, but still works.
+		lg.Info("No index, downloading whole format",
+			zap.String("format", format.FormatID),
+			zap.Error(err),
+		)
+		return nil, 0
+	}
+
+	ranges, from := idx.Ranges(opt.Start, opt.End)
+	if len(ranges) == len(idx.Header) {
+		// Clip is out of media, let ffmpeg report it.
+		return nil, 0
+	}
+	return ranges, from
+}
+
+// clip cuts clip of job options from downloaded inputs of video to output
+// of target.
+func (b *Bot) clip(
+	ctx context.Context,
+	j *ent.Job,
+	video *ytdlp.Video,
+	inputs []media.ClipInput,
+	output string,
+	target media.Target,
+) error {
+	metadata, chapters := containerMetadata(video, j.URL)
+	return b.media.Clip(ctx, media.ClipOptions{
+		Inputs:   inputs,
+		Output:   output,
+		Start:    j.Options.Start,
+		End:      j.Options.End,
+		Target:   target,
+		Metadata: metadata,
+		Chapters: chapters,
+	})
+}
@@ -0,0 +1,272 @@
+package bot
+
+import (
+	"context"
+
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+)
+
+// newRouter returns router of bot commands, where messages that are not
+// commands are requests of links.
+func (b *Bot) newRouter() *command.Router {
+	r := command.NewRouter(b.reply, b.locale)
+	r.Add(
+		command.Command{
+			Name:        "start",
+			Description: "cmd.start",
+			Scope:       command.Private,
+			Handler:     b.onHelpCommand,
+		},
+		command.Command{
+			Name:        "help",
+			Usage:       "[command]",
+			Description: "cmd.help",
+			Scope:       command.All,
+			Handler:     b.onHelpCommand,
+		},
+		command.Command{
+			Name:        "audio",
+			Usage:       "[opus|mp3] <url>",
+			Description: "cmd.audio",
+			Help:        "cmd.audio.help",
+			Scope:       command.All,
+			Handler:     b.authorized(b.onAudioCommand),
+		},
+		command.Command{
+			Name:        "clip",
+			Usage:       "<url> 1:20-3:05",
+			Description: "cmd.clip",
+			Help:        "cmd.clip.help",
+			Scope:       command.All,
+			Handler:     b.authorized(b.onClipCommand),
+		},
+		command.Command{
+			Name:        "gif",
+			Usage:       "<url> [1:20-1:23]",
+			Description: "cmd.gif",
+			Scope:       command.All,
+			Handler:     b.authorized(b.onModeCommand(modeAnimation)),
+		},
+		command.Command{
+			Name:        "note",
+			Usage:       "<url> [1:20-1:23]",
+			Description: "cmd.note",
+			Help:        "cmd.note.help",
+			Scope:       command.All,
+			Handler:     b.authorized(b.onModeCommand(modeNote)),
+		},
+		command.Command{
+			Name:        "sticker",
+			Usage:       "<url> [1:20-1:23]",
+			Description: "cmd.sticker",
+			Help:        "cmd.sticker.help",
+			Scope:       command.All,
+			Handler:     b.authorized(b.onModeCommand(modeSticker)),
+		},
+		command.Command{
+			Name:        "cancel",
+			Description: "cmd.cancel",
+			Scope:       command.All,
+			Handler:     b.authorized(b.onCancelCommand),
+		},
+		command.Command{
+			Name:        "settings",
+			Description: "cmd.settings",
+			Help:        "cmd.settings.help",
+			Scope:       command.Private | command.GroupAdmin,
+			Handler:     b.authorized(b.adminOnly(b.onSettingsCommand)),
+		},
+		command.Command{
+			Name:        "caption",
+			Usage:       "[template|reset]",
+			Description: "cmd.caption",
+			Scope:       command.Private | command.GroupAdmin,
+			Handler:     b.authorized(b.adminOnly(b.onCaptionCommand)),
+		},
+		command.Command{
+			Name:        "originals",
+			Usage:       "[on|off]",
+			Description: "cmd.originals",
+			Scope:       command.Private | command.GroupAdmin,
+			Handler:     b.authorized(b.adminOnly(b.onOriginalsCommand)),
+		},
+		command.Command{
+			Name:        "autofetch",
+			Usage:       "[on|off]",
+			Description: "cmd.autofetch",
+			Help:        "cmd.autofetch.help",
+			Scope:       command.GroupAdmin,
+			Handler:     b.authorized(b.adminOnly(b.onAutoFetchCommand)),
+		},
+		command.Command{
+			Name:        "join",
+			Usage:       "<code>",
+			Description: "cmd.join",
+			Help:        "cmd.join.help",
+			Scope:       command.Private | command.GroupAdmin,
+			Handler:     b.onJoinCommand,
+		},
+		command.Command{
+			Name:        "allow",
+			Usage:       "<user|chat|channel> <id> [role]",
+			Description: "cmd.allow",
+			Help:        "cmd.allow.help",
+			Scope:       command.Admin,
+			Handler:     b.botAdminOnly(b.onAllowCommand),
+		},
+		command.Command{
+			Name:        "deny",
+			Usage:       "<user|chat|channel> <id>",
+			Description: "cmd.deny",
+			Scope:       command.Admin,
+			Handler:     b.botAdminOnly(b.onDenyCommand),
+		},
+		command.Command{
+			Name:        "invite",
+			Usage:       "[role] [uses]",
+			Description: "cmd.invite",
+			Help:        "cmd.invite.help",
+			Scope:       command.Admin,
+			Handler:     b.botAdminOnly(b.onInviteCommand),
+		},
+		command.Command{
+			Name:        "role",
+			Usage:       "[name] [admin=on|off] [duration=30m] [size=500MB] [jobs=10] [traffic=2GB] [features=video,audio,clip,gif,note,sticker|all]",
+			Description: "cmd.role",
+			Help:        "cmd.role.help",
+			Scope:       command.Admin,
+			Handler:     b.botAdminOnly(b.onRoleCommand),
+		},
+		command.Command{
+			Name:        "usage",
+			Usage:       "[<user|chat|channel> <id>]",
+			Description: "cmd.usage",
+			Help:        "cmd.usage.help",
+			Scope:       command.All,
+			Handler:     b.authorized(b.onUsageCommand),
+		},
+		command.Command{
+			Name:        "reset",
+			Usage:       "<user|chat|channel> <id>",
+			Description: "cmd.reset",
+			Scope:       command.Admin,
+			Handler:     b.botAdminOnly(b.onResetCommand),
+		},
+		command.Command{
+			Name:        "access",
+			Description: "cmd.access",
+			Scope:       command.Admin,
+			Handler:     b.botAdminOnly(b.onAccessCommand),
+		},
+	)
+	r.Fallback(b.authorized(b.onLinks))
+	return r
+}
+
+// reply replies to message of input with text.
+func (b *Bot) reply(ctx context.Context, in command.Input, text string) error {
+	if _, err := b.sender.Reply(in.Entities, in.Update).Text(ctx, text); err != nil {
+		return errors.Wrap(err, "reply")
+	}
+	return nil
+}
+
+// onHelpCommand handles "/start" and "/help [command]" commands.
+func (b *Bot) onHelpCommand(ctx context.Context, in command.Input) error {
+	loc := b.locale(ctx, in)
+	if args := in.Fields(); len(args) == 1 && in.Name == "help" {
+		text, ok := b.router.CommandHelp(loc, args[0])
+		if !ok {
+			return b.reply(ctx, in, loc.T("help.unknown"))
+		}
+		return b.reply(ctx, in, text)
+	}
+
+	if isPrivate(in.Message) {
+		scope := command.Private
+		r, err := b.roleOf(ctx, in)
+		if err != nil {
+			return errors.Wrap(err, "role")
+		}
+		if r == nil {
+			return b.reply(ctx, in, loc.T("help.private")+"\n\n"+loc.T("help.join"))
+		}
+		admin, err := b.isBotAdmin(ctx, in.Message)
+		if err != nil {
+			return errors.Wrap(err, "admin")
+		}
+		if admin {
+			scope |= command.Admin
+		}
+		return b.reply(ctx, in, loc.T("help.private")+"\n\n"+
+			b.router.Help(loc, scope)+"\n\n"+
+			loc.T("help.details"))
+	}
+	return b.reply(ctx, in, loc.T("help.group")+"\n\n"+
+		b.router.Help(loc, command.Groups)+"\n\n"+
+		loc.T("help.details"))
+}
+
+// SetCommands fetches username of bot and registers command lists of
+// private chats, groups, group admins and bot admins in every language.
+func (b *Bot) SetCommands(ctx context.Context) error {
+	users, err := b.api.UsersGetUsers(ctx, []tg.InputUserClass{&tg.InputUserSelf{}})
+	if err != nil {
+		return errors.Wrap(err, "get self")
+	}
+	for _, u := range users {
+		if self, ok := u.(*tg.User); ok {
+			b.router.SetUsername(self.Username)
+		}
+	}
+
+	for _, lang := range i18n.Languages() {
+		var (
+			loc      = i18n.Lookup(lang)
+			langCode = lang
+		)
+		if lang == i18n.Default {
+			// Default language is used for users of any language
+			// without own list.
+			langCode = ""
+		}
+		for _, s := range []struct {
+			Scope    command.Scope
+			BotScope tg.BotCommandScopeClass
+		}{
+			{Scope: command.Private, BotScope: &tg.BotCommandScopeUsers{}},
+			{Scope: command.Group, BotScope: &tg.BotCommandScopeChats{}},
+			{Scope: command.GroupAdmin, BotScope: &tg.BotCommandScopeChatAdmins{}},
+		} {
+			if _, err := b.api.BotsSetBotCommands(ctx, &tg.BotsSetBotCommandsRequest{
+				Scope:    s.BotScope,
+				LangCode: langCode,
+				Commands: b.router.BotCommands(loc, s.Scope),
+			}); err != nil {
+				return errors.Wrapf(err, "set %s commands of %T", lang, s.BotScope)
+			}
+		}
+		for _, id := range b.admins {
+			// Admins are known only by id, so access hash may be missing.
+			if _, err := b.api.BotsSetBotCommands(ctx, &tg.BotsSetBotCommandsRequest{
+				Scope:    &tg.BotCommandScopePeer{Peer: &tg.InputPeerUser{UserID: id}},
+				LangCode: langCode,
+				Commands: b.router.BotCommands(loc, command.Private|command.Admin),
+			}); err != nil {
+				b.lg.Warn("Failed to set commands of admin",
+					zap.Int64("user_id", id),
+					zap.String("lang", lang),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+	b.lg.Info("Commands registered", zap.String("username", b.router.Username()))
+
+	return nil
+}
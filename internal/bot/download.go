@@ -0,0 +1,413 @@
+package bot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/i18n"
+	"github.com/ernado/tentacle/internal/media"
+	"github.com/ernado/tentacle/internal/ytdlp"
+	"github.com/ernado/tentacle/internal/ytio"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/message/styling"
+	"github.com/gotd/td/telegram/uploader"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// thumbSize is maximum side of thumbnail accepted by telegram.
+const thumbSize = 320
+
+// jobTimeout limits download job run time, not including time in queue.
+const jobTimeout = time.Minute * 30
+
+// Stage of download job.
+type Stage int
+
+const (
+	StageQueued Stage = iota
+	StageInfo
+	StageDownload
+	StageProcess
+	StageUpload
+)
+
+// Text returns name of stage in language of locale.
+func (s Stage) Text(loc *i18n.Locale) string {
+	switch s {
+	case StageQueued:
+		return loc.T("stage.queued")
+	case StageInfo:
+		return loc.T("stage.info")
+	case StageDownload:
+		return loc.T("stage.download")
+	case StageProcess:
+		return loc.T("stage.process")
+	case StageUpload:
+		return loc.T("stage.upload")
+	default:
+		return loc.T("stage.working")
+	}
+}
+
+// result of download, shared by every requester.
+type result struct {
+	// Video is info of downloaded video, used for captions.
+	Video *ytdlp.Video
+	Media []uploadedMedia
+	// Album reports whether media are items of post, that are sent as
+	// grouped media.
+	Album bool
+	// Conversion made to make video playable, empty for audio.
+	Conversion media.Conversion
+	// Attachments are files that are sent after media, like subtitles.
+	Attachments []*tg.Document
+	// Traffic is count of downloaded bytes, including failed attempts.
+	// Result with only traffic is returned along with error.
+	Traffic int64
+}
+
+// uploadedMedia is media that is uploaded to telegram, document or photo.
+type uploadedMedia struct {
+	Document *tg.Document
+	Photo    *tg.Photo
+	// Original file of photo as uncompressed document, nil for other
+	// media.
+	Original *tg.Document
+}
+
+// option returns media option to send uploaded media with caption.
+func (u uploadedMedia) option(caption ...styling.StyledTextOption) message.MultiMediaOption {
+	if u.Photo != nil {
+		return message.Photo(u.Photo, caption...)
+	}
+	return message.Document(u.Document, caption...)
+}
+
+// download fetches video or photo of job, or every entry of post, and
+// uploads it to telegram, associating media with the chat of reply builder.
+// Video that exceeds upload limit can be split into multiple documents.
+//
+// The returned media can be sent to any chat multiple times. Bytes of every
+// download are counted by traffic.
+func (b *Bot) download(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	j *ent.Job,
+	entries []*ytdlp.Video,
+	traffic *ytio.Counter,
+	report func(Status),
+) (*result, error) {
+	dir := b.jobDir(j)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, errors.Wrap(err, "create job dir")
+	}
+
+	httpClient, err := ytdlp.NewHTTPClientWithProxy(b.proxy)
+	if err != nil {
+		return nil, errors.Wrap(err, "create http client")
+	}
+	httpClient = traffic.Client(httpClient)
+
+	if len(entries) == 0 {
+		// Resumed job, info is fetched again because format URLs expire.
+		report(Status{Stage: StageInfo})
+
+		start := time.Now()
+		if entries, err = b.ytdlp().Entries(ctx, j.URL); err != nil {
+			return nil, errors.Wrap(err, "fetch video info")
+		}
+		lg.Info("Got info",
+			zap.Duration("duration", time.Since(start)),
+			zap.String("title", entries[0].Title),
+			zap.Int("entries", len(entries)),
+		)
+	}
+	if albumOf(entries, j.Options) {
+		return b.downloadAlbum(ctx, lg, reply, j, entries, httpClient, report)
+	}
+	video := entries[0]
+	if image, ok := video.BestImage(); ok {
+		return b.downloadImage(ctx, lg, reply, j, video, image, httpClient, report)
+	}
+
+	choice, err := video.Select(j.Options.Format)
+	if err != nil {
+		return nil, errors.Wrap(err, "select format")
+	}
+	lg.Info("Selected format",
+		zap.Int("plan", int(choice.Plan())),
+		zap.String("video", choice.Video.FormatID),
+		zap.String("audio", choice.Audio.FormatID),
+	)
+
+	var (
+		downloaded     = new(ytio.Progress)
+		files, formats = choiceFiles(dir, choice, downloaded)
+	)
+
+	report(Status{Stage: StageDownload})
+	stopProgress := trackProgress(ctx, StageDownload, downloaded, report)
+
+	var (
+		g, gCtx = errgroup.WithContext(ctx)
+		inputs  = make([]media.ClipInput, len(files))
+	)
+	for i, file := range files {
+		format := formats[i]
+		g.Go(func() error {
+			// Clip needs only some segments of indexed formats.
+			ranges, from := clipRanges(gCtx, lg, format, j.Options, httpClient)
+			if err := ytdlp.DownloadRanges(gCtx, format, file, ranges, httpClient); err != nil {
+				lg.Error("Download error", zap.String("format", format.FormatID), zap.Error(err))
+				return errors.Wrapf(err, "download %s", format.FormatID)
+			}
+			inputs[i] = media.ClipInput{Path: file.Path}
+			if len(ranges) == 0 {
+				return nil
+			}
+
+			// Downloaded file is sparse, so segments are joined with header
+			// into playable file.
+			inputs[i] = media.ClipInput{Path: file.Path + "-clip", Offset: from}
+			if err := ytio.Extract(file.Path, inputs[i].Path, ranges); err != nil {
+				return errors.Wrapf(err, "extract %s", format.FormatID)
+			}
+
+			return nil
+		})
+	}
+
+	err = g.Wait()
+	stopProgress()
+	if err != nil {
+		return nil, errors.Wrap(err, "download")
+	}
+
+	if choice.Plan() == ytdlp.PlanAudio {
+		var (
+			inputPath = inputs[0].Path
+			format    = choice.Audio
+		)
+		if j.Options.Clip() {
+			report(Status{Stage: StageProcess})
+
+			inputPath = filepath.Join(dir, "clip.m4a")
+			if err := b.clip(ctx, j, video, inputs, inputPath, media.TargetVideo); err != nil {
+				return nil, errors.Wrap(err, "clip")
+			}
+			// Clip is encoded to AAC in m4a.
+			format = ytdlp.Format{FormatID: "clip", Ext: "m4a", ACodec: "mp4a.40.2"}
+		}
+		docs, err := b.audio(ctx, lg, reply, j, video, format, inputPath, httpClient, report)
+		if err != nil {
+			return nil, err
+		}
+		return &result{Video: video, Media: docs}, nil
+	}
+
+	report(Status{Stage: StageProcess})
+
+	if j.Options.Mode != "" {
+		// Animation, video note and sticker are small single documents.
+		target := targetOf(j.Options.Mode)
+		outputPath := filepath.Join(dir, "output"+target.Ext())
+		if err := b.clip(ctx, j, video, inputs, outputPath, target); err != nil {
+			return nil, errors.Wrap(err, "convert")
+		}
+		name := fileName(video.Title, "video", target.Ext())
+		doc, err := b.uploadVideo(ctx, lg, reply, outputPath, name, j.Options.Mode, report)
+		if err != nil {
+			return nil, errors.Wrap(err, "upload")
+		}
+		return &result{Video: video, Media: []uploadedMedia{{Document: doc}}}, nil
+	}
+
+	// Both progressive format and pair of formats are converted to single
+	// mp4 that is sent as video.
+	var (
+		outputPath = filepath.Join(dir, "output.mp4")
+		conversion media.Conversion
+	)
+	if j.Options.Clip() {
+		// Clip is re-encoded anyway.
+		if err := b.clip(ctx, j, video, inputs, outputPath, media.TargetVideo); err != nil {
+			return nil, errors.Wrap(err, "clip")
+		}
+		conversion = media.ConvertVideo
+	} else {
+		paths := make([]string, 0, len(inputs))
+		for _, in := range inputs {
+			paths = append(paths, in.Path)
+		}
+		metadata, chapters := containerMetadata(video, j.URL)
+		if conversion, err = b.media.ConvertMP4(ctx, media.ConvertOptions{
+			Inputs:   paths,
+			Output:   outputPath,
+			Metadata: metadata,
+			Chapters: chapters,
+			// Codec chosen on purpose is not converted back.
+			KeepVideo: j.Options.Codec != "",
+		}); err != nil {
+			return nil, errors.Wrap(err, "mux")
+		}
+	}
+	lg.Info("Converted", zap.String("conversion", string(conversion)))
+
+	paths, err := b.fitLimit(ctx, lg, outputPath, j.Options.Oversize)
+	if err != nil {
+		return nil, errors.Wrap(err, "fit upload limit")
+	}
+
+	uploadMode := ""
+	if j.Options.Document {
+		uploadMode = modeFile
+	}
+	docs := make([]uploadedMedia, 0, len(paths))
+	for i, path := range paths {
+		name := fileName(partTitle(video.Title, i, len(paths)), "video", ".mp4")
+		doc, err := b.uploadVideo(ctx, lg, reply, path, name, uploadMode, report)
+		if err != nil {
+			return nil, errors.Wrapf(err, "upload %s", filepath.Base(path))
+		}
+		docs = append(docs, uploadedMedia{Document: doc})
+	}
+
+	return &result{
+		Video:       video,
+		Media:       docs,
+		Conversion:  conversion,
+		Attachments: b.subtitles(ctx, lg, reply, dir, video, j.Options, httpClient),
+	}, nil
+}
+
+// choiceFiles returns files in dir to download formats of choice to.
+//
+// Files are named by format, so parts downloaded by interrupted attempt
+// are reused only if the same format is selected again.
+func choiceFiles(dir string, choice ytdlp.Choice, p *ytio.Progress) ([]*ytio.File, []ytdlp.Format) {
+	var (
+		files   []*ytio.File
+		formats []ytdlp.Format
+	)
+	for _, f := range []struct {
+		Kind   string
+		Format ytdlp.Format
+	}{
+		{Kind: "video", Format: choice.Video},
+		{Kind: "audio", Format: choice.Audio},
+	} {
+		if f.Format.FormatID == "" {
+			// Not needed by plan.
+			continue
+		}
+		files = append(files, &ytio.File{
+			Path:     filepath.Join(dir, f.Kind+"-"+f.Format.FormatID),
+			Progress: p,
+		})
+		formats = append(formats, f.Format)
+	}
+	return files, formats
+}
+
+// uploadVideo uploads video of mode at path as file name with first frame
+// as thumbnail.
+func (b *Bot) uploadVideo(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	outputPath string,
+	name string,
+	mode string,
+	report func(Status),
+) (*tg.Document, error) {
+	// Pick first frame of video as thumbnail.
+	previewPath := strings.TrimSuffix(outputPath, filepath.Ext(outputPath)) + ".jpg"
+
+	if err := b.media.ExtractFrame(ctx, outputPath, previewPath, 0, thumbSize); err != nil {
+		return nil, errors.Wrap(err, "preview")
+	}
+
+	info, err := b.media.Probe(ctx, outputPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "probe output")
+	}
+
+	report(Status{Stage: StageUpload})
+
+	thumbnail, err := b.uploader(lg, nil).FromPath(ctx, previewPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "upload")
+	}
+
+	lg.Info("Got summary",
+		zap.Duration("duration", info.Duration),
+		zap.Int("width", info.Width),
+		zap.Int("height", info.Height),
+	)
+
+	outputFile, err := os.Open(outputPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "open output")
+	}
+	defer func() { _ = outputFile.Close() }()
+
+	stat, err := outputFile.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "stat output")
+	}
+
+	uploaded := new(ytio.Progress)
+	stopProgress := trackProgress(ctx, StageUpload, uploaded, report)
+	inputClass, err := b.uploader(lg, uploaded).
+		Upload(ctx, uploader.NewUpload(name, outputFile, stat.Size()))
+	stopProgress()
+	if err != nil {
+		return nil, errors.Wrap(err, "upload")
+	}
+	lg.Info("Uploaded")
+
+	uploadedDocument := modeDocument(message.UploadedDocument(inputClass).
+		Filename(name).
+		Thumb(thumbnail), mode, info)
+
+	// Upload media without sending, so every requester of the same video
+	// can send the resulting document.
+	res, err := reply.UploadMedia(ctx, uploadedDocument)
+	if err != nil {
+		return nil, errors.Wrap(err, "upload media")
+	}
+
+	return uploadedDocumentFrom(res)
+}
+
+func uploadedDocumentFrom(media tg.MessageMediaClass) (*tg.Document, error) {
+	m, ok := media.(*tg.MessageMediaDocument)
+	if !ok {
+		return nil, errors.Errorf("unexpected media %T", media)
+	}
+	doc, ok := m.Document.(*tg.Document)
+	if !ok {
+		return nil, errors.Errorf("unexpected document %T", m.Document)
+	}
+
+	return doc, nil
+}
+
+func (b *Bot) uploader(lg *zap.Logger, p *ytio.Progress) *uploader.Uploader {
+	return uploader.NewUploader(b.uploadAPI).
+		WithPartSize(uploader.MaximumPartSize).
+		WithThreads(b.threads).
+		WithProgress(ZapProgressHandler{
+			Logger:   lg.Named("uploader"),
+			Progress: p,
+		})
+}
@@ -0,0 +1,319 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/job"
+	"github.com/ernado/tentacle/internal/failure"
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message/markup"
+	"github.com/gotd/td/tg"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// retryTimeout is how long retry button of failure works.
+const retryTimeout = time.Hour * 24
+
+// reportWindow is interval within which the same failure is reported to
+// admin chat once.
+const reportWindow = time.Hour
+
+const retryPrefix = "retry:"
+
+// retries is registry of failures that can be retried.
+type retries struct {
+	mux     sync.Mutex
+	pending map[uint64]retry
+}
+
+type retry struct {
+	userID int64
+	at     time.Time
+	status *statusMessage
+	run    func(ctx context.Context) error
+}
+
+// Add failure of user, returning its id. Expired failures are removed.
+func (r *retries) Add(userID int64, status *statusMessage, run func(ctx context.Context) error) uint64 {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.pending == nil {
+		r.pending = make(map[uint64]retry)
+	}
+	now := time.Now()
+	for id, v := range r.pending {
+		if now.Sub(v.at) > retryTimeout {
+			delete(r.pending, id)
+		}
+	}
+	id := randomID(r.pending)
+	r.pending[id] = retry{userID: userID, at: now, status: status, run: run}
+
+	return id
+}
+
+// Take removes failure of user, reporting whether it exists and is owned
+// by user.
+func (r *retries) Take(id uint64, userID int64) (retry, bool) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	v, ok := r.pending[id]
+	if !ok || v.userID != userID || time.Since(v.at) > retryTimeout {
+		return retry{}, false
+	}
+	delete(r.pending, id)
+
+	return v, true
+}
+
+func retryMarkup(loc *i18n.Locale, id uint64) tg.ReplyMarkupClass {
+	return markup.InlineRow(
+		markup.Callback(loc.T("retry.button"), []byte(retryPrefix+strconv.FormatUint(id, 10))),
+	)
+}
+
+// onRetryCallback handles retry button of failure, running retry in
+// background, so button press is answered immediately.
+func (b *Bot) onRetryCallback(ctx context.Context, loc *i18n.Locale, u *tg.UpdateBotCallbackQuery) (string, error) {
+	id, err := strconv.ParseUint(strings.TrimPrefix(string(u.Data), retryPrefix), 10, 64)
+	if err != nil {
+		return "", errors.Wrap(err, "parse retry id")
+	}
+	r, ok := b.retries.Take(id, u.UserID)
+	if !ok {
+		return loc.T("retry.expired"), nil
+	}
+
+	go func() {
+		r.status.ClearMarkup(ctx)
+		if err := r.run(ctx); err != nil {
+			b.lg.Warn("Retry failed", zap.Error(err))
+		}
+	}()
+
+	return loc.T("retry.started"), nil
+}
+
+// failureText returns message about failure of kind at stage in language
+// of locale.
+func failureText(loc *i18n.Locale, kind failure.Kind, stage Stage) string {
+	switch kind {
+	case failure.KindUnavailable:
+		return loc.T("failure.unavailable")
+	case failure.KindLogin:
+		return loc.T("failure.login")
+	case failure.KindGeo:
+		return loc.T("failure.geo")
+	case failure.KindUnsupported:
+		return loc.T("failure.unsupported")
+	case failure.KindFormat:
+		return loc.T("failure.format")
+	case failure.KindTimeout:
+		return loc.T("failure.timeout")
+	case failure.KindNetwork:
+		return loc.T("failure.network")
+	case failure.KindProcess:
+		return loc.T("failure.process")
+	case failure.KindTelegram:
+		return loc.T("failure.telegram")
+	}
+	switch stage {
+	case StageInfo:
+		return loc.T("failure.info")
+	case StageDownload:
+		return loc.T("failure.download")
+	case StageProcess:
+		return loc.T("failure.process")
+	case StageUpload:
+		return loc.T("failure.telegram")
+	default:
+		return loc.T("failure.unknown")
+	}
+}
+
+// requester returns description of author and chat of request for
+// reports.
+func requester(from, chat tg.PeerClass) string {
+	fromType, fromID := grantPeer(from)
+	if samePeer(from, chat) {
+		return fmt.Sprintf("%s %d", fromType, fromID)
+	}
+	chatType, chatID := grantPeer(chat)
+	return fmt.Sprintf("%s %d in %s %d", fromType, fromID, chatType, chatID)
+}
+
+// fail shows failure of report to requester with button that calls retry,
+// and reports it to admin chat. Only user with userID can retry, nobody if
+// it is zero. Failures of canceled ctx are ignored, as well as unsupported
+// links that were not addressed to bot.
+func (b *Bot) fail(ctx context.Context, status *statusMessage, userID int64, r failure.Report, run func(ctx context.Context) error) {
+	if ctx.Err() != nil {
+		return
+	}
+	kind := failure.Classify(r.Err)
+	if status.quiet && kind == failure.KindUnsupported {
+		// Links of other messages are fetched only if supported.
+		status.Delete(ctx)
+		return
+	}
+	stage := status.Stage()
+	r.Stage = stage.Text(i18n.Lookup(i18n.Default))
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		r.TraceID = sc.TraceID().String()
+	}
+
+	text := failureText(status.loc, kind, stage)
+	if status.quiet {
+		// Deleted anyway, so nothing to retry.
+		status.Fail(ctx, text, nil)
+	} else {
+		id := b.retries.Add(userID, status, run)
+		status.Fail(ctx, text, retryMarkup(status.loc, id))
+	}
+
+	b.report(ctx, r)
+}
+
+// report sends failure report to admin chat, unless the same failure was
+// reported recently.
+func (b *Bot) report(ctx context.Context, r failure.Report) {
+	peer := b.adminChat.InputPeer()
+	if peer == nil {
+		return
+	}
+	send, repeated := b.failures.Add(failure.Fingerprint(r.Stage, r.Err), time.Now())
+	if !send {
+		return
+	}
+	r.Repeated = repeated
+	if _, err := b.sender.To(peer).Text(ctx, r.Text()); err != nil {
+		b.lg.Warn("Failed to report failure", zap.Error(err))
+	}
+}
+
+// jobMessage returns message of job request, with only chat and author
+// set, so access and quota of requester can be checked again.
+func jobMessage(j *ent.Job) *tg.Message {
+	return &tg.Message{
+		ID:     j.MessageID,
+		PeerID: storedPeer{Type: j.PeerType, ID: j.PeerID}.Peer(),
+		FromID: jobSender(j),
+	}
+}
+
+// retryJob runs failed job again as new job with the same options, if
+// requester still has access and quota.
+func (b *Bot) retryJob(ctx context.Context, j *ent.Job) error {
+	var (
+		m     = jobMessage(j)
+		loc   = b.jobLocale(ctx, j)
+		reply = b.sender.To(jobPeer(j)).Reply(j.MessageID)
+	)
+	r, err := b.roleOf(ctx, command.Input{Message: m})
+	if err != nil {
+		return errors.Wrap(err, "role")
+	}
+	if r == nil {
+		if _, err := reply.Text(ctx, loc.T("access.denied")); err != nil {
+			return errors.Wrap(err, "reply")
+		}
+		return nil
+	}
+	subjects, err := b.usageSubjects(ctx, m, r)
+	if err != nil {
+		return errors.Wrap(err, "usage subjects")
+	}
+	peer := storedPeer{Type: j.PeerType, ID: j.PeerID, AccessHash: j.AccessHash}
+	retried, exceeded, err := b.reserveJob(ctx, loc, m, peer, subjects, j.URL, j.Options)
+	if err != nil {
+		return err
+	}
+	if exceeded != "" {
+		if _, err := reply.Text(ctx, exceeded); err != nil {
+			return errors.Wrap(err, "reply")
+		}
+		return nil
+	}
+
+	return b.runJob(ctx, retried, nil, nil)
+}
+
+// channelIDShift is offset of channel ids in bot API chat ids, so
+// supergroup 1234 is -1000000001234.
+const channelIDShift = 1_000_000_000_000
+
+// adminChat is chat that receives failure reports, identified by bot API
+// chat id. Access hash of chat is learned from updates, so bot must see a
+// message of chat, like a command, before reports are delivered there.
+type adminChat struct {
+	id int64
+
+	mux        sync.Mutex
+	accessHash int64
+}
+
+// peer returns peer of chat.
+func (c *adminChat) peer() storedPeer {
+	switch {
+	case c.id > 0:
+		return storedPeer{Type: job.PeerTypeUser, ID: c.id}
+	case c.id <= -channelIDShift:
+		return storedPeer{Type: job.PeerTypeChannel, ID: -c.id - channelIDShift}
+	default:
+		return storedPeer{Type: job.PeerTypeChat, ID: -c.id}
+	}
+}
+
+// InputPeer returns input peer of chat, nil if chat is not set.
+func (c *adminChat) InputPeer() tg.InputPeerClass {
+	if c.id == 0 {
+		return nil
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	p := c.peer()
+	p.AccessHash = c.accessHash
+	return p.InputPeer()
+}
+
+// Learn access hash of chat from update entities.
+func (c *adminChat) Learn(e tg.Entities) {
+	if c.id == 0 {
+		return
+	}
+	p := c.peer()
+	var accessHash int64
+	switch p.Type {
+	case job.PeerTypeUser:
+		u, ok := e.Users[p.ID]
+		if !ok {
+			return
+		}
+		accessHash = u.AccessHash
+	case job.PeerTypeChannel:
+		ch, ok := e.Channels[p.ID]
+		if !ok {
+			return
+		}
+		accessHash = ch.AccessHash
+	default:
+		return
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.accessHash = accessHash
+}
@@ -0,0 +1,57 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// maxFileName limits length of file name in runes, without extension.
+const maxFileName = 100
+
+// partTitle returns title of part i of n parts. It has no words, because
+// uploaded parts are shared by requesters that speak different languages.
+func partTitle(title string, i, n int) string {
+	if n <= 1 {
+		return title
+	}
+	return fmt.Sprintf("%s (%d)", title, i+1)
+}
+
+// fileName returns file name from title with extension ext, replacing
+// characters that are not allowed in file names. Fallback is used if
+// nothing is left of title.
+func fileName(title, fallback, ext string) string {
+	var (
+		b     strings.Builder
+		n     int
+		space bool
+	)
+	for _, r := range strings.TrimSpace(title) {
+		if n >= maxFileName {
+			break
+		}
+		switch {
+		case unicode.IsControl(r):
+			continue
+		case unicode.IsSpace(r):
+			// Collapse whitespace.
+			if space {
+				continue
+			}
+			r, space = ' ', true
+		case strings.ContainsRune(`/\:*?"<>|`, r):
+			r, space = '_', false
+		default:
+			space = false
+		}
+		b.WriteRune(r)
+		n++
+	}
+	// Leading dot hides file and trailing dots are removed by Windows.
+	name := strings.Trim(b.String(), ". ")
+	if name == "" {
+		name = fallback
+	}
+	return name + ext
+}
@@ -0,0 +1,253 @@
+package bot
+
+import (
+	"context"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent/preference"
+	"github.com/ernado/tentacle/internal/links"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tg"
+)
+
+// isPrivate reports whether message is in private chat with bot.
+func isPrivate(m *tg.Message) bool {
+	_, ok := m.PeerID.(*tg.PeerUser)
+	return ok
+}
+
+// addressed reports whether message is addressed to bot: sent in private
+// chat, is command, mentions bot or replies to its message.
+func (b *Bot) addressed(in command.Input) bool {
+	m := in.Message
+	if isPrivate(m) || in.Name != "" || m.Mentioned {
+		// Telegram marks replies to bot messages as mentions too.
+		return true
+	}
+	username := b.router.Username()
+	if username == "" {
+		return false
+	}
+	units := utf16.Encode([]rune(m.Message))
+	for _, e := range m.Entities {
+		e, ok := e.(*tg.MessageEntityMention)
+		if !ok || e.Offset < 0 || e.Offset+e.Length > len(units) {
+			continue
+		}
+		mention := string(utf16.Decode(units[e.Offset : e.Offset+e.Length]))
+		if strings.EqualFold(mention, "@"+username) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupLinks returns links of message to request. In groups, only links of
+// messages that are addressed to bot are requested, unless group has auto
+// fetch enabled. Message that is addressed to bot and has no links refers to
+// links of replied message.
+func (b *Bot) groupLinks(ctx context.Context, in command.Input) ([]string, error) {
+	m := in.Message
+	list := links.Extract(m.Message, m.Entities)
+	if isPrivate(m) {
+		return list, nil
+	}
+	if b.addressed(in) {
+		if len(list) > 0 {
+			return list, nil
+		}
+		return b.repliedLinks(ctx, in)
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	peer, err := peerFrom(in.Entities, m.PeerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "peer")
+	}
+	p, err := b.preferenceOf(ctx, peer)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil || !p.AutoFetch {
+		return nil, nil
+	}
+	return list, nil
+}
+
+// repliedLinks returns links of message that message of input replies to.
+func (b *Bot) repliedLinks(ctx context.Context, in command.Input) ([]string, error) {
+	header, ok := in.Message.ReplyTo.(*tg.MessageReplyHeader)
+	if !ok {
+		return nil, nil
+	}
+	id, ok := header.GetReplyToMsgID()
+	if !ok {
+		return nil, nil
+	}
+
+	var (
+		ids = []tg.InputMessageClass{&tg.InputMessageID{ID: id}}
+		res tg.MessagesMessagesClass
+		err error
+	)
+	if p, ok := in.Message.PeerID.(*tg.PeerChannel); ok {
+		c, ok := in.Entities.Channels[p.ChannelID]
+		if !ok {
+			return nil, errors.Errorf("channel %d not found", p.ChannelID)
+		}
+		res, err = b.api.ChannelsGetMessages(ctx, &tg.ChannelsGetMessagesRequest{
+			Channel: c.AsInput(),
+			ID:      ids,
+		})
+	} else {
+		res, err = b.api.MessagesGetMessages(ctx, ids)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "get replied message")
+	}
+	modified, ok := res.AsModified()
+	if !ok {
+		return nil, nil
+	}
+	for _, msg := range modified.GetMessages() {
+		if msg, ok := msg.(*tg.Message); ok {
+			return links.Extract(msg.Message, msg.Entities), nil
+		}
+	}
+	return nil, nil
+}
+
+// isChatAdmin reports whether author of message is admin of group. Every
+// user is admin of private chat with bot.
+func (b *Bot) isChatAdmin(ctx context.Context, in command.Input) (bool, error) {
+	return b.isAdminOf(ctx, in.Entities, in.Message.PeerID, senderPeer(in.Message))
+}
+
+// isAdminOf reports whether author from is admin of chat peer.
+func (b *Bot) isAdminOf(ctx context.Context, e tg.Entities, peer, from tg.PeerClass) (bool, error) {
+	if samePeer(from, peer) {
+		// Private chat or anonymous admin that sends as supergroup.
+		return true, nil
+	}
+	author, ok := from.(*tg.PeerUser)
+	if !ok {
+		// Channel that is not the group itself.
+		return false, nil
+	}
+	user := author.UserID
+	switch p := peer.(type) {
+	case *tg.PeerChannel:
+		c, ok := e.Channels[p.ChannelID]
+		if !ok {
+			return false, errors.Errorf("channel %d not found", p.ChannelID)
+		}
+		u, ok := e.Users[user]
+		if !ok {
+			return false, errors.Errorf("user %d not found", user)
+		}
+		res, err := b.api.ChannelsGetParticipant(ctx, &tg.ChannelsGetParticipantRequest{
+			Channel:     c.AsInput(),
+			Participant: u.AsInputPeer(),
+		})
+		if err != nil {
+			return false, errors.Wrap(err, "get participant")
+		}
+		switch res.Participant.(type) {
+		case *tg.ChannelParticipantCreator, *tg.ChannelParticipantAdmin:
+			return true, nil
+		}
+		return false, nil
+	case *tg.PeerChat:
+		full, err := b.api.MessagesGetFullChat(ctx, p.ChatID)
+		if err != nil {
+			return false, errors.Wrap(err, "get full chat")
+		}
+		chat, ok := full.FullChat.(*tg.ChatFull)
+		if !ok {
+			return false, nil
+		}
+		participants, ok := chat.Participants.(*tg.ChatParticipants)
+		if !ok {
+			return false, nil
+		}
+		for _, participant := range participants.Participants {
+			switch participant := participant.(type) {
+			case *tg.ChatParticipantCreator:
+				if participant.UserID == user {
+					return true, nil
+				}
+			case *tg.ChatParticipantAdmin:
+				if participant.UserID == user {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	default:
+		return false, nil
+	}
+}
+
+// adminOnly returns handler that allows only admins of group to run h.
+func (b *Bot) adminOnly(h command.Handler) command.Handler {
+	return func(ctx context.Context, in command.Input) error {
+		ok, err := b.isChatAdmin(ctx, in)
+		if err != nil {
+			return errors.Wrap(err, "check admin")
+		}
+		if !ok {
+			return b.replyT(ctx, in, "access.chat_admin_only")
+		}
+		return h(ctx, in)
+	}
+}
+
+// onAutoFetchCommand handles "/autofetch [on|off]" command, that shows or
+// sets whether every link in group is requested.
+func (b *Bot) onAutoFetchCommand(ctx context.Context, in command.Input) error {
+	peer, err := peerFrom(in.Entities, in.Message.PeerID)
+	if err != nil {
+		return errors.Wrap(err, "peer")
+	}
+
+	var autoFetch bool
+	switch args := in.Fields(); {
+	case len(args) == 0:
+		p, err := b.preferenceOf(ctx, peer)
+		if err != nil {
+			return err
+		}
+		state := "off"
+		if p != nil && p.AutoFetch {
+			state = "on"
+		}
+		loc := b.locale(ctx, in)
+		return b.reply(ctx, in, loc.T("autofetch.state", state)+"\n"+b.router.Usage(loc, in.Name))
+	case len(args) == 1 && args[0] == "on":
+		autoFetch = true
+	case len(args) == 1 && args[0] == "off":
+	default:
+		return command.ErrUsage
+	}
+
+	if err := b.db.Preference.Create().
+		SetPeerType(preference.PeerType(peer.Type)).
+		SetPeerID(peer.ID).
+		SetAutoFetch(autoFetch).
+		OnConflictColumns(preference.FieldPeerType, preference.FieldPeerID).
+		UpdateAutoFetch().
+		UpdateUpdatedAt().
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "save preference")
+	}
+
+	if autoFetch {
+		return b.replyT(ctx, in, "autofetch.on")
+	}
+	return b.replyT(ctx, in, "autofetch.off")
+}
@@ -0,0 +1,159 @@
+package bot
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/media"
+	"github.com/ernado/tentacle/internal/ytdlp"
+	"github.com/ernado/tentacle/internal/ytio"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/uploader"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+)
+
+// downloadImage downloads image of photo post and uploads it as photo.
+func (b *Bot) downloadImage(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	j *ent.Job,
+	video *ytdlp.Video,
+	format ytdlp.Format,
+	httpClient *http.Client,
+	report func(Status),
+) (*result, error) {
+	lg.Info("Selected image",
+		zap.String("format", format.FormatID),
+		zap.String("ext", format.Ext),
+	)
+	var (
+		downloaded = new(ytio.Progress)
+		file       = &ytio.File{
+			Path:     filepath.Join(b.jobDir(j), "image-"+format.FormatID),
+			Progress: downloaded,
+		}
+	)
+	report(Status{Stage: StageDownload})
+	stopProgress := trackProgress(ctx, StageDownload, downloaded, report)
+	err := ytdlp.DownloadChunked(ctx, format, file, httpClient)
+	stopProgress()
+	if err != nil {
+		return nil, errors.Wrap(err, "download")
+	}
+
+	name := fileName(video.Title, "image", "."+format.Ext)
+	photo, err := b.uploadImage(ctx, lg, reply, file.Path, name, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result{Video: video, Media: []uploadedMedia{photo}}, nil
+}
+
+// uploadImage uploads image at path as photo, converting it to JPEG if
+// telegram would not render it, and the original file as document name.
+func (b *Bot) uploadImage(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	path string,
+	name string,
+	report func(Status),
+) (uploadedMedia, error) {
+	report(Status{Stage: StageProcess})
+
+	info, err := b.media.Probe(ctx, path)
+	if err != nil {
+		return uploadedMedia{}, errors.Wrap(err, "probe image")
+	}
+	photoPath := path
+	if !media.PhotoCompatible(info) {
+		photoPath = path + ".jpg"
+		if err := b.media.ConvertPhoto(ctx, path, photoPath); err != nil {
+			return uploadedMedia{}, errors.Wrap(err, "convert")
+		}
+		lg.Info("Converted image", zap.String("codec", info.VideoCodec))
+	}
+
+	report(Status{Stage: StageUpload})
+
+	photoFile, err := b.uploader(lg, nil).FromPath(ctx, photoPath)
+	if err != nil {
+		return uploadedMedia{}, errors.Wrap(err, "upload photo")
+	}
+	res, err := reply.UploadMedia(ctx, message.UploadedPhoto(photoFile))
+	if err != nil {
+		return uploadedMedia{}, errors.Wrap(err, "upload photo media")
+	}
+	photo, err := uploadedPhotoFrom(res)
+	if err != nil {
+		return uploadedMedia{}, err
+	}
+
+	// Original is always uploaded, because result is shared by requesters
+	// that can differ in whether they want it.
+	original, err := b.uploadFile(ctx, lg, reply, path, name)
+	if err != nil {
+		return uploadedMedia{}, errors.Wrap(err, "upload original")
+	}
+	lg.Info("Uploaded")
+
+	return uploadedMedia{Photo: photo, Original: original}, nil
+}
+
+// uploadFile uploads file at path as document name, that is shown as file
+// regardless of its type.
+func (b *Bot) uploadFile(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	path string,
+	name string,
+) (*tg.Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open")
+	}
+	defer func() { _ = f.Close() }()
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "stat")
+	}
+	inputClass, err := b.uploader(lg, nil).
+		Upload(ctx, uploader.NewUpload(name, f, stat.Size()))
+	if err != nil {
+		return nil, errors.Wrap(err, "upload")
+	}
+	doc := message.UploadedDocument(inputClass).
+		Filename(name).
+		ForceFile(true)
+	if mimeType := mime.TypeByExtension(filepath.Ext(name)); mimeType != "" {
+		doc = doc.MIME(mimeType)
+	}
+	res, err := reply.UploadMedia(ctx, doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "upload media")
+	}
+	return uploadedDocumentFrom(res)
+}
+
+func uploadedPhotoFrom(media tg.MessageMediaClass) (*tg.Photo, error) {
+	m, ok := media.(*tg.MessageMediaPhoto)
+	if !ok {
+		return nil, errors.Errorf("unexpected media %T", media)
+	}
+	photo, ok := m.Photo.(*tg.Photo)
+	if !ok {
+		return nil, errors.Errorf("unexpected photo %T", m.Photo)
+	}
+
+	return photo, nil
+}
@@ -0,0 +1,332 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ernado/tentacle/internal/caption"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/job"
+	"github.com/ernado/tentacle/internal/ent/schema"
+	"github.com/ernado/tentacle/internal/failure"
+	"github.com/ernado/tentacle/internal/i18n"
+	"github.com/ernado/tentacle/internal/queue"
+	"github.com/ernado/tentacle/internal/ytdlp"
+	"github.com/ernado/tentacle/internal/ytio"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message"
+	"go.uber.org/zap"
+)
+
+// maxAttempts limits how many times job is started, including resumes after
+// restart.
+const maxAttempts = 3
+
+// jobDir returns directory for files of job.
+func (b *Bot) jobDir(j *ent.Job) string {
+	return filepath.Join(b.workDir, "tentacle-job-"+strconv.Itoa(j.ID))
+}
+
+// jobKey returns key of job result, so requests of the same video with
+// the same options share one download, even if links differ.
+func jobKey(j *ent.Job) string {
+	uri, err := ytdlp.CanonicalURL(j.URL)
+	if err != nil {
+		uri = j.URL
+	}
+	options, _ := json.Marshal(j.Options)
+	return uri + " " + string(options)
+}
+
+// runJob runs job and sends result to requester, tracking job state in
+// database.
+//
+// Entries of job url and status message are optional and are reused if
+// set.
+//
+// If ctx is canceled, job state is not changed so it can be resumed later.
+// Failure is shown to requester with retry button and reported to admin
+// chat.
+func (b *Bot) runJob(ctx context.Context, j *ent.Job, entries []*ytdlp.Video, status *statusMessage) error {
+	ctx, span := b.tracer.Start(ctx, "Job")
+	defer span.End()
+
+	ctx, done := b.active.Add(ctx, j)
+	defer done()
+
+	var (
+		peer  = jobPeer(j)
+		reply = b.sender.To(peer).Reply(j.MessageID)
+		lg    = b.lg.With(zap.Int("job_id", j.ID), zap.Int("msg_id", j.MessageID))
+	)
+	if status == nil {
+		// Replies stay in thread of request, including forum topic.
+		answer := b.sender.To(peer)
+		answer.Reply(j.MessageID)
+		status = &statusMessage{
+			answer: answer,
+			loc:    b.jobLocale(ctx, j),
+			lg:     lg,
+		}
+	}
+	status.SetMarkup(cancelMarkup(status.loc, j))
+
+	var (
+		key  = jobKey(j)
+		task = queue.Task{
+			UserID: j.UserID,
+			ChatID: j.PeerID,
+		}
+		started sync.Once
+	)
+	res, shared, err := b.jobs.Do(ctx, key, func(ctx context.Context, report func(Status)) (res *result, err error) {
+		task.OnPosition = func(pos int) {
+			report(Status{Stage: StageQueued, Position: pos})
+		}
+		var traffic ytio.Counter
+		err = b.queue.Do(ctx, task, func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, jobTimeout)
+			defer cancel()
+
+			res, err = b.download(ctx, lg, reply, j, entries, &traffic, report)
+			return err
+		})
+		if res == nil {
+			res = new(result)
+		}
+		res.Traffic = traffic.Bytes()
+		if err != nil && ctx.Err() != nil && !isCanceled(ctx) {
+			// Shutdown, keep files to resume later.
+			return res, err
+		}
+		if rmErr := os.RemoveAll(b.jobDir(j)); rmErr != nil {
+			lg.Warn("Failed to remove job dir", zap.Error(rmErr))
+		}
+
+		return res, err
+	}, func(s Status) {
+		if s.Stage != StageQueued {
+			started.Do(func() {
+				if err := b.startJob(ctx, j); err != nil {
+					lg.Warn("Failed to update job", zap.Error(err))
+				}
+			})
+		}
+		status.Update(ctx, s)
+	})
+	if shared {
+		lg.Info("Attached to in-flight job", zap.String("key", key))
+	}
+	if res != nil {
+		// Every requester that waited for download is charged for its
+		// traffic, even if download failed, so failing links are not free.
+		if trafficErr := b.recordTraffic(context.WithoutCancel(ctx), j, res.Traffic); trafficErr != nil {
+			lg.Warn("Failed to record traffic", zap.Error(trafficErr))
+		}
+	}
+	if err == nil {
+		err = b.sendResult(ctx, status.loc, reply, j, res)
+	}
+	if isCanceled(ctx) {
+		status.Finalize(context.WithoutCancel(ctx), status.loc.T("cancel.done"))
+		if finishErr := b.finishJob(context.WithoutCancel(ctx), j, nil, errJobCanceled); finishErr != nil {
+			lg.Warn("Failed to update job", zap.Error(finishErr))
+		}
+		return nil
+	}
+	if ctx.Err() != nil {
+		// Shutdown, will be resumed.
+		finalizeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*5)
+		defer cancel()
+		status.Finalize(finalizeCtx, status.loc.T("job.interrupted"))
+		return ctx.Err()
+	}
+	if err != nil {
+		b.fail(ctx, status, jobUser(j), failure.Report{
+			JobID:     j.ID,
+			URL:       j.URL,
+			Requester: requester(jobSender(j), storedPeer{Type: j.PeerType, ID: j.PeerID}.Peer()),
+			Err:       err,
+		}, func(ctx context.Context) error {
+			return b.retryJob(ctx, j)
+		})
+	} else {
+		status.Delete(ctx)
+	}
+	if finishErr := b.finishJob(ctx, j, res, err); finishErr != nil {
+		lg.Warn("Failed to update job", zap.Error(finishErr))
+	}
+
+	return err
+}
+
+// sendResult sends media of job result, captioning the first one with
+// template of chat and every one with clip range, part of split video and
+// conversion that was made in language of locale. Original files of photos
+// follow if chat wants them.
+func (b *Bot) sendResult(ctx context.Context, loc *i18n.Locale, reply *message.Builder, j *ent.Job, res *result) error {
+	p, err := b.preferenceOf(ctx, storedPeer{Type: j.PeerType, ID: j.PeerID})
+	if err != nil {
+		return errors.Wrap(err, "preference")
+	}
+	var head []caption.Line
+	if res.Video != nil {
+		tpl, err := captionTemplate(p)
+		if err != nil {
+			return errors.Wrap(err, "caption template")
+		}
+		head = tpl.Render(caption.DataOf(res.Video, j.URL))
+	}
+	if res.Album {
+		err = sendAlbum(ctx, reply, res.Media, caption.Options(caption.Fit(head, nil, caption.Limit)))
+	} else {
+		err = sendParts(ctx, loc, reply, j.Options, res, head)
+	}
+	if err != nil {
+		return err
+	}
+
+	var attachments []uploadedMedia
+	for _, doc := range res.Attachments {
+		attachments = append(attachments, uploadedMedia{Document: doc})
+	}
+	if err := sendAlbum(ctx, reply, attachments, nil); err != nil {
+		return errors.Wrap(err, "send attachments")
+	}
+
+	if p == nil || !p.Originals {
+		return nil
+	}
+	var originals []uploadedMedia
+	for _, item := range res.Media {
+		if item.Original != nil {
+			originals = append(originals, uploadedMedia{Document: item.Original})
+		}
+	}
+	if err := sendAlbum(ctx, reply, originals, nil); err != nil {
+		return errors.Wrap(err, "send originals")
+	}
+
+	return nil
+}
+
+// sendParts sends media of result one by one, where head is caption of
+// the first one.
+func sendParts(
+	ctx context.Context,
+	loc *i18n.Locale,
+	reply *message.Builder,
+	opt schema.JobOptions,
+	res *result,
+	head []caption.Line,
+) error {
+	for i, item := range res.Media {
+		if opt.Mode == modeNote || opt.Mode == modeSticker {
+			// Video notes and stickers can not have caption.
+			if _, err := reply.Media(ctx, item.option()); err != nil {
+				return errors.Wrap(err, "send document")
+			}
+			continue
+		}
+		var tail []string
+		if opt.Clip() {
+			tail = append(tail, clipCaption(loc, opt))
+		}
+		if len(res.Media) > 1 {
+			tail = append(tail, loc.T("caption.part", i+1, len(res.Media)))
+		}
+		if i == 0 && res.Conversion != "" {
+			tail = append(tail, conversionText(loc, res.Conversion))
+		}
+		var lines []caption.Line
+		if i == 0 {
+			lines = head
+		}
+		text := caption.Options(caption.Fit(lines, caption.PlainLines(tail...), caption.Limit))
+		if _, err := reply.Media(ctx, item.option(text...)); err != nil {
+			return errors.Wrap(err, "send media")
+		}
+	}
+	return nil
+}
+
+func (b *Bot) startJob(ctx context.Context, j *ent.Job) error {
+	return b.db.Job.UpdateOne(j).
+		SetState(job.StateRunning).
+		AddAttempts(1).
+		SetStartedAt(time.Now()).
+		Exec(ctx)
+}
+
+// finishJob records job outcome, where res is optional.
+func (b *Bot) finishJob(ctx context.Context, j *ent.Job, res *result, jobErr error) error {
+	u := b.db.Job.UpdateOne(j).
+		SetFinishedAt(time.Now()).
+		SetState(job.StateDone)
+	if res != nil && res.Conversion != "" {
+		u.SetConversion(job.Conversion(res.Conversion))
+	}
+	switch {
+	case errors.Is(jobErr, errJobCanceled):
+		u.SetState(job.StateCanceled)
+	case jobErr != nil:
+		u.SetState(job.StateFailed).SetError(jobErr.Error())
+	}
+	return u.Exec(ctx)
+}
+
+// Resume continues jobs that were queued or interrupted by restart.
+func (b *Bot) Resume(ctx context.Context) error {
+	jobs, err := b.db.Job.Query().
+		Where(job.StateIn(job.StateQueued, job.StateRunning)).
+		Order(ent.Asc(job.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return errors.Wrap(err, "query jobs")
+	}
+
+	for _, j := range jobs {
+		lg := b.lg.With(zap.Int("job_id", j.ID))
+		var (
+			answer = b.sender.To(jobPeer(j)).Reply(j.MessageID)
+			loc    = b.jobLocale(ctx, j)
+		)
+
+		if j.Attempts >= maxAttempts {
+			lg.Warn("Job exceeded attempts", zap.Int("attempts", j.Attempts))
+			if err := b.finishJob(ctx, j, nil, errors.New("too many attempts")); err != nil {
+				return errors.Wrap(err, "fail job")
+			}
+			if err := os.RemoveAll(b.jobDir(j)); err != nil {
+				lg.Warn("Failed to remove job dir", zap.Error(err))
+			}
+			if _, err := answer.Text(ctx, loc.T("job.attempts")); err != nil {
+				lg.Warn("Failed to notify", zap.Error(err))
+			}
+			continue
+		}
+
+		text := loc.T("job.requeued")
+		if j.State == job.StateRunning {
+			text = loc.T("job.resumed")
+		}
+		if _, err := answer.Text(ctx, text); err != nil {
+			lg.Warn("Failed to notify", zap.Error(err))
+		}
+
+		lg.Info("Resuming job", zap.Stringer("state", j.State), zap.Int("attempts", j.Attempts))
+		go func() {
+			if err := b.runJob(ctx, j, nil, nil); err != nil {
+				lg.Error("Resumed job failed", zap.Error(err))
+			}
+		}()
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package bot
+
+import (
+	"context"
+
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/i18n"
+	"github.com/ernado/tentacle/internal/media"
+
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+)
+
+// localeOf returns locale of language of preference, or of language of
+// telegram user if preference has none. Both are optional.
+func localeOf(p *ent.Preference, user *tg.User) *i18n.Locale {
+	if p != nil && p.Language != "" {
+		return i18n.Lookup(p.Language)
+	}
+	if user != nil {
+		return i18n.Lookup(user.LangCode)
+	}
+	return i18n.Lookup(i18n.Default)
+}
+
+// locale returns locale of replies to input.
+func (b *Bot) locale(ctx context.Context, in command.Input) *i18n.Locale {
+	p, err := b.requestPreference(ctx, in)
+	if err != nil {
+		b.lg.Warn("Failed to get preference", zap.Error(err))
+	}
+	return localeOf(p, in.Entities.Users[senderUser(in.Message)])
+}
+
+// callbackLocale returns locale of answers to button press.
+func (b *Bot) callbackLocale(ctx context.Context, e tg.Entities, u *tg.UpdateBotCallbackQuery) *i18n.Locale {
+	var p *ent.Preference
+	peer, err := peerFrom(e, u.Peer)
+	if err == nil {
+		p, err = b.preferenceOf(ctx, peer)
+	}
+	if err != nil {
+		b.lg.Warn("Failed to get preference", zap.Error(err))
+	}
+	return localeOf(p, e.Users[u.UserID])
+}
+
+// jobLocale returns locale of chat of job, that is used when requester is
+// not known, like for resumed jobs.
+func (b *Bot) jobLocale(ctx context.Context, j *ent.Job) *i18n.Locale {
+	p, err := b.preferenceOf(ctx, storedPeer{Type: j.PeerType, ID: j.PeerID})
+	if err != nil {
+		b.lg.Warn("Failed to get preference", zap.Error(err))
+	}
+	return localeOf(p, nil)
+}
+
+// replyT replies to input with message of key in language of input.
+func (b *Bot) replyT(ctx context.Context, in command.Input, key string, args ...any) error {
+	return b.reply(ctx, in, b.locale(ctx, in).T(key, args...))
+}
+
+// conversionText returns description of conversion in language of locale.
+func conversionText(loc *i18n.Locale, c media.Conversion) string {
+	switch c {
+	case media.ConvertCopy:
+		return loc.T("conversion.copy")
+	case media.ConvertAudio:
+		return loc.T("conversion.audio")
+	case media.ConvertVideo:
+		return loc.T("conversion.video")
+	default:
+		return string(c)
+	}
+}
@@ -0,0 +1,38 @@
+package bot
+
+import (
+	"github.com/ernado/tentacle/internal/media"
+	"github.com/ernado/tentacle/internal/ytdlp"
+)
+
+// containerMetadata returns metadata tags and chapters of video from url,
+// that are written to produced files, so they are useful once downloaded
+// from telegram.
+func containerMetadata(v *ytdlp.Video, url string) (map[string]string, []media.Chapter) {
+	metadata := map[string]string{
+		"title":   v.Title,
+		"artist":  v.Uploader,
+		"comment": url,
+	}
+	if d := v.UploadDate; len(d) == 8 {
+		metadata["date"] = d[:4] + "-" + d[4:6] + "-" + d[6:]
+	}
+	if v.Description != "" {
+		metadata["description"] = v.Description
+	}
+	for k, value := range metadata {
+		if value == "" {
+			delete(metadata, k)
+		}
+	}
+
+	chapters := make([]media.Chapter, 0, len(v.Chapters))
+	for _, c := range v.Chapters {
+		chapters = append(chapters, media.Chapter{
+			Start: c.Start(),
+			End:   c.End(),
+			Title: c.Title,
+		})
+	}
+	return metadata, chapters
+}
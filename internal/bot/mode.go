@@ -0,0 +1,96 @@
+package bot
+
+import (
+	"context"
+	"time"
+
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent/schema"
+	"github.com/ernado/tentacle/internal/media"
+	"github.com/ernado/tentacle/internal/ytdlp"
+
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/tg"
+)
+
+// Modes of job, that are kinds of produced media other than video.
+const (
+	modeAnimation = "animation"
+	modeNote      = "note"
+	modeSticker   = "sticker"
+)
+
+// modeFile is not mode of job, but upload mode of video that is sent as
+// file, see schema.JobOptions.Document.
+const modeFile = "file"
+
+// targetOf returns media target of mode.
+func targetOf(mode string) media.Target {
+	switch mode {
+	case modeAnimation:
+		return media.TargetAnimation
+	case modeNote:
+		return media.TargetVideoNote
+	case modeSticker:
+		return media.TargetSticker
+	default:
+		return media.TargetVideo
+	}
+}
+
+// onModeCommand returns handler of "/gif", "/note" and "/sticker" commands
+// of mode, that take url and optional time range.
+func (b *Bot) onModeCommand(mode string) command.Handler {
+	return func(ctx context.Context, in command.Input) error {
+		var (
+			args = in.Fields()
+			opt  = schema.JobOptions{Mode: mode, Format: ytdlp.FormatBest}
+			err  error
+		)
+		if len(args) == 2 {
+			opt.Start, opt.End, err = ytdlp.ParseRange(args[1])
+		}
+		if len(args) < 1 || len(args) > 2 || err != nil {
+			return command.ErrUsage
+		}
+		if limit := targetOf(opt.Mode).MaxDuration(); limit > 0 && (opt.End == 0 || opt.End-opt.Start > limit) {
+			// Limit range explicitly, so only needed segments are downloaded.
+			opt.End = opt.Start + limit
+		}
+
+		return b.request(ctx, in, args[0], opt)
+	}
+}
+
+// modeDocument returns media of uploaded video file of mode.
+func modeDocument(doc *message.UploadedDocumentBuilder, mode string, info *media.Info) message.MediaOption {
+	switch mode {
+	case modeAnimation:
+		return doc.MIME("video/mp4").
+			GIF().
+			Video().
+			Duration(info.Duration).
+			Resolution(info.Width, info.Height)
+	case modeNote:
+		return doc.MIME("video/mp4").
+			RoundVideo().
+			Duration(info.Duration).
+			Resolution(info.Width, info.Height)
+	case modeSticker:
+		return doc.MIME("video/webm").
+			Attributes(&tg.DocumentAttributeVideo{
+				Duration: info.Duration.Round(time.Millisecond).Seconds(),
+				W:        info.Width,
+				H:        info.Height,
+			}).
+			UploadedSticker()
+	case modeFile:
+		return doc.MIME("video/mp4").ForceFile(true)
+	default:
+		return doc.MIME("video/mp4").
+			Video().
+			Duration(info.Duration).
+			Resolution(info.Width, info.Height).
+			SupportsStreaming()
+	}
+}
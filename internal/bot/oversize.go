@@ -0,0 +1,144 @@
+package bot
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ernado/tentacle/internal/media"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+)
+
+// defaultUploadLimit is maximum size of file that bot can upload.
+const defaultUploadLimit int64 = 2000 << 20
+
+// Ways to deliver video that exceeds upload limit.
+const (
+	oversizeSplit    = "split"
+	oversizeCompress = "compress"
+)
+
+const (
+	// splitMargin is fraction of upload limit that is targeted by
+	// every part, because parts are cut only on keyframes.
+	splitMargin = 0.9
+	// compressMargin is fraction of upload limit that is targeted by
+	// re-encoding, because encoder does not hit bitrate exactly.
+	compressMargin = 0.95
+
+	compressAudioBitrate = 128_000
+	// compressMinBitrate is minimum video bitrate worth watching.
+	compressMinBitrate = 100_000
+)
+
+// pickOversize asks user how to deliver video of estimated size that
+// exceeds upload limit, splitting by default.
+func (b *Bot) pickOversize(ctx context.Context, status *statusMessage, userID int64, size int64) (string, error) {
+	var (
+		loc      = status.loc
+		question = loc.T("pick.oversize",
+			humanize.Bytes(uint64(size)), humanize.Bytes(uint64(b.uploadLimit)),
+		)
+	)
+	i, err := b.pick(ctx, status, userID, question, []string{
+		loc.T("pick.oversize.split"),
+		loc.T("pick.oversize.compress"),
+	})
+	if err != nil {
+		return "", err
+	}
+	if i == 1 {
+		return oversizeCompress, nil
+	}
+	return oversizeSplit, nil
+}
+
+// fitLimit returns paths of files that have content of video at path and
+// fit upload limit, splitting or compressing video according to mode.
+func (b *Bot) fitLimit(ctx context.Context, lg *zap.Logger, path, mode string) ([]string, error) {
+	info, err := b.media.Probe(ctx, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "probe")
+	}
+	if info.Size <= b.uploadLimit {
+		return []string{path}, nil
+	}
+	if info.Duration <= 0 {
+		return nil, errors.New("unknown duration")
+	}
+
+	lg.Info("Output exceeds upload limit",
+		zap.Int64("size", info.Size),
+		zap.Int64("limit", b.uploadLimit),
+		zap.String("mode", mode),
+	)
+	if mode == oversizeCompress {
+		out, err := b.compress(ctx, path, info.Duration)
+		if err != nil {
+			return nil, errors.Wrap(err, "compress")
+		}
+		return []string{out}, nil
+	}
+
+	parts, err := b.split(ctx, path, info.Size, info.Duration)
+	if err != nil {
+		return nil, errors.Wrap(err, "split")
+	}
+	return parts, nil
+}
+
+// split cuts video into independently playable parts on keyframes.
+func (b *Bot) split(ctx context.Context, path string, size int64, duration time.Duration) ([]string, error) {
+	count := math.Ceil(float64(size) / (float64(b.uploadLimit) * splitMargin))
+	parts, err := b.media.Segment(ctx, path, filepath.Dir(path), time.Duration(float64(duration)/count))
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range parts {
+		stat, err := os.Stat(p)
+		if err != nil {
+			return nil, errors.Wrap(err, "stat part")
+		}
+		if stat.Size() > b.uploadLimit {
+			// Keyframes are too sparse.
+			return nil, errors.Errorf("part %d is %s, over upload limit", i+1, humanize.Bytes(uint64(stat.Size())))
+		}
+	}
+
+	return parts, nil
+}
+
+// compress re-encodes video in two passes with bitrate that fits upload
+// limit.
+func (b *Bot) compress(ctx context.Context, path string, duration time.Duration) (string, error) {
+	bitrate := int64(float64(b.uploadLimit)*8*compressMargin/duration.Seconds()) - compressAudioBitrate
+	if bitrate < compressMinBitrate {
+		return "", errors.Errorf("video is too long to fit %s", humanize.Bytes(uint64(b.uploadLimit)))
+	}
+
+	dir := filepath.Dir(path)
+	opt := media.TranscodeOptions{
+		Inputs:       []string{path},
+		Output:       filepath.Join(dir, "compressed.mp4"),
+		VideoCodec:   media.H264,
+		VideoBitrate: bitrate,
+		Preset:       "medium",
+		PassLogFile:  filepath.Join(dir, "pass"),
+		AudioCodec:   media.AAC,
+		AudioBitrate: compressAudioBitrate,
+		FastStart:    true,
+	}
+	for _, pass := range []int{1, 2} {
+		opt.Pass = pass
+		if err := b.media.Transcode(ctx, opt); err != nil {
+			return "", errors.Wrapf(err, "pass %d", pass)
+		}
+	}
+
+	return opt.Output, nil
+}
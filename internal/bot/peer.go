@@ -0,0 +1,151 @@
+package bot
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tg"
+
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/job"
+)
+
+// chatID returns id of chat, channel or user from peer.
+func chatID(p tg.PeerClass) int64 {
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		return p.UserID
+	case *tg.PeerChat:
+		return p.ChatID
+	case *tg.PeerChannel:
+		return p.ChannelID
+	default:
+		return 0
+	}
+}
+
+// senderID returns id of message author, falling back to chat id for
+// private chats where FromID is not set.
+func senderID(m *tg.Message) int64 {
+	if from, ok := m.GetFromID(); ok {
+		return chatID(from)
+	}
+	return chatID(m.PeerID)
+}
+
+// senderPeer returns peer of message author, that is chat itself for
+// private chats where FromID is not set.
+func senderPeer(m *tg.Message) tg.PeerClass {
+	if from, ok := m.GetFromID(); ok {
+		return from
+	}
+	return m.PeerID
+}
+
+// senderUser returns id of user that authored message, zero if message is
+// sent as channel, like by anonymous admin.
+func senderUser(m *tg.Message) int64 {
+	if p, ok := senderPeer(m).(*tg.PeerUser); ok {
+		return p.UserID
+	}
+	return 0
+}
+
+// samePeer reports whether a and b are the same peer, comparing type and
+// id, as ids of users, chats and channels may be equal.
+func samePeer(a, b tg.PeerClass) bool {
+	switch a := a.(type) {
+	case *tg.PeerUser:
+		b, ok := b.(*tg.PeerUser)
+		return ok && a.UserID == b.UserID
+	case *tg.PeerChat:
+		b, ok := b.(*tg.PeerChat)
+		return ok && a.ChatID == b.ChatID
+	case *tg.PeerChannel:
+		b, ok := b.(*tg.PeerChannel)
+		return ok && a.ChannelID == b.ChannelID
+	default:
+		return false
+	}
+}
+
+// storedPeer is peer representation that can be persisted.
+type storedPeer struct {
+	Type       job.PeerType
+	ID         int64
+	AccessHash int64
+}
+
+// peerFrom resolves access hash of peer from update entities.
+func peerFrom(e tg.Entities, p tg.PeerClass) (storedPeer, error) {
+	switch p := p.(type) {
+	case *tg.PeerUser:
+		u, ok := e.Users[p.UserID]
+		if !ok {
+			return storedPeer{}, errors.Errorf("user %d not found", p.UserID)
+		}
+		return storedPeer{Type: job.PeerTypeUser, ID: u.ID, AccessHash: u.AccessHash}, nil
+	case *tg.PeerChat:
+		return storedPeer{Type: job.PeerTypeChat, ID: p.ChatID}, nil
+	case *tg.PeerChannel:
+		c, ok := e.Channels[p.ChannelID]
+		if !ok {
+			return storedPeer{}, errors.Errorf("channel %d not found", p.ChannelID)
+		}
+		return storedPeer{Type: job.PeerTypeChannel, ID: c.ID, AccessHash: c.AccessHash}, nil
+	default:
+		return storedPeer{}, errors.Errorf("unexpected peer %T", p)
+	}
+}
+
+// InputPeer returns input peer of stored peer.
+func (p storedPeer) InputPeer() tg.InputPeerClass {
+	switch p.Type {
+	case job.PeerTypeChat:
+		return &tg.InputPeerChat{ChatID: p.ID}
+	case job.PeerTypeChannel:
+		return &tg.InputPeerChannel{ChannelID: p.ID, AccessHash: p.AccessHash}
+	default:
+		return &tg.InputPeerUser{UserID: p.ID, AccessHash: p.AccessHash}
+	}
+}
+
+// Peer returns peer of stored peer.
+func (p storedPeer) Peer() tg.PeerClass {
+	switch p.Type {
+	case job.PeerTypeChat:
+		return &tg.PeerChat{ChatID: p.ID}
+	case job.PeerTypeChannel:
+		return &tg.PeerChannel{ChannelID: p.ID}
+	default:
+		return &tg.PeerUser{UserID: p.ID}
+	}
+}
+
+// senderType returns job user type of message author.
+func senderType(m *tg.Message) job.UserType {
+	if _, ok := senderPeer(m).(*tg.PeerChannel); ok {
+		return job.UserTypeChannel
+	}
+	return job.UserTypeUser
+}
+
+// jobSender returns peer of job requester.
+func jobSender(j *ent.Job) tg.PeerClass {
+	if j.UserType == job.UserTypeChannel {
+		return &tg.PeerChannel{ChannelID: j.UserID}
+	}
+	return &tg.PeerUser{UserID: j.UserID}
+}
+
+// jobUser returns id of user that requested job, zero if job was requested
+// as channel.
+func jobUser(j *ent.Job) int64 {
+	if j.UserType == job.UserTypeChannel {
+		return 0
+	}
+	return j.UserID
+}
+
+// jobPeer returns input peer of job chat.
+func jobPeer(j *ent.Job) tg.InputPeerClass {
+	return storedPeer{Type: j.PeerType, ID: j.PeerID, AccessHash: j.AccessHash}.InputPeer()
+}
@@ -0,0 +1,172 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ernado/tentacle/internal/i18n"
+	"github.com/ernado/tentacle/internal/ytdlp"
+
+	"github.com/dustin/go-humanize"
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message/markup"
+	"github.com/gotd/td/tg"
+)
+
+// pickTimeout is how long user can choose format before default is used.
+const pickTimeout = time.Minute
+
+const pickPrefix = "pick:"
+
+// randomID returns random non-zero id that is not in pending. Ids are random,
+// so buttons of previous runs of bot do not match pending entries.
+func randomID[V any](pending map[uint64]V) uint64 {
+	for {
+		id := rand.Uint64()
+		if _, ok := pending[id]; id != 0 && !ok {
+			return id
+		}
+	}
+}
+
+// pickers is registry of pending format choices.
+type pickers struct {
+	mux     sync.Mutex
+	pending map[uint64]picker
+}
+
+type picker struct {
+	userID int64
+	ch     chan int
+}
+
+// Add pending choice of user, returning its id, channel that receives
+// picked index and function to remove it from registry.
+func (p *pickers) Add(userID int64) (id uint64, picked <-chan int, remove func()) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.pending == nil {
+		p.pending = make(map[uint64]picker)
+	}
+	id = randomID(p.pending)
+	ch := make(chan int, 1)
+	p.pending[id] = picker{userID: userID, ch: ch}
+
+	return id, ch, func() {
+		p.mux.Lock()
+		defer p.mux.Unlock()
+		delete(p.pending, id)
+	}
+}
+
+// Pick choice for pending id, reporting whether it was accepted.
+func (p *pickers) Pick(id uint64, userID int64, choice int) bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	v, ok := p.pending[id]
+	if !ok || v.userID != userID {
+		return false
+	}
+	delete(p.pending, id)
+	v.ch <- choice
+
+	return true
+}
+
+func pickMarkup(id uint64, labels []string) tg.ReplyMarkupClass {
+	rows := make([]tg.KeyboardButtonRow, 0, len(labels))
+	for i, label := range labels {
+		data := pickPrefix + strconv.FormatUint(id, 10) + ":" + strconv.Itoa(i)
+		rows = append(rows, markup.Row(markup.Callback(label, []byte(data))))
+	}
+	return markup.InlineKeyboard(rows...)
+}
+
+// pick asks user question with labels as possible answers, returning index
+// of picked label or zero if user does not pick anything in time.
+func (b *Bot) pick(ctx context.Context, status *statusMessage, userID int64, question string, labels []string) (int, error) {
+	id, picked, remove := b.pickers.Add(userID)
+	defer remove()
+
+	status.Ask(ctx, question, pickMarkup(id, labels))
+
+	timer := time.NewTimer(pickTimeout)
+	defer timer.Stop()
+
+	select {
+	case i := <-picked:
+		if i < 0 || i >= len(labels) {
+			return 0, nil
+		}
+		return i, nil
+	case <-timer.C:
+		return 0, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// choiceLabel returns short description of format choice in language of
+// locale, like "720p avc1 ~1.3 MB".
+func choiceLabel(loc *i18n.Locale, c ytdlp.Choice) string {
+	var b strings.Builder
+	if c.AudioOnly() {
+		b.WriteString(loc.T("pick.audio", ytdlp.Codec(c.Audio.ACodec)))
+	} else {
+		fmt.Fprintf(&b, "%dp %s", c.Video.ShortSide(), ytdlp.Codec(c.Video.VCodec))
+	}
+	if c.Size > 0 {
+		fmt.Fprintf(&b, " ~%s", humanize.Bytes(uint64(c.Size)))
+	}
+	return b.String()
+}
+
+// pickFormat asks user to choose format, returning selector of the default
+// (first) choice if user does not pick anything in time.
+func (b *Bot) pickFormat(ctx context.Context, status *statusMessage, userID int64, choices []ytdlp.Choice) (string, error) {
+	switch len(choices) {
+	case 0:
+		return ytdlp.FormatBest, nil
+	case 1:
+		return choices[0].Selector(), nil
+	}
+
+	labels := make([]string, 0, len(choices))
+	for _, c := range choices {
+		labels = append(labels, choiceLabel(status.loc, c))
+	}
+	i, err := b.pick(ctx, status, userID, status.loc.T("pick.format", labels[0]), labels)
+	if err != nil {
+		return "", err
+	}
+
+	return choices[i].Selector(), nil
+}
+
+// onPickCallback handles format choice button.
+func (b *Bot) onPickCallback(loc *i18n.Locale, u *tg.UpdateBotCallbackQuery) (string, error) {
+	idStr, choiceStr, ok := strings.Cut(strings.TrimPrefix(string(u.Data), pickPrefix), ":")
+	if !ok {
+		return "", errors.Errorf("bad pick data %q", u.Data)
+	}
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return "", errors.Wrap(err, "parse pick id")
+	}
+	choice, err := strconv.Atoi(choiceStr)
+	if err != nil {
+		return "", errors.Wrap(err, "parse choice")
+	}
+	if !b.pickers.Pick(id, u.UserID, choice) {
+		return loc.T("pick.expired"), nil
+	}
+
+	return loc.T("pick.selected"), nil
+}
@@ -0,0 +1,131 @@
+package bot
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ernado/tentacle/internal/caption"
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/preference"
+
+	"github.com/go-faster/errors"
+)
+
+// preferenceOf returns preference of peer, nil if there is none.
+func (b *Bot) preferenceOf(ctx context.Context, peer storedPeer) (*ent.Preference, error) {
+	p, err := b.db.Preference.Query().
+		Where(
+			preference.PeerTypeEQ(preference.PeerType(peer.Type)),
+			preference.PeerID(peer.ID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "query preference")
+	}
+	return p, nil
+}
+
+// captionTemplate returns caption template of preference, default if
+// there is none.
+func captionTemplate(p *ent.Preference) (*caption.Template, error) {
+	text := caption.Default
+	if p != nil && p.Caption != "" {
+		text = p.Caption
+	}
+	return caption.Parse(text)
+}
+
+// onCaptionCommand handles "/caption [template|reset]" command, that
+// shows or sets caption template of chat.
+func (b *Bot) onCaptionCommand(ctx context.Context, in command.Input) error {
+	peer, err := peerFrom(in.Entities, in.Message.PeerID)
+	if err != nil {
+		return errors.Wrap(err, "peer")
+	}
+
+	// Template can span multiple lines after command.
+	arg := in.Args
+	switch arg {
+	case "":
+		current := caption.Default
+		p, err := b.preferenceOf(ctx, peer)
+		if err != nil {
+			return err
+		}
+		if p != nil && p.Caption != "" {
+			current = p.Caption
+		}
+		loc := b.locale(ctx, in)
+		return b.reply(ctx, in, loc.T("caption.current", current)+"\n\n"+
+			loc.T("caption.fields", "{"+strings.Join(caption.Fields, "}, {")+"}")+"\n"+
+			b.router.Usage(loc, in.Name))
+	case "reset":
+		arg = ""
+	default:
+		if _, err := caption.Parse(arg); err != nil {
+			return b.replyT(ctx, in, "caption.bad", err.Error())
+		}
+	}
+
+	if err := b.db.Preference.Create().
+		SetPeerType(preference.PeerType(peer.Type)).
+		SetPeerID(peer.ID).
+		SetCaption(arg).
+		OnConflictColumns(preference.FieldPeerType, preference.FieldPeerID).
+		UpdateCaption().
+		UpdateUpdatedAt().
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "save preference")
+	}
+
+	return b.replyT(ctx, in, "caption.saved")
+}
+
+// onOriginalsCommand handles "/originals [on|off]" command, that shows or
+// sets whether original files of photos are sent as documents.
+func (b *Bot) onOriginalsCommand(ctx context.Context, in command.Input) error {
+	peer, err := peerFrom(in.Entities, in.Message.PeerID)
+	if err != nil {
+		return errors.Wrap(err, "peer")
+	}
+
+	var originals bool
+	switch args := in.Fields(); {
+	case len(args) == 0:
+		p, err := b.preferenceOf(ctx, peer)
+		if err != nil {
+			return err
+		}
+		state := "off"
+		if p != nil && p.Originals {
+			state = "on"
+		}
+		loc := b.locale(ctx, in)
+		return b.reply(ctx, in, loc.T("originals.state", state)+"\n"+b.router.Usage(loc, in.Name))
+	case len(args) == 1 && args[0] == "on":
+		originals = true
+	case len(args) == 1 && args[0] == "off":
+	default:
+		return command.ErrUsage
+	}
+
+	if err := b.db.Preference.Create().
+		SetPeerType(preference.PeerType(peer.Type)).
+		SetPeerID(peer.ID).
+		SetOriginals(originals).
+		OnConflictColumns(preference.FieldPeerType, preference.FieldPeerID).
+		UpdateOriginals().
+		UpdateUpdatedAt().
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "save preference")
+	}
+
+	if originals {
+		return b.replyT(ctx, in, "originals.on")
+	}
+	return b.replyT(ctx, in, "originals.off")
+}
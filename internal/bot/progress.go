@@ -0,0 +1,66 @@
+package bot
+
+import (
+	"context"
+	"time"
+
+	"github.com/ernado/tentacle/internal/ytio"
+)
+
+// progressInterval is interval of transfer progress reports.
+const progressInterval = time.Second
+
+// trackProgress periodically reports progress of stage until stop is called.
+func trackProgress(ctx context.Context, stage Stage, p *ytio.Progress, report func(Status)) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+
+		var (
+			speed    float64 // bytes per second, smoothed
+			lastDone int64
+			lastTime = time.Now()
+		)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				transferred, total := p.Load()
+				if transferred < lastDone {
+					lastDone = transferred
+				}
+				current := float64(transferred-lastDone) / now.Sub(lastTime).Seconds()
+				lastDone, lastTime = transferred, now
+				if speed == 0 {
+					speed = current
+				} else {
+					// Exponential moving average to smooth out spikes.
+					const alpha = 0.3
+					speed = alpha*current + (1-alpha)*speed
+				}
+
+				s := Status{
+					Stage: stage,
+					Done:  transferred,
+					Total: total,
+					Speed: speed,
+				}
+				if speed > 0 && total > transferred {
+					s.ETA = time.Duration(float64(total-transferred) / speed * float64(time.Second))
+				}
+				report(s)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
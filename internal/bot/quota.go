@@ -0,0 +1,225 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"time"
+
+	"github.com/ernado/tentacle/internal/access"
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/usage"
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tg"
+)
+
+// usageSubject is user or chat whose usage is counted.
+type usageSubject struct {
+	Type usage.PeerType
+	ID   int64
+	// Role limits usage, nil if unlimited.
+	Role *ent.Role
+}
+
+// usagePeer returns usage peer type and id of peer.
+func usagePeer(p tg.PeerClass) (usage.PeerType, int64) {
+	peerType, peerID := grantPeer(p)
+	return usage.PeerType(peerType), peerID
+}
+
+// usageSubjects returns author of message limited by role r and group of
+// message limited by role of its grant.
+func (b *Bot) usageSubjects(ctx context.Context, m *tg.Message, r *ent.Role) ([]usageSubject, error) {
+	user := usageSubject{Role: r}
+	user.Type, user.ID = usagePeer(senderPeer(m))
+	if isPrivate(m) {
+		return []usageSubject{user}, nil
+	}
+
+	chatRole, err := b.chatRoleOf(ctx, m)
+	if err != nil {
+		return nil, errors.Wrap(err, "chat role")
+	}
+	chat := usageSubject{Role: chatRole}
+	chat.Type, chat.ID = usagePeer(m.PeerID)
+	if chat == user {
+		// Anonymous admin sends as group.
+		return []usageSubject{user}, nil
+	}
+	return []usageSubject{user, chat}, nil
+}
+
+// usageOf returns usage of peer.
+func usageOf(ctx context.Context, db *ent.Client, peerType usage.PeerType, peerID int64) (access.Usage, error) {
+	now := time.Now()
+	list, err := db.Usage.Query().
+		Where(
+			usage.PeerTypeEQ(peerType),
+			usage.PeerID(peerID),
+			usage.CreatedAtGT(now.Add(-access.BytesWindow)),
+		).
+		All(ctx)
+	if err != nil {
+		return access.Usage{}, errors.Wrap(err, "query usage")
+	}
+	records := make([]access.Record, 0, len(list))
+	for _, u := range list {
+		records = append(records, access.Record{At: u.CreatedAt, Bytes: u.Bytes})
+	}
+	return access.UsageOf(records, now), nil
+}
+
+// quotaExceeded returns description of exhausted quota of subjects in
+// language of locale, empty if new job fits every quota.
+func (b *Bot) quotaExceeded(ctx context.Context, loc *i18n.Locale, subjects []usageSubject) (string, error) {
+	return b.checkQuota(ctx, b.db, loc, subjects)
+}
+
+// checkQuota is quotaExceeded that uses db, like client of transaction.
+func (b *Bot) checkQuota(ctx context.Context, db *ent.Client, loc *i18n.Locale, subjects []usageSubject) (string, error) {
+	for i, s := range subjects {
+		if s.Role == nil {
+			continue
+		}
+		u, err := usageOf(ctx, db, s.Type, s.ID)
+		if err != nil {
+			return "", err
+		}
+		if err := s.Role.Limits.CheckUsage(u, time.Now()); err != nil {
+			if i > 0 {
+				return loc.T("quota.chat", exceededText(loc, err)), nil
+			}
+			return exceededText(loc, err), nil
+		}
+	}
+	return "", nil
+}
+
+// lockUsage locks usage of subjects until end of transaction of db, so
+// quota of subject is checked by one request at a time.
+func lockUsage(ctx context.Context, db *ent.Client, subjects []usageSubject) error {
+	keys := make([]int64, 0, len(subjects))
+	for _, s := range subjects {
+		h := fnv.New64a()
+		_, _ = fmt.Fprintf(h, "usage %s %d", s.Type, s.ID)
+		keys = append(keys, int64(h.Sum64()))
+	}
+	// Locks are taken in the same order by every request.
+	slices.Sort(keys)
+	for _, key := range keys {
+		if _, err := db.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", key); err != nil {
+			return errors.Wrap(err, "lock usage")
+		}
+	}
+	return nil
+}
+
+// recordUsage counts job in usage of subjects and removes records of
+// subjects that are out of quota windows.
+func recordUsage(ctx context.Context, db *ent.Client, j *ent.Job, subjects []usageSubject) error {
+	for _, s := range subjects {
+		if _, err := db.Usage.Delete().
+			Where(
+				usage.PeerTypeEQ(s.Type),
+				usage.PeerID(s.ID),
+				usage.CreatedAtLT(time.Now().Add(-access.BytesWindow)),
+			).
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "delete old usage")
+		}
+		if err := db.Usage.Create().
+			SetPeerType(s.Type).
+			SetPeerID(s.ID).
+			SetJobID(j.ID).
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "create usage")
+		}
+	}
+	return nil
+}
+
+// recordTraffic adds n bytes downloaded by job to its usage.
+func (b *Bot) recordTraffic(ctx context.Context, j *ent.Job, n int64) error {
+	if n == 0 {
+		return nil
+	}
+	return b.db.Usage.Update().
+		Where(usage.JobID(j.ID)).
+		AddBytes(n).
+		Exec(ctx)
+}
+
+// onUsageCommand handles "/usage [<user|chat|channel> <id>]" command, that
+// shows usage of author and chat, or of any user or chat for admins.
+func (b *Bot) onUsageCommand(ctx context.Context, in command.Input) error {
+	var (
+		subjects []usageSubject
+		loc      = b.locale(ctx, in)
+	)
+	if args := in.Fields(); len(args) > 0 {
+		peerType, peerID, err := parseSubject(args)
+		if err != nil {
+			return err
+		}
+		admin, err := b.isBotAdmin(ctx, in.Message)
+		if err != nil {
+			return errors.Wrap(err, "admin")
+		}
+		if !admin {
+			return b.reply(ctx, in, loc.T("access.bot_admin_only"))
+		}
+		s := usageSubject{Type: usage.PeerType(peerType), ID: peerID}
+		if s.Role, err = b.grantRole(ctx, subjectPeer(peerType, peerID)); err != nil {
+			return errors.Wrap(err, "role")
+		}
+		subjects = append(subjects, s)
+	} else {
+		r, err := b.roleOf(ctx, in)
+		if err != nil {
+			return errors.Wrap(err, "role")
+		}
+		if subjects, err = b.usageSubjects(ctx, in.Message, r); err != nil {
+			return err
+		}
+	}
+
+	text := ""
+	for i, s := range subjects {
+		u, err := usageOf(ctx, b.db, s.Type, s.ID)
+		if err != nil {
+			return err
+		}
+		var limits access.Limits
+		if s.Role != nil {
+			limits = s.Role.Limits
+		}
+		if i > 0 {
+			text += "\n\n"
+		}
+		text += loc.T("usage.title", s.Type, s.ID) + "\n" + u.Format(loc, limits)
+	}
+	return b.reply(ctx, in, text)
+}
+
+// onResetCommand handles "/reset <user|chat|channel> <id>" command, that
+// resets usage of user or chat.
+func (b *Bot) onResetCommand(ctx context.Context, in command.Input) error {
+	peerType, peerID, err := parseSubject(in.Fields())
+	if err != nil {
+		return err
+	}
+	n, err := b.db.Usage.Delete().
+		Where(
+			usage.PeerTypeEQ(usage.PeerType(peerType)),
+			usage.PeerID(peerID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete usage")
+	}
+	return b.reply(ctx, in, b.locale(ctx, in).N("usage.reset", n, peerType, peerID))
+}
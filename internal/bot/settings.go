@@ -0,0 +1,169 @@
+package bot
+
+import (
+	"context"
+
+	"github.com/ernado/tentacle/internal/command"
+	"github.com/ernado/tentacle/internal/ent"
+	"github.com/ernado/tentacle/internal/ent/job"
+	"github.com/ernado/tentacle/internal/ent/preference"
+	"github.com/ernado/tentacle/internal/i18n"
+	"github.com/ernado/tentacle/internal/settings"
+	"github.com/ernado/tentacle/internal/ytdlp"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// settingsOf returns settings of preference, default if there is none.
+func settingsOf(p *ent.Preference) settings.Settings {
+	if p == nil {
+		return settings.Settings{}
+	}
+	return settings.Settings{
+		Quality:   p.Quality,
+		Codec:     p.Codec,
+		Format:    p.DefaultFormat.String(),
+		Subtitles: p.Subtitles,
+		Document:  p.Document,
+		Language:  p.Language,
+		Caption:   p.Caption,
+	}
+}
+
+// formatPreference returns format preference of settings.
+func formatPreference(s settings.Settings) ytdlp.Preference {
+	return ytdlp.Preference{MaxSide: s.Quality, Codec: s.Codec}
+}
+
+// requestPreference returns preference of chat of message. In group that
+// has no preference, preference of author is used.
+func (b *Bot) requestPreference(ctx context.Context, in command.Input) (*ent.Preference, error) {
+	m := in.Message
+	peer, err := peerFrom(in.Entities, m.PeerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "peer")
+	}
+	p, err := b.preferenceOf(ctx, peer)
+	if err != nil || p != nil || isPrivate(m) {
+		return p, err
+	}
+	from, ok := m.GetFromID()
+	if !ok {
+		return nil, nil
+	}
+	user, ok := from.(*tg.PeerUser)
+	if !ok {
+		return nil, nil
+	}
+	return b.preferenceOf(ctx, storedPeer{Type: job.PeerTypeUser, ID: user.UserID})
+}
+
+// savePreference saves settings as preference of peer.
+func (b *Bot) savePreference(ctx context.Context, peer storedPeer, s settings.Settings) error {
+	format := preference.DefaultFormat(s.Format)
+	if format == "" {
+		format = preference.DefaultFormatAsk
+	}
+	if err := b.db.Preference.Create().
+		SetPeerType(preference.PeerType(peer.Type)).
+		SetPeerID(peer.ID).
+		SetQuality(s.Quality).
+		SetCodec(s.Codec).
+		SetDefaultFormat(format).
+		SetSubtitles(s.Subtitles).
+		SetDocument(s.Document).
+		SetLanguage(s.Language).
+		OnConflictColumns(preference.FieldPeerType, preference.FieldPeerID).
+		UpdateQuality().
+		UpdateCodec().
+		UpdateDefaultFormat().
+		UpdateSubtitles().
+		UpdateDocument().
+		UpdateLanguage().
+		UpdateUpdatedAt().
+		Exec(ctx); err != nil {
+		return errors.Wrap(err, "save preference")
+	}
+	return nil
+}
+
+// onSettingsCommand handles "/settings" command, that shows settings menu
+// of chat.
+func (b *Bot) onSettingsCommand(ctx context.Context, in command.Input) error {
+	peer, err := peerFrom(in.Entities, in.Message.PeerID)
+	if err != nil {
+		return errors.Wrap(err, "peer")
+	}
+	p, err := b.preferenceOf(ctx, peer)
+	if err != nil {
+		return err
+	}
+	var (
+		s   = settingsOf(p)
+		loc = localeOf(p, in.Entities.Users[senderUser(in.Message)])
+	)
+	if _, err := b.sender.Reply(in.Entities, in.Update).
+		Markup(s.Markup(loc, "")).
+		Text(ctx, s.Text(loc)); err != nil {
+		return errors.Wrap(err, "reply")
+	}
+	return nil
+}
+
+// onSettingsCallback handles buttons of settings menu.
+func (b *Bot) onSettingsCallback(
+	ctx context.Context,
+	loc *i18n.Locale,
+	e tg.Entities,
+	u *tg.UpdateBotCallbackQuery,
+) (string, error) {
+	key, value, set, err := settings.ParseData(u.Data)
+	if err != nil {
+		return "", err
+	}
+	ok, err := b.isAdminOf(ctx, e, u.Peer, &tg.PeerUser{UserID: u.UserID})
+	if err != nil {
+		return "", errors.Wrap(err, "check admin")
+	}
+	if !ok {
+		return loc.T("access.chat_admin_only"), nil
+	}
+
+	peer, err := peerFrom(e, u.Peer)
+	if err != nil {
+		return "", errors.Wrap(err, "peer")
+	}
+	p, err := b.preferenceOf(ctx, peer)
+	if err != nil {
+		return "", err
+	}
+	s := settingsOf(p)
+	answer := ""
+	if set {
+		if s, err = s.Apply(key, value); err != nil {
+			return "", err
+		}
+		if err := b.savePreference(ctx, peer, s); err != nil {
+			return "", err
+		}
+		if key == settings.KeyLanguage {
+			// Menu is shown in chosen language right away.
+			loc = localeOf(&ent.Preference{Language: s.Language}, e.Users[u.UserID])
+		}
+		if key != settings.KeySubtitles {
+			// Subtitles are toggled, so their menu is kept open.
+			key = ""
+		}
+		answer = loc.T("settings.saved")
+	}
+
+	if _, err := b.sender.To(peer.InputPeer()).
+		Markup(s.Markup(loc, key)).
+		Edit(u.MsgID).
+		Text(ctx, s.Text(loc)); err != nil && !tgerr.Is(err, "MESSAGE_NOT_MODIFIED") {
+		return "", errors.Wrap(err, "edit settings")
+	}
+	return answer, nil
+}
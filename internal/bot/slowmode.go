@@ -0,0 +1,43 @@
+package bot
+
+import (
+	"context"
+	"time"
+
+	"github.com/gotd/td/bin"
+	"github.com/gotd/td/tg"
+	"github.com/gotd/td/tgerr"
+)
+
+// maxSlowModeWait limits wait for slow mode of group, sending fails if
+// group requires to wait longer.
+const maxSlowModeWait = time.Minute
+
+// slowModeInvoker waits and retries requests that are rejected by slow
+// mode of group.
+type slowModeInvoker struct {
+	next tg.Invoker
+}
+
+// Invoke implements tg.Invoker.
+func (i slowModeInvoker) Invoke(ctx context.Context, input bin.Encoder, output bin.Decoder) error {
+	for {
+		err := i.next.Invoke(ctx, input, output)
+		rpcErr, ok := tgerr.AsType(err, "SLOWMODE_WAIT")
+		if !ok {
+			return err
+		}
+		wait := time.Second * time.Duration(rpcErr.Argument)
+		if wait > maxSlowModeWait {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
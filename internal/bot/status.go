@@ -0,0 +1,280 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/message/unpack"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+)
+
+// Status of download job, reported to every requester.
+type Status struct {
+	Stage Stage
+	// Position in queue, only for StageQueued.
+	Position int
+
+	// Transfer progress, only for StageDownload and StageUpload.
+	Done  int64
+	Total int64
+	Speed float64 // bytes per second
+	ETA   time.Duration
+}
+
+// Text returns description of status in language of locale.
+func (s Status) Text(loc *i18n.Locale) string {
+	if s.Stage == StageQueued && s.Position > 0 {
+		return loc.T("status.position", s.Position)
+	}
+	if s.Total <= 0 {
+		return s.Stage.Text(loc) + "..."
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %d%%\n%s / %s",
+		s.Stage.Text(loc),
+		s.Done*100/s.Total,
+		humanize.Bytes(uint64(s.Done)),
+		humanize.Bytes(uint64(s.Total)),
+	)
+	if s.Speed > 0 {
+		b.WriteString(loc.T("status.speed", humanize.Bytes(uint64(s.Speed))))
+	}
+	if s.ETA > 0 {
+		b.WriteString(loc.T("status.eta", s.ETA.Round(time.Second)))
+	}
+
+	return b.String()
+}
+
+// editInterval is minimum interval between edits of status message with
+// the same stage, so bot stays under telegram edit limits.
+const editInterval = time.Second * 3
+
+// statusMessage is single message that shows job status to requester,
+// edited in place as job progresses.
+//
+// Progress updates never wait for network, so one slow requester does not
+// stall progress of others that share the job: while edit is in flight,
+// only the latest update is kept and shown after it.
+type statusMessage struct {
+	answer *message.RequestBuilder
+	// quiet status is deleted instead of showing final text, so failures
+	// of requests that were not addressed to bot do not spam group.
+	quiet bool
+	// loc is locale of status texts.
+	loc *i18n.Locale
+	lg  *zap.Logger
+
+	// io serializes requests, as answer builder is shared.
+	io sync.Mutex
+
+	mux sync.Mutex
+	// markup is shown while job is in progress.
+	markup tg.ReplyMarkupClass
+	id     int
+	text   string
+	// stage is stage of last update, shown is stage of shown text.
+	stage    Stage
+	shown    Stage
+	lastEdit time.Time
+	// sending is set while update is sent, next is the latest update
+	// that arrived meanwhile.
+	sending bool
+	next    *Status
+	// done is set when status is finalized or deleted, so late updates
+	// are ignored.
+	done bool
+}
+
+// SetMarkup sets markup that is shown while job is in progress.
+func (m *statusMessage) SetMarkup(markup tg.ReplyMarkupClass) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.markup = markup
+}
+
+// Stage returns stage of last update.
+func (m *statusMessage) Stage() Stage {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	return m.stage
+}
+
+// Done reports whether status was finalized or deleted.
+func (m *statusMessage) Done() bool {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	return m.done
+}
+
+// Update status message in background. Updates of the same stage are
+// rate-limited.
+func (m *statusMessage) Update(ctx context.Context, s Status) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if m.done {
+		return
+	}
+	m.stage = s.Stage
+	if m.sending {
+		m.next = &s
+		return
+	}
+	text := s.Text(m.loc)
+	if text == m.text {
+		return
+	}
+	if m.id != 0 && s.Stage == m.shown && time.Since(m.lastEdit) < editInterval {
+		return
+	}
+
+	m.sending = true
+	go m.send(ctx, text, s.Stage)
+}
+
+// send shows text of update with stage, then the latest update that
+// arrived meanwhile.
+func (m *statusMessage) send(ctx context.Context, text string, stage Stage) {
+	m.io.Lock()
+	m.mux.Lock()
+	if m.done {
+		m.sending, m.next = false, nil
+		m.mux.Unlock()
+		m.io.Unlock()
+		return
+	}
+	id, markup := m.id, m.markup
+	m.mux.Unlock()
+
+	id = m.write(ctx, id, text, markup)
+
+	m.mux.Lock()
+	m.id, m.text, m.shown, m.lastEdit = id, text, stage, time.Now()
+	next := m.next
+	m.sending, m.next = false, nil
+	m.mux.Unlock()
+	m.io.Unlock()
+
+	if next != nil {
+		m.Update(ctx, *next)
+	}
+}
+
+// write sends message if id is zero or edits it, returning its id. Must be
+// called with io lock held.
+func (m *statusMessage) write(ctx context.Context, id int, text string, markup tg.ReplyMarkupClass) int {
+	// Builder is shared, so markup is always set to remove previous one.
+	b := m.answer.Markup(markup)
+	if id != 0 {
+		if _, err := b.Edit(id).Text(ctx, text); err != nil {
+			m.lg.Warn("Failed to edit status", zap.Error(err))
+		}
+		return id
+	}
+
+	id, err := unpack.MessageID(b.Text(ctx, text))
+	if err != nil {
+		m.lg.Warn("Failed to send status", zap.Error(err))
+		return 0
+	}
+	return id
+}
+
+// set shows text with markup, waiting for update in flight.
+func (m *statusMessage) set(ctx context.Context, text string, markup tg.ReplyMarkupClass, done bool) {
+	m.io.Lock()
+	defer m.io.Unlock()
+
+	m.mux.Lock()
+	if m.done {
+		m.mux.Unlock()
+		return
+	}
+	m.done = done
+	if done && m.quiet {
+		id := m.id
+		m.id = 0
+		m.mux.Unlock()
+		m.delete(ctx, id)
+		return
+	}
+	id, current := m.id, m.text
+	m.mux.Unlock()
+
+	if done && markup == nil && text == current {
+		return
+	}
+	id = m.write(ctx, id, text, markup)
+
+	m.mux.Lock()
+	m.id, m.text, m.lastEdit = id, text, time.Now()
+	m.mux.Unlock()
+}
+
+// Delete status message, used when result is delivered.
+func (m *statusMessage) Delete(ctx context.Context) {
+	m.io.Lock()
+	defer m.io.Unlock()
+
+	m.mux.Lock()
+	id := m.id
+	m.id, m.done = 0, true
+	m.mux.Unlock()
+
+	m.delete(ctx, id)
+}
+
+// delete message with id. Must be called with io lock held.
+func (m *statusMessage) delete(ctx context.Context, id int) {
+	if id == 0 {
+		return
+	}
+	if _, err := m.answer.Revoke().Messages(ctx, id); err != nil {
+		m.lg.Warn("Failed to delete status", zap.Error(err))
+	}
+}
+
+// Ask replaces status with question and markup of possible answers.
+func (m *statusMessage) Ask(ctx context.Context, text string, markup tg.ReplyMarkupClass) {
+	m.set(ctx, text, markup, false)
+}
+
+// Finalize replaces status with final text, bypassing rate limit and
+// removing markup. Quiet status is deleted.
+func (m *statusMessage) Finalize(ctx context.Context, text string) {
+	m.Fail(ctx, text, nil)
+}
+
+// Fail replaces status with final text of failure and markup, like retry
+// button. Quiet status is deleted.
+func (m *statusMessage) Fail(ctx context.Context, text string, markup tg.ReplyMarkupClass) {
+	m.set(ctx, text, markup, true)
+}
+
+// ClearMarkup removes buttons of final status, keeping its text.
+func (m *statusMessage) ClearMarkup(ctx context.Context) {
+	m.io.Lock()
+	defer m.io.Unlock()
+
+	m.mux.Lock()
+	id, text := m.id, m.text
+	m.mux.Unlock()
+
+	if id == 0 {
+		return
+	}
+	m.write(ctx, id, text, nil)
+}
@@ -0,0 +1,46 @@
+package bot
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+
+	"github.com/ernado/tentacle/internal/ent/schema"
+	"github.com/ernado/tentacle/internal/ytdlp"
+
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/tg"
+	"go.uber.org/zap"
+)
+
+// subtitles uploads subtitles of video in languages of options. Subtitles
+// are optional, so failures are only logged.
+func (b *Bot) subtitles(
+	ctx context.Context,
+	lg *zap.Logger,
+	reply *message.Builder,
+	dir string,
+	video *ytdlp.Video,
+	opt schema.JobOptions,
+	httpClient *http.Client,
+) []*tg.Document {
+	var docs []*tg.Document
+	for _, s := range video.SubtitlesOf(opt.Subtitles) {
+		var (
+			path = filepath.Join(dir, "subtitles-"+s.Lang+"."+s.Ext)
+			name = fileName(video.Title+" ("+s.Lang+")", "subtitles", "."+s.Ext)
+			slg  = lg.With(zap.String("lang", s.Lang))
+		)
+		if err := ytdlp.DownloadSubtitle(ctx, s, path, httpClient); err != nil {
+			slg.Warn("Failed to download subtitles", zap.Error(err))
+			continue
+		}
+		doc, err := b.uploadFile(ctx, slg, reply, path, name)
+		if err != nil {
+			slg.Warn("Failed to upload subtitles", zap.Error(err))
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
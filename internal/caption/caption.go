@@ -0,0 +1,337 @@
+// Package caption renders captions of sent media from templates.
+package caption
+
+import (
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message/styling"
+
+	"github.com/ernado/tentacle/internal/ytdlp"
+)
+
+// Limit is maximum length of media caption in UTF-16 code units.
+const Limit = 1024
+
+// Default is template that is used if chat has none.
+const Default = "{title}\n{uploader} · {duration}\n{url}\n{chapters}"
+
+// Fields that can be used in template.
+var Fields = []string{"title", "uploader", "duration", "url", "chapters"}
+
+// Kind of Part.
+type Kind int
+
+const (
+	Plain Kind = iota
+	Bold
+	// URL is text that is URL itself.
+	URL
+	// TextURL is text that links to Part.Link.
+	TextURL
+)
+
+// Part of Line with the same style.
+type Part struct {
+	Kind Kind
+	Text string
+	Link string
+}
+
+// Line of caption.
+type Line []Part
+
+// Text returns line as plain text.
+func (l Line) Text() string {
+	var b strings.Builder
+	for _, p := range l {
+		b.WriteString(p.Text)
+	}
+	return b.String()
+}
+
+// Len returns length of line in UTF-16 code units, as telegram counts it.
+func (l Line) Len() int {
+	var n int
+	for _, p := range l {
+		n += utf16Len(p.Text)
+	}
+	return n
+}
+
+func utf16Len(s string) int {
+	var n int
+	for _, r := range s {
+		n += utf16.RuneLen(r)
+	}
+	return n
+}
+
+// Chapter of video.
+type Chapter struct {
+	Start time.Duration
+	Title string
+}
+
+// Data of caption.
+type Data struct {
+	Title    string
+	Uploader string
+	Duration time.Duration
+	URL      string
+	Chapters []Chapter
+}
+
+// DataOf returns caption data of video at source url.
+func DataOf(v *ytdlp.Video, url string) Data {
+	d := Data{
+		Title:    v.Title,
+		Uploader: v.Uploader,
+		Duration: time.Duration(v.Duration * float64(time.Second)),
+		URL:      url,
+	}
+	for _, c := range v.Chapters {
+		d.Chapters = append(d.Chapters, Chapter{Start: c.Start(), Title: c.Title})
+	}
+	return d
+}
+
+// token of template line, either literal text or field.
+type token struct {
+	Text  string
+	Field string
+}
+
+// Template of caption, where fields like "{title}" are replaced with
+// data. Line with "{chapters}" is replaced with line per chapter.
+type Template struct {
+	lines [][]token
+}
+
+// Parse parses template.
+func Parse(s string) (*Template, error) {
+	t := new(Template)
+	for _, line := range strings.Split(s, "\n") {
+		var tokens []token
+		for line != "" {
+			start := strings.IndexByte(line, '{')
+			if start < 0 {
+				tokens = append(tokens, token{Text: line})
+				break
+			}
+			if start > 0 {
+				tokens = append(tokens, token{Text: line[:start]})
+			}
+			end := strings.IndexByte(line[start:], '}')
+			if end < 0 {
+				return nil, errors.Errorf("unclosed field in %q", line)
+			}
+			field := line[start+1 : start+end]
+			if !knownField(field) {
+				return nil, errors.Errorf("unknown field %q", field)
+			}
+			tokens = append(tokens, token{Field: field})
+			line = line[start+end+1:]
+		}
+		for _, tok := range tokens {
+			if tok.Field == "chapters" && len(tokens) != 1 {
+				return nil, errors.New("chapters must be on separate line")
+			}
+		}
+		t.lines = append(t.lines, tokens)
+	}
+	return t, nil
+}
+
+func knownField(name string) bool {
+	for _, f := range Fields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Render renders template with data. Lines where every field is empty
+// are skipped.
+func (t *Template) Render(d Data) []Line {
+	var lines []Line
+	for _, tokens := range t.lines {
+		if len(tokens) == 1 && tokens[0].Field == "chapters" {
+			lines = append(lines, chapterLines(d)...)
+			continue
+		}
+		var (
+			line   Line
+			fields int
+			empty  int
+		)
+		for _, tok := range tokens {
+			if tok.Field == "" {
+				line = append(line, Part{Text: tok.Text})
+				continue
+			}
+			fields++
+			part := fieldPart(tok.Field, d)
+			if part.Text == "" {
+				empty++
+				continue
+			}
+			line = append(line, part)
+		}
+		if fields > 0 && fields == empty {
+			continue
+		}
+		lines = append(lines, trim(line))
+	}
+	return lines
+}
+
+func fieldPart(field string, d Data) Part {
+	switch field {
+	case "title":
+		return Part{Kind: Bold, Text: d.Title}
+	case "uploader":
+		return Part{Text: d.Uploader}
+	case "duration":
+		if d.Duration <= 0 {
+			return Part{}
+		}
+		return Part{Text: ytdlp.FormatTimestamp(d.Duration)}
+	case "url":
+		return Part{Kind: URL, Text: d.URL}
+	default:
+		return Part{}
+	}
+}
+
+// chapterLines returns line per chapter, where timestamp links to chapter
+// start.
+func chapterLines(d Data) []Line {
+	lines := make([]Line, 0, len(d.Chapters))
+	for _, c := range d.Chapters {
+		ts := Part{Text: ytdlp.FormatTimestamp(c.Start)}
+		if d.URL != "" {
+			ts = Part{Kind: TextURL, Text: ts.Text, Link: ytdlp.URLAt(d.URL, c.Start)}
+		}
+		lines = append(lines, Line{ts, {Text: " " + c.Title}})
+	}
+	return lines
+}
+
+// separators are trimmed around line.
+const separators = " ·•|-–—,"
+
+// trim removes separators around line, left by empty fields.
+func trim(line Line) Line {
+	if len(line) > 0 && line[0].Kind == Plain {
+		line[0].Text = strings.TrimLeft(line[0].Text, separators)
+	}
+	if n := len(line); n > 0 && line[n-1].Kind == Plain {
+		line[n-1].Text = strings.TrimRight(line[n-1].Text, separators)
+	}
+	return line
+}
+
+// ellipsis marks truncated caption.
+const ellipsis = "…"
+
+// Fit returns lines of head followed by tail that fit limit, dropping
+// last lines of head if needed. Tail is always kept.
+func Fit(head, tail []Line, limit int) []Line {
+	used := -1 // no separator before first line
+	for _, line := range tail {
+		used += line.Len() + 1
+	}
+	if used >= limit {
+		return tail
+	}
+
+	var lines []Line
+	for i, line := range head {
+		if used+1+line.Len() <= limit {
+			used += line.Len() + 1
+			lines = append(lines, line)
+			continue
+		}
+		// Line does not fit, so it is cut if it is the first one, and
+		// the rest is replaced with ellipsis.
+		budget := limit - used - 1 - utf16Len(ellipsis)
+		if i == 0 && budget > 0 {
+			lines = append(lines, append(cut(line, budget), Part{Text: ellipsis}))
+		} else if budget >= 0 {
+			lines = append(lines, Line{{Text: ellipsis}})
+		}
+		break
+	}
+	return append(lines, tail...)
+}
+
+// cut returns prefix of line that is not longer than n.
+func cut(line Line, n int) Line {
+	var out Line
+	for _, p := range line {
+		if n <= 0 {
+			break
+		}
+		l := utf16Len(p.Text)
+		if l <= n {
+			out = append(out, p)
+			n -= l
+			continue
+		}
+		var text []rune
+		for _, r := range p.Text {
+			if n-utf16.RuneLen(r) < 0 {
+				break
+			}
+			n -= utf16.RuneLen(r)
+			text = append(text, r)
+		}
+		p.Text = string(text)
+		if p.Kind == URL {
+			// Part of URL is not a link.
+			p.Kind = Plain
+		}
+		out = append(out, p)
+		break
+	}
+	return out
+}
+
+// Options returns styled text of lines.
+func Options(lines []Line) []styling.StyledTextOption {
+	var opts []styling.StyledTextOption
+	for i, line := range lines {
+		if i > 0 {
+			opts = append(opts, styling.Plain("\n"))
+		}
+		for _, p := range line {
+			if p.Text == "" {
+				continue
+			}
+			switch p.Kind {
+			case Bold:
+				opts = append(opts, styling.Bold(p.Text))
+			case URL:
+				opts = append(opts, styling.URL(p.Text))
+			case TextURL:
+				opts = append(opts, styling.TextURL(p.Text, p.Link))
+			default:
+				opts = append(opts, styling.Plain(p.Text))
+			}
+		}
+	}
+	return opts
+}
+
+// PlainLines returns lines of plain text.
+func PlainLines(texts ...string) []Line {
+	lines := make([]Line, 0, len(texts))
+	for _, text := range texts {
+		lines = append(lines, Line{{Text: text}})
+	}
+	return lines
+}
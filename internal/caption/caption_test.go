@@ -0,0 +1,69 @@
+package caption
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func text(lines []Line) string {
+	texts := make([]string, 0, len(lines))
+	for _, line := range lines {
+		texts = append(texts, line.Text())
+	}
+	return strings.Join(texts, "\n")
+}
+
+func TestParse(t *testing.T) {
+	for _, s := range []string{
+		"{title",
+		"{unknown}",
+		"Chapters: {chapters}",
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestRender(t *testing.T) {
+	tpl, err := Parse(Default)
+	require.NoError(t, err)
+
+	data := Data{
+		Title:    "Title",
+		Uploader: "Uploader",
+		Duration: time.Second * 185,
+		URL:      "https://youtu.be/id",
+		Chapters: []Chapter{
+			{Title: "Intro"},
+			{Start: time.Second * 80, Title: "Main"},
+		},
+	}
+	lines := tpl.Render(data)
+	require.Equal(t, "Title\nUploader · 3:05\nhttps://youtu.be/id\n0:00 Intro\n1:20 Main", text(lines))
+	require.Equal(t, Part{Kind: Bold, Text: "Title"}, lines[0][0])
+	require.Equal(t, Part{Kind: URL, Text: "https://youtu.be/id"}, lines[2][0])
+	require.Equal(t, Part{Kind: TextURL, Text: "1:20", Link: "https://youtu.be/id?t=80"}, lines[4][0])
+
+	// Empty fields are skipped with their line or separators.
+	lines = tpl.Render(Data{Title: "Title", Duration: time.Second * 5})
+	require.Equal(t, "Title\n0:05", text(lines))
+	lines = tpl.Render(Data{Title: "Title"})
+	require.Equal(t, "Title", text(lines))
+}
+
+func TestFit(t *testing.T) {
+	head := PlainLines("Title", "Description that is long")
+	tail := PlainLines("Part 1/2")
+
+	require.Equal(t, "Title\nDescription that is long\nPart 1/2", text(Fit(head, tail, Limit)))
+	require.Equal(t, "Title\n…\nPart 1/2", text(Fit(head, tail, 20)))
+	require.Equal(t, "Ti…\nPart 1/2", text(Fit(head, tail, 12)))
+
+	// Length is counted in UTF-16 code units.
+	lines := Fit(PlainLines(strings.Repeat("😀", 10)), nil, 9)
+	require.Equal(t, strings.Repeat("😀", 4)+"…", text(lines))
+	require.LessOrEqual(t, lines[0].Len(), 9)
+}
@@ -0,0 +1,237 @@
+// Package command routes bot commands of messages to handlers.
+package command
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/tg"
+)
+
+// Scope is set of chats where command is listed.
+type Scope int
+
+const (
+	// Private chat with bot.
+	Private Scope = 1 << iota
+	// Group is any group or supergroup.
+	Group
+	// GroupAdmin is group where user is admin.
+	GroupAdmin
+	// Admin is private chat with admin of bot.
+	Admin
+
+	// Groups is every group, including ones where user is admin.
+	Groups = Group | GroupAdmin
+	// All is every chat.
+	All = Private | Groups
+)
+
+// ErrUsage is returned by handler if arguments are invalid, so usage of
+// command is replied.
+var ErrUsage = errors.New("bad usage")
+
+// Input of command handler.
+type Input struct {
+	Entities tg.Entities
+	// Update is new message of private chat or group, or new channel
+	// message of supergroup.
+	Update  message.AnswerableMessageUpdate
+	Message *tg.Message
+	// Name of command without slash and bot username.
+	Name string
+	// Args is text after command, possibly multiline.
+	Args string
+}
+
+// Fields returns arguments split around whitespace.
+func (i Input) Fields() []string {
+	return strings.Fields(i.Args)
+}
+
+// Handler of command or message.
+type Handler func(ctx context.Context, in Input) error
+
+// Command of bot.
+type Command struct {
+	// Name without slash, like "clip".
+	Name string
+	// Usage is description of arguments, like "<url> 1:20-3:05".
+	Usage string
+	// Description is catalog key of short description for command list.
+	Description string
+	// Help is optional catalog key of detailed description for help of
+	// command.
+	Help  string
+	Scope Scope
+
+	Handler Handler
+}
+
+// Parse returns name and arguments of command in text. Command with
+// username, like "/clip@bot", is reported only if username is the same.
+func Parse(text, username string) (name, args string, ok bool) {
+	if !strings.HasPrefix(text, "/") {
+		return "", "", false
+	}
+	end := strings.IndexFunc(text, unicode.IsSpace)
+	if end < 0 {
+		end = len(text)
+	}
+	name, args = text[1:end], strings.TrimSpace(text[end:])
+	if n, mention, found := strings.Cut(name, "@"); found {
+		if username != "" && !strings.EqualFold(mention, username) {
+			// Command for another bot.
+			return "", "", false
+		}
+		name = n
+	}
+	if name == "" {
+		return "", "", false
+	}
+	return strings.ToLower(name), args, true
+}
+
+// Router dispatches commands of new messages to handlers, and messages
+// that are not commands to fallback.
+type Router struct {
+	commands []Command
+	byName   map[string]Command
+	username atomic.Pointer[string]
+	reply    func(ctx context.Context, in Input, text string) error
+	locale   func(ctx context.Context, in Input) *i18n.Locale
+	fallback Handler
+}
+
+// NewRouter creates new Router, that uses reply to answer input in
+// language of locale.
+func NewRouter(
+	reply func(ctx context.Context, in Input, text string) error,
+	locale func(ctx context.Context, in Input) *i18n.Locale,
+) *Router {
+	return &Router{
+		byName: make(map[string]Command),
+		reply:  reply,
+		locale: locale,
+	}
+}
+
+// Add commands to router.
+func (r *Router) Add(commands ...Command) {
+	for _, c := range commands {
+		r.commands = append(r.commands, c)
+		r.byName[c.Name] = c
+	}
+}
+
+// Fallback sets handler of messages that are not commands.
+func (r *Router) Fallback(h Handler) {
+	r.fallback = h
+}
+
+// SetUsername sets username of bot, so commands for other bots are not
+// handled.
+func (r *Router) SetUsername(username string) {
+	r.username.Store(&username)
+}
+
+// Username returns username of bot, empty if not known yet.
+func (r *Router) Username() string {
+	if u := r.username.Load(); u != nil {
+		return *u
+	}
+	return ""
+}
+
+// Handle handles new message or new channel message of tg.UpdateDispatcher.
+// Posts of broadcast channels are ignored.
+func (r *Router) Handle(ctx context.Context, e tg.Entities, u message.AnswerableMessageUpdate) error {
+	m, ok := u.GetMessage().(*tg.Message)
+	if !ok || m.Out || m.Post {
+		return nil
+	}
+	in := Input{Entities: e, Update: u, Message: m}
+
+	name, args, ok := Parse(m.Message, r.Username())
+	if !ok {
+		if r.fallback == nil {
+			return nil
+		}
+		return r.fallback(ctx, in)
+	}
+	in.Name, in.Args = name, args
+
+	c, ok := r.byName[name]
+	if !ok {
+		if _, private := m.PeerID.(*tg.PeerUser); !private {
+			// Probably command of another bot.
+			return nil
+		}
+		return r.reply(ctx, in, r.locale(ctx, in).T("command.unknown", name))
+	}
+	if err := c.Handler(ctx, in); errors.Is(err, ErrUsage) {
+		return r.reply(ctx, in, r.Usage(r.locale(ctx, in), name))
+	} else if err != nil {
+		return errors.Wrap(err, name)
+	}
+	return nil
+}
+
+// Usage returns usage of command.
+func (r *Router) Usage(loc *i18n.Locale, name string) string {
+	c := r.byName[name]
+	if c.Usage == "" {
+		return loc.T("command.usage", "/"+c.Name)
+	}
+	return loc.T("command.usage", "/"+c.Name+" "+c.Usage)
+}
+
+// Help returns list of commands of scope.
+func (r *Router) Help(loc *i18n.Locale, scope Scope) string {
+	var b strings.Builder
+	for _, c := range r.commands {
+		if c.Scope&scope == 0 {
+			continue
+		}
+		b.WriteString("/" + c.Name)
+		if c.Usage != "" {
+			b.WriteString(" " + c.Usage)
+		}
+		b.WriteString(" — " + loc.T(c.Description) + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// CommandHelp returns help of command, reporting whether it exists.
+func (r *Router) CommandHelp(loc *i18n.Locale, name string) (string, bool) {
+	c, ok := r.byName[strings.TrimPrefix(strings.ToLower(name), "/")]
+	if !ok {
+		return "", false
+	}
+	text := loc.T(c.Description) + "\n" + r.Usage(loc, c.Name)
+	if c.Help != "" {
+		text += "\n\n" + loc.T(c.Help)
+	}
+	return text, true
+}
+
+// BotCommands returns command list of scope for bots.setBotCommands.
+func (r *Router) BotCommands(loc *i18n.Locale, scope Scope) []tg.BotCommand {
+	var list []tg.BotCommand
+	for _, c := range r.commands {
+		if c.Scope&scope == 0 {
+			continue
+		}
+		list = append(list, tg.BotCommand{
+			Command:     c.Name,
+			Description: loc.T(c.Description),
+		})
+	}
+	return list
+}
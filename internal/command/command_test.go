@@ -0,0 +1,121 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/gotd/td/tg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		Text string
+		Name string
+		Args string
+		OK   bool
+	}{
+		{Text: "https://example.com"},
+		{Text: "/"},
+		{Text: "/help", Name: "help", OK: true},
+		{Text: "/Clip url 1:20-3:05", Name: "clip", Args: "url 1:20-3:05", OK: true},
+		{Text: "/caption\n{title}\n{url}", Name: "caption", Args: "{title}\n{url}", OK: true},
+		{Text: "/help@TentacleBot audio", Name: "help", Args: "audio", OK: true},
+		{Text: "/help@other_bot"},
+	} {
+		t.Run(tt.Text, func(t *testing.T) {
+			name, args, ok := Parse(tt.Text, "tentaclebot")
+			require.Equal(t, tt.OK, ok)
+			require.Equal(t, tt.Name, name)
+			require.Equal(t, tt.Args, args)
+		})
+	}
+}
+
+func TestRouter(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		loc     = i18n.Lookup("en")
+		replies []string
+		called  []Input
+	)
+	r := NewRouter(func(ctx context.Context, in Input, text string) error {
+		replies = append(replies, text)
+		return nil
+	}, func(ctx context.Context, in Input) *i18n.Locale {
+		return loc
+	})
+	r.SetUsername("tentaclebot")
+	r.Add(
+		Command{
+			Name:        "clip",
+			Usage:       "<url> 1:20-3:05",
+			Description: "Cut fragment",
+			Scope:       All,
+			Handler: func(ctx context.Context, in Input) error {
+				if len(in.Fields()) != 2 {
+					return ErrUsage
+				}
+				called = append(called, in)
+				return nil
+			},
+		},
+		Command{
+			Name:        "caption",
+			Description: "Set caption",
+			Scope:       Private | GroupAdmin,
+			Handler:     func(ctx context.Context, in Input) error { return nil },
+		},
+		Command{
+			Name:        "allow",
+			Description: "Allow user",
+			Scope:       Admin,
+			Handler:     func(ctx context.Context, in Input) error { return nil },
+		},
+	)
+	r.Fallback(func(ctx context.Context, in Input) error {
+		called = append(called, in)
+		return nil
+	})
+	handle := func(text string, peer tg.PeerClass) {
+		t.Helper()
+		require.NoError(t, r.Handle(ctx, tg.Entities{}, &tg.UpdateNewMessage{
+			Message: &tg.Message{Message: text, PeerID: peer},
+		}))
+	}
+
+	handle("/clip@tentaclebot url", &tg.PeerUser{UserID: 1})
+	require.Equal(t, []string{"Usage: /clip <url> 1:20-3:05"}, replies)
+
+	handle("/clip url 1:20-3:05", &tg.PeerUser{UserID: 1})
+	require.Len(t, called, 1)
+	require.Equal(t, "clip", called[0].Name)
+
+	handle("just text", &tg.PeerChat{ChatID: 1})
+	require.Len(t, called, 2)
+	require.Empty(t, called[1].Name)
+
+	require.NoError(t, r.Handle(ctx, tg.Entities{}, &tg.UpdateNewChannelMessage{
+		Message: &tg.Message{Message: "/clip url 1:20-3:05", PeerID: &tg.PeerChannel{ChannelID: 1}},
+	}))
+	require.Len(t, called, 3)
+	require.NoError(t, r.Handle(ctx, tg.Entities{}, &tg.UpdateNewChannelMessage{
+		Message: &tg.Message{Message: "/clip url 1:20-3:05", PeerID: &tg.PeerChannel{ChannelID: 1}, Post: true},
+	}))
+	require.Len(t, called, 3)
+
+	handle("/unknown", &tg.PeerChat{ChatID: 1})
+	require.Len(t, replies, 1)
+	handle("/unknown", &tg.PeerUser{UserID: 1})
+	require.Equal(t, "Unknown command /unknown, see /help.", replies[1])
+
+	require.Equal(t, "/clip <url> 1:20-3:05 — Cut fragment", r.Help(loc, Group))
+	require.Len(t, r.BotCommands(loc, GroupAdmin), 2)
+	require.Len(t, r.BotCommands(loc, Private), 2)
+	require.Len(t, r.BotCommands(loc, Private|Admin), 3)
+	help, ok := r.CommandHelp(loc, "/clip")
+	require.True(t, ok)
+	require.Equal(t, "Cut fragment\nUsage: /clip <url> 1:20-3:05", help)
+}
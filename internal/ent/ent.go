@@ -12,9 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ernado/tentacle/internal/ent/grant"
+	"github.com/ernado/tentacle/internal/ent/invite"
+	"github.com/ernado/tentacle/internal/ent/job"
+	"github.com/ernado/tentacle/internal/ent/preference"
+	"github.com/ernado/tentacle/internal/ent/role"
 	"github.com/ernado/tentacle/internal/ent/telegramblob"
 	"github.com/ernado/tentacle/internal/ent/telegramchannel"
 	"github.com/ernado/tentacle/internal/ent/telegramsession"
+	"github.com/ernado/tentacle/internal/ent/usage"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +81,15 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			grant.Table:           grant.ValidColumn,
+			invite.Table:          invite.ValidColumn,
+			job.Table:             job.ValidColumn,
+			preference.Table:      preference.ValidColumn,
+			role.Table:            role.ValidColumn,
 			telegramblob.Table:    telegramblob.ValidColumn,
 			telegramchannel.Table: telegramchannel.ValidColumn,
 			telegramsession.Table: telegramsession.ValidColumn,
+			usage.Table:           usage.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -24,6 +24,7 @@ func run() error {
 			gen.FeatureVersionedMigration,
 			gen.FeatureIntercept,
 			gen.FeatureNamedEdges,
+			gen.FeatureExecQuery,
 		},
 	}); err != nil {
 		return errors.Wrap(err, "ent codegen")
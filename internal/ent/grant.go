@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ernado/tentacle/internal/ent/grant"
+)
+
+// Grant is the model entity for the Grant schema.
+type Grant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PeerType holds the value of the "peer_type" field.
+	PeerType grant.PeerType `json:"peer_type,omitempty"`
+	// PeerID holds the value of the "peer_id" field.
+	PeerID int64 `json:"peer_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role,omitempty"`
+	// user id of admin, zero if redeemed invite
+	GrantedBy int64 `json:"granted_by,omitempty"`
+	// code of redeemed invite
+	Invite string `json:"invite,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Grant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case grant.FieldID, grant.FieldPeerID, grant.FieldGrantedBy:
+			values[i] = new(sql.NullInt64)
+		case grant.FieldPeerType, grant.FieldRole, grant.FieldInvite:
+			values[i] = new(sql.NullString)
+		case grant.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Grant fields.
+func (_m *Grant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case grant.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case grant.FieldPeerType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field peer_type", values[i])
+			} else if value.Valid {
+				_m.PeerType = grant.PeerType(value.String)
+			}
+		case grant.FieldPeerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field peer_id", values[i])
+			} else if value.Valid {
+				_m.PeerID = value.Int64
+			}
+		case grant.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = value.String
+			}
+		case grant.FieldGrantedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_by", values[i])
+			} else if value.Valid {
+				_m.GrantedBy = value.Int64
+			}
+		case grant.FieldInvite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invite", values[i])
+			} else if value.Valid {
+				_m.Invite = value.String
+			}
+		case grant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Grant.
+// This includes values selected through modifiers, order, etc.
+func (_m *Grant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Grant.
+// Note that you need to call Grant.Unwrap() before calling this method if this Grant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Grant) Update() *GrantUpdateOne {
+	return NewGrantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Grant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Grant) Unwrap() *Grant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Grant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Grant) String() string {
+	var builder strings.Builder
+	builder.WriteString("Grant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("peer_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeerType))
+	builder.WriteString(", ")
+	builder.WriteString("peer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeerID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(_m.Role)
+	builder.WriteString(", ")
+	builder.WriteString("granted_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrantedBy))
+	builder.WriteString(", ")
+	builder.WriteString("invite=")
+	builder.WriteString(_m.Invite)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Grants is a parsable slice of Grant.
+type Grants []*Grant
@@ -0,0 +1,119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package grant
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the grant type in the database.
+	Label = "grant"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPeerType holds the string denoting the peer_type field in the database.
+	FieldPeerType = "peer_type"
+	// FieldPeerID holds the string denoting the peer_id field in the database.
+	FieldPeerID = "peer_id"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldGrantedBy holds the string denoting the granted_by field in the database.
+	FieldGrantedBy = "granted_by"
+	// FieldInvite holds the string denoting the invite field in the database.
+	FieldInvite = "invite"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the grant in the database.
+	Table = "grants"
+)
+
+// Columns holds all SQL columns for grant fields.
+var Columns = []string{
+	FieldID,
+	FieldPeerType,
+	FieldPeerID,
+	FieldRole,
+	FieldGrantedBy,
+	FieldInvite,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// PeerType defines the type for the "peer_type" enum field.
+type PeerType string
+
+// PeerType values.
+const (
+	PeerTypeUser    PeerType = "user"
+	PeerTypeChat    PeerType = "chat"
+	PeerTypeChannel PeerType = "channel"
+)
+
+func (pt PeerType) String() string {
+	return string(pt)
+}
+
+// PeerTypeValidator is a validator for the "peer_type" field enum values. It is called by the builders before save.
+func PeerTypeValidator(pt PeerType) error {
+	switch pt {
+	case PeerTypeUser, PeerTypeChat, PeerTypeChannel:
+		return nil
+	default:
+		return fmt.Errorf("grant: invalid enum value for peer_type field: %q", pt)
+	}
+}
+
+// OrderOption defines the ordering options for the Grant queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPeerType orders the results by the peer_type field.
+func ByPeerType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeerType, opts...).ToFunc()
+}
+
+// ByPeerID orders the results by the peer_id field.
+func ByPeerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeerID, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByGrantedBy orders the results by the granted_by field.
+func ByGrantedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrantedBy, opts...).ToFunc()
+}
+
+// ByInvite orders the results by the invite field.
+func ByInvite(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvite, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package grant
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ernado/tentacle/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Grant {
+	return predicate.Grant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Grant {
+	return predicate.Grant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Grant {
+	return predicate.Grant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Grant {
+	return predicate.Grant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Grant {
+	return predicate.Grant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Grant {
+	return predicate.Grant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Grant {
+	return predicate.Grant(sql.FieldLTE(FieldID, id))
+}
+
+// PeerID applies equality check predicate on the "peer_id" field. It's identical to PeerIDEQ.
+func PeerID(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldPeerID, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldRole, v))
+}
+
+// GrantedBy applies equality check predicate on the "granted_by" field. It's identical to GrantedByEQ.
+func GrantedBy(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// Invite applies equality check predicate on the "invite" field. It's identical to InviteEQ.
+func Invite(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldInvite, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PeerTypeEQ applies the EQ predicate on the "peer_type" field.
+func PeerTypeEQ(v PeerType) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldPeerType, v))
+}
+
+// PeerTypeNEQ applies the NEQ predicate on the "peer_type" field.
+func PeerTypeNEQ(v PeerType) predicate.Grant {
+	return predicate.Grant(sql.FieldNEQ(FieldPeerType, v))
+}
+
+// PeerTypeIn applies the In predicate on the "peer_type" field.
+func PeerTypeIn(vs ...PeerType) predicate.Grant {
+	return predicate.Grant(sql.FieldIn(FieldPeerType, vs...))
+}
+
+// PeerTypeNotIn applies the NotIn predicate on the "peer_type" field.
+func PeerTypeNotIn(vs ...PeerType) predicate.Grant {
+	return predicate.Grant(sql.FieldNotIn(FieldPeerType, vs...))
+}
+
+// PeerIDEQ applies the EQ predicate on the "peer_id" field.
+func PeerIDEQ(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldPeerID, v))
+}
+
+// PeerIDNEQ applies the NEQ predicate on the "peer_id" field.
+func PeerIDNEQ(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldNEQ(FieldPeerID, v))
+}
+
+// PeerIDIn applies the In predicate on the "peer_id" field.
+func PeerIDIn(vs ...int64) predicate.Grant {
+	return predicate.Grant(sql.FieldIn(FieldPeerID, vs...))
+}
+
+// PeerIDNotIn applies the NotIn predicate on the "peer_id" field.
+func PeerIDNotIn(vs ...int64) predicate.Grant {
+	return predicate.Grant(sql.FieldNotIn(FieldPeerID, vs...))
+}
+
+// PeerIDGT applies the GT predicate on the "peer_id" field.
+func PeerIDGT(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldGT(FieldPeerID, v))
+}
+
+// PeerIDGTE applies the GTE predicate on the "peer_id" field.
+func PeerIDGTE(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldGTE(FieldPeerID, v))
+}
+
+// PeerIDLT applies the LT predicate on the "peer_id" field.
+func PeerIDLT(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldLT(FieldPeerID, v))
+}
+
+// PeerIDLTE applies the LTE predicate on the "peer_id" field.
+func PeerIDLTE(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldLTE(FieldPeerID, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.Grant {
+	return predicate.Grant(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.Grant {
+	return predicate.Grant(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldContainsFold(FieldRole, v))
+}
+
+// GrantedByEQ applies the EQ predicate on the "granted_by" field.
+func GrantedByEQ(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// GrantedByNEQ applies the NEQ predicate on the "granted_by" field.
+func GrantedByNEQ(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldNEQ(FieldGrantedBy, v))
+}
+
+// GrantedByIn applies the In predicate on the "granted_by" field.
+func GrantedByIn(vs ...int64) predicate.Grant {
+	return predicate.Grant(sql.FieldIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByNotIn applies the NotIn predicate on the "granted_by" field.
+func GrantedByNotIn(vs ...int64) predicate.Grant {
+	return predicate.Grant(sql.FieldNotIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByGT applies the GT predicate on the "granted_by" field.
+func GrantedByGT(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldGT(FieldGrantedBy, v))
+}
+
+// GrantedByGTE applies the GTE predicate on the "granted_by" field.
+func GrantedByGTE(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldGTE(FieldGrantedBy, v))
+}
+
+// GrantedByLT applies the LT predicate on the "granted_by" field.
+func GrantedByLT(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldLT(FieldGrantedBy, v))
+}
+
+// GrantedByLTE applies the LTE predicate on the "granted_by" field.
+func GrantedByLTE(v int64) predicate.Grant {
+	return predicate.Grant(sql.FieldLTE(FieldGrantedBy, v))
+}
+
+// InviteEQ applies the EQ predicate on the "invite" field.
+func InviteEQ(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldInvite, v))
+}
+
+// InviteNEQ applies the NEQ predicate on the "invite" field.
+func InviteNEQ(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldNEQ(FieldInvite, v))
+}
+
+// InviteIn applies the In predicate on the "invite" field.
+func InviteIn(vs ...string) predicate.Grant {
+	return predicate.Grant(sql.FieldIn(FieldInvite, vs...))
+}
+
+// InviteNotIn applies the NotIn predicate on the "invite" field.
+func InviteNotIn(vs ...string) predicate.Grant {
+	return predicate.Grant(sql.FieldNotIn(FieldInvite, vs...))
+}
+
+// InviteGT applies the GT predicate on the "invite" field.
+func InviteGT(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldGT(FieldInvite, v))
+}
+
+// InviteGTE applies the GTE predicate on the "invite" field.
+func InviteGTE(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldGTE(FieldInvite, v))
+}
+
+// InviteLT applies the LT predicate on the "invite" field.
+func InviteLT(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldLT(FieldInvite, v))
+}
+
+// InviteLTE applies the LTE predicate on the "invite" field.
+func InviteLTE(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldLTE(FieldInvite, v))
+}
+
+// InviteContains applies the Contains predicate on the "invite" field.
+func InviteContains(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldContains(FieldInvite, v))
+}
+
+// InviteHasPrefix applies the HasPrefix predicate on the "invite" field.
+func InviteHasPrefix(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldHasPrefix(FieldInvite, v))
+}
+
+// InviteHasSuffix applies the HasSuffix predicate on the "invite" field.
+func InviteHasSuffix(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldHasSuffix(FieldInvite, v))
+}
+
+// InviteIsNil applies the IsNil predicate on the "invite" field.
+func InviteIsNil() predicate.Grant {
+	return predicate.Grant(sql.FieldIsNull(FieldInvite))
+}
+
+// InviteNotNil applies the NotNil predicate on the "invite" field.
+func InviteNotNil() predicate.Grant {
+	return predicate.Grant(sql.FieldNotNull(FieldInvite))
+}
+
+// InviteEqualFold applies the EqualFold predicate on the "invite" field.
+func InviteEqualFold(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldEqualFold(FieldInvite, v))
+}
+
+// InviteContainsFold applies the ContainsFold predicate on the "invite" field.
+func InviteContainsFold(v string) predicate.Grant {
+	return predicate.Grant(sql.FieldContainsFold(FieldInvite, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Grant {
+	return predicate.Grant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Grant {
+	return predicate.Grant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Grant {
+	return predicate.Grant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Grant {
+	return predicate.Grant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Grant {
+	return predicate.Grant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Grant {
+	return predicate.Grant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Grant {
+	return predicate.Grant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Grant {
+	return predicate.Grant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Grant) predicate.Grant {
+	return predicate.Grant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Grant) predicate.Grant {
+	return predicate.Grant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Grant) predicate.Grant {
+	return predicate.Grant(sql.NotPredicates(p))
+}
@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/grant"
+)
+
+// GrantCreate is the builder for creating a Grant entity.
+type GrantCreate struct {
+	config
+	mutation *GrantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_c *GrantCreate) SetPeerType(v grant.PeerType) *GrantCreate {
+	_c.mutation.SetPeerType(v)
+	return _c
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_c *GrantCreate) SetPeerID(v int64) *GrantCreate {
+	_c.mutation.SetPeerID(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *GrantCreate) SetRole(v string) *GrantCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_c *GrantCreate) SetGrantedBy(v int64) *GrantCreate {
+	_c.mutation.SetGrantedBy(v)
+	return _c
+}
+
+// SetInvite sets the "invite" field.
+func (_c *GrantCreate) SetInvite(v string) *GrantCreate {
+	_c.mutation.SetInvite(v)
+	return _c
+}
+
+// SetNillableInvite sets the "invite" field if the given value is not nil.
+func (_c *GrantCreate) SetNillableInvite(v *string) *GrantCreate {
+	if v != nil {
+		_c.SetInvite(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GrantCreate) SetCreatedAt(v time.Time) *GrantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GrantCreate) SetNillableCreatedAt(v *time.Time) *GrantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the GrantMutation object of the builder.
+func (_c *GrantCreate) Mutation() *GrantMutation {
+	return _c.mutation
+}
+
+// Save creates the Grant in the database.
+func (_c *GrantCreate) Save(ctx context.Context) (*Grant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GrantCreate) SaveX(ctx context.Context) *Grant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GrantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GrantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GrantCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := grant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GrantCreate) check() error {
+	if _, ok := _c.mutation.PeerType(); !ok {
+		return &ValidationError{Name: "peer_type", err: errors.New(`ent: missing required field "Grant.peer_type"`)}
+	}
+	if v, ok := _c.mutation.PeerType(); ok {
+		if err := grant.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Grant.peer_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PeerID(); !ok {
+		return &ValidationError{Name: "peer_id", err: errors.New(`ent: missing required field "Grant.peer_id"`)}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Grant.role"`)}
+	}
+	if _, ok := _c.mutation.GrantedBy(); !ok {
+		return &ValidationError{Name: "granted_by", err: errors.New(`ent: missing required field "Grant.granted_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Grant.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *GrantCreate) sqlSave(ctx context.Context) (*Grant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GrantCreate) createSpec() (*Grant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Grant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(grant.Table, sqlgraph.NewFieldSpec(grant.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.PeerType(); ok {
+		_spec.SetField(grant.FieldPeerType, field.TypeEnum, value)
+		_node.PeerType = value
+	}
+	if value, ok := _c.mutation.PeerID(); ok {
+		_spec.SetField(grant.FieldPeerID, field.TypeInt64, value)
+		_node.PeerID = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(grant.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.GrantedBy(); ok {
+		_spec.SetField(grant.FieldGrantedBy, field.TypeInt64, value)
+		_node.GrantedBy = value
+	}
+	if value, ok := _c.mutation.Invite(); ok {
+		_spec.SetField(grant.FieldInvite, field.TypeString, value)
+		_node.Invite = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(grant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Grant.Create().
+//		SetPeerType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GrantUpsert) {
+//			SetPeerType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GrantCreate) OnConflict(opts ...sql.ConflictOption) *GrantUpsertOne {
+	_c.conflict = opts
+	return &GrantUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Grant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GrantCreate) OnConflictColumns(columns ...string) *GrantUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GrantUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GrantUpsertOne is the builder for "upsert"-ing
+	//  one Grant node.
+	GrantUpsertOne struct {
+		create *GrantCreate
+	}
+
+	// GrantUpsert is the "OnConflict" setter.
+	GrantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPeerType sets the "peer_type" field.
+func (u *GrantUpsert) SetPeerType(v grant.PeerType) *GrantUpsert {
+	u.Set(grant.FieldPeerType, v)
+	return u
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *GrantUpsert) UpdatePeerType() *GrantUpsert {
+	u.SetExcluded(grant.FieldPeerType)
+	return u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *GrantUpsert) SetPeerID(v int64) *GrantUpsert {
+	u.Set(grant.FieldPeerID, v)
+	return u
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *GrantUpsert) UpdatePeerID() *GrantUpsert {
+	u.SetExcluded(grant.FieldPeerID)
+	return u
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *GrantUpsert) AddPeerID(v int64) *GrantUpsert {
+	u.Add(grant.FieldPeerID, v)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *GrantUpsert) SetRole(v string) *GrantUpsert {
+	u.Set(grant.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *GrantUpsert) UpdateRole() *GrantUpsert {
+	u.SetExcluded(grant.FieldRole)
+	return u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *GrantUpsert) SetGrantedBy(v int64) *GrantUpsert {
+	u.Set(grant.FieldGrantedBy, v)
+	return u
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *GrantUpsert) UpdateGrantedBy() *GrantUpsert {
+	u.SetExcluded(grant.FieldGrantedBy)
+	return u
+}
+
+// AddGrantedBy adds v to the "granted_by" field.
+func (u *GrantUpsert) AddGrantedBy(v int64) *GrantUpsert {
+	u.Add(grant.FieldGrantedBy, v)
+	return u
+}
+
+// SetInvite sets the "invite" field.
+func (u *GrantUpsert) SetInvite(v string) *GrantUpsert {
+	u.Set(grant.FieldInvite, v)
+	return u
+}
+
+// UpdateInvite sets the "invite" field to the value that was provided on create.
+func (u *GrantUpsert) UpdateInvite() *GrantUpsert {
+	u.SetExcluded(grant.FieldInvite)
+	return u
+}
+
+// ClearInvite clears the value of the "invite" field.
+func (u *GrantUpsert) ClearInvite() *GrantUpsert {
+	u.SetNull(grant.FieldInvite)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Grant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GrantUpsertOne) UpdateNewValues() *GrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(grant.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Grant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GrantUpsertOne) Ignore() *GrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GrantUpsertOne) DoNothing() *GrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GrantCreate.OnConflict
+// documentation for more info.
+func (u *GrantUpsertOne) Update(set func(*GrantUpsert)) *GrantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GrantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (u *GrantUpsertOne) SetPeerType(v grant.PeerType) *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetPeerType(v)
+	})
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *GrantUpsertOne) UpdatePeerType() *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdatePeerType()
+	})
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *GrantUpsertOne) SetPeerID(v int64) *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetPeerID(v)
+	})
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *GrantUpsertOne) AddPeerID(v int64) *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.AddPeerID(v)
+	})
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *GrantUpsertOne) UpdatePeerID() *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdatePeerID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *GrantUpsertOne) SetRole(v string) *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *GrantUpsertOne) UpdateRole() *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *GrantUpsertOne) SetGrantedBy(v int64) *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// AddGrantedBy adds v to the "granted_by" field.
+func (u *GrantUpsertOne) AddGrantedBy(v int64) *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.AddGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *GrantUpsertOne) UpdateGrantedBy() *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// SetInvite sets the "invite" field.
+func (u *GrantUpsertOne) SetInvite(v string) *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetInvite(v)
+	})
+}
+
+// UpdateInvite sets the "invite" field to the value that was provided on create.
+func (u *GrantUpsertOne) UpdateInvite() *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdateInvite()
+	})
+}
+
+// ClearInvite clears the value of the "invite" field.
+func (u *GrantUpsertOne) ClearInvite() *GrantUpsertOne {
+	return u.Update(func(s *GrantUpsert) {
+		s.ClearInvite()
+	})
+}
+
+// Exec executes the query.
+func (u *GrantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GrantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GrantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GrantUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GrantUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GrantCreateBulk is the builder for creating many Grant entities in bulk.
+type GrantCreateBulk struct {
+	config
+	err      error
+	builders []*GrantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Grant entities in the database.
+func (_c *GrantCreateBulk) Save(ctx context.Context) ([]*Grant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Grant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GrantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GrantCreateBulk) SaveX(ctx context.Context) []*Grant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GrantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GrantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Grant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GrantUpsert) {
+//			SetPeerType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GrantCreateBulk) OnConflict(opts ...sql.ConflictOption) *GrantUpsertBulk {
+	_c.conflict = opts
+	return &GrantUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Grant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GrantCreateBulk) OnConflictColumns(columns ...string) *GrantUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GrantUpsertBulk{
+		create: _c,
+	}
+}
+
+// GrantUpsertBulk is the builder for "upsert"-ing
+// a bulk of Grant nodes.
+type GrantUpsertBulk struct {
+	create *GrantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Grant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GrantUpsertBulk) UpdateNewValues() *GrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(grant.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Grant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GrantUpsertBulk) Ignore() *GrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GrantUpsertBulk) DoNothing() *GrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GrantCreateBulk.OnConflict
+// documentation for more info.
+func (u *GrantUpsertBulk) Update(set func(*GrantUpsert)) *GrantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GrantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (u *GrantUpsertBulk) SetPeerType(v grant.PeerType) *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetPeerType(v)
+	})
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *GrantUpsertBulk) UpdatePeerType() *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdatePeerType()
+	})
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *GrantUpsertBulk) SetPeerID(v int64) *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetPeerID(v)
+	})
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *GrantUpsertBulk) AddPeerID(v int64) *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.AddPeerID(v)
+	})
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *GrantUpsertBulk) UpdatePeerID() *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdatePeerID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *GrantUpsertBulk) SetRole(v string) *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *GrantUpsertBulk) UpdateRole() *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (u *GrantUpsertBulk) SetGrantedBy(v int64) *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetGrantedBy(v)
+	})
+}
+
+// AddGrantedBy adds v to the "granted_by" field.
+func (u *GrantUpsertBulk) AddGrantedBy(v int64) *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.AddGrantedBy(v)
+	})
+}
+
+// UpdateGrantedBy sets the "granted_by" field to the value that was provided on create.
+func (u *GrantUpsertBulk) UpdateGrantedBy() *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdateGrantedBy()
+	})
+}
+
+// SetInvite sets the "invite" field.
+func (u *GrantUpsertBulk) SetInvite(v string) *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.SetInvite(v)
+	})
+}
+
+// UpdateInvite sets the "invite" field to the value that was provided on create.
+func (u *GrantUpsertBulk) UpdateInvite() *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.UpdateInvite()
+	})
+}
+
+// ClearInvite clears the value of the "invite" field.
+func (u *GrantUpsertBulk) ClearInvite() *GrantUpsertBulk {
+	return u.Update(func(s *GrantUpsert) {
+		s.ClearInvite()
+	})
+}
+
+// Exec executes the query.
+func (u *GrantUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GrantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GrantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GrantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/grant"
+	"github.com/ernado/tentacle/internal/ent/predicate"
+)
+
+// GrantUpdate is the builder for updating Grant entities.
+type GrantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GrantMutation
+}
+
+// Where appends a list predicates to the GrantUpdate builder.
+func (_u *GrantUpdate) Where(ps ...predicate.Grant) *GrantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_u *GrantUpdate) SetPeerType(v grant.PeerType) *GrantUpdate {
+	_u.mutation.SetPeerType(v)
+	return _u
+}
+
+// SetNillablePeerType sets the "peer_type" field if the given value is not nil.
+func (_u *GrantUpdate) SetNillablePeerType(v *grant.PeerType) *GrantUpdate {
+	if v != nil {
+		_u.SetPeerType(*v)
+	}
+	return _u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_u *GrantUpdate) SetPeerID(v int64) *GrantUpdate {
+	_u.mutation.ResetPeerID()
+	_u.mutation.SetPeerID(v)
+	return _u
+}
+
+// SetNillablePeerID sets the "peer_id" field if the given value is not nil.
+func (_u *GrantUpdate) SetNillablePeerID(v *int64) *GrantUpdate {
+	if v != nil {
+		_u.SetPeerID(*v)
+	}
+	return _u
+}
+
+// AddPeerID adds value to the "peer_id" field.
+func (_u *GrantUpdate) AddPeerID(v int64) *GrantUpdate {
+	_u.mutation.AddPeerID(v)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *GrantUpdate) SetRole(v string) *GrantUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *GrantUpdate) SetNillableRole(v *string) *GrantUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *GrantUpdate) SetGrantedBy(v int64) *GrantUpdate {
+	_u.mutation.ResetGrantedBy()
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *GrantUpdate) SetNillableGrantedBy(v *int64) *GrantUpdate {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// AddGrantedBy adds value to the "granted_by" field.
+func (_u *GrantUpdate) AddGrantedBy(v int64) *GrantUpdate {
+	_u.mutation.AddGrantedBy(v)
+	return _u
+}
+
+// SetInvite sets the "invite" field.
+func (_u *GrantUpdate) SetInvite(v string) *GrantUpdate {
+	_u.mutation.SetInvite(v)
+	return _u
+}
+
+// SetNillableInvite sets the "invite" field if the given value is not nil.
+func (_u *GrantUpdate) SetNillableInvite(v *string) *GrantUpdate {
+	if v != nil {
+		_u.SetInvite(*v)
+	}
+	return _u
+}
+
+// ClearInvite clears the value of the "invite" field.
+func (_u *GrantUpdate) ClearInvite() *GrantUpdate {
+	_u.mutation.ClearInvite()
+	return _u
+}
+
+// Mutation returns the GrantMutation object of the builder.
+func (_u *GrantUpdate) Mutation() *GrantMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GrantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GrantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GrantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GrantUpdate) check() error {
+	if v, ok := _u.mutation.PeerType(); ok {
+		if err := grant.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Grant.peer_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GrantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(grant.Table, grant.Columns, sqlgraph.NewFieldSpec(grant.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeerType(); ok {
+		_spec.SetField(grant.FieldPeerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerID(); ok {
+		_spec.SetField(grant.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPeerID(); ok {
+		_spec.AddField(grant.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(grant.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(grant.FieldGrantedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGrantedBy(); ok {
+		_spec.AddField(grant.FieldGrantedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Invite(); ok {
+		_spec.SetField(grant.FieldInvite, field.TypeString, value)
+	}
+	if _u.mutation.InviteCleared() {
+		_spec.ClearField(grant.FieldInvite, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GrantUpdateOne is the builder for updating a single Grant entity.
+type GrantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GrantMutation
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_u *GrantUpdateOne) SetPeerType(v grant.PeerType) *GrantUpdateOne {
+	_u.mutation.SetPeerType(v)
+	return _u
+}
+
+// SetNillablePeerType sets the "peer_type" field if the given value is not nil.
+func (_u *GrantUpdateOne) SetNillablePeerType(v *grant.PeerType) *GrantUpdateOne {
+	if v != nil {
+		_u.SetPeerType(*v)
+	}
+	return _u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_u *GrantUpdateOne) SetPeerID(v int64) *GrantUpdateOne {
+	_u.mutation.ResetPeerID()
+	_u.mutation.SetPeerID(v)
+	return _u
+}
+
+// SetNillablePeerID sets the "peer_id" field if the given value is not nil.
+func (_u *GrantUpdateOne) SetNillablePeerID(v *int64) *GrantUpdateOne {
+	if v != nil {
+		_u.SetPeerID(*v)
+	}
+	return _u
+}
+
+// AddPeerID adds value to the "peer_id" field.
+func (_u *GrantUpdateOne) AddPeerID(v int64) *GrantUpdateOne {
+	_u.mutation.AddPeerID(v)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *GrantUpdateOne) SetRole(v string) *GrantUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *GrantUpdateOne) SetNillableRole(v *string) *GrantUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *GrantUpdateOne) SetGrantedBy(v int64) *GrantUpdateOne {
+	_u.mutation.ResetGrantedBy()
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *GrantUpdateOne) SetNillableGrantedBy(v *int64) *GrantUpdateOne {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// AddGrantedBy adds value to the "granted_by" field.
+func (_u *GrantUpdateOne) AddGrantedBy(v int64) *GrantUpdateOne {
+	_u.mutation.AddGrantedBy(v)
+	return _u
+}
+
+// SetInvite sets the "invite" field.
+func (_u *GrantUpdateOne) SetInvite(v string) *GrantUpdateOne {
+	_u.mutation.SetInvite(v)
+	return _u
+}
+
+// SetNillableInvite sets the "invite" field if the given value is not nil.
+func (_u *GrantUpdateOne) SetNillableInvite(v *string) *GrantUpdateOne {
+	if v != nil {
+		_u.SetInvite(*v)
+	}
+	return _u
+}
+
+// ClearInvite clears the value of the "invite" field.
+func (_u *GrantUpdateOne) ClearInvite() *GrantUpdateOne {
+	_u.mutation.ClearInvite()
+	return _u
+}
+
+// Mutation returns the GrantMutation object of the builder.
+func (_u *GrantUpdateOne) Mutation() *GrantMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GrantUpdate builder.
+func (_u *GrantUpdateOne) Where(ps ...predicate.Grant) *GrantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GrantUpdateOne) Select(field string, fields ...string) *GrantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Grant entity.
+func (_u *GrantUpdateOne) Save(ctx context.Context) (*Grant, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GrantUpdateOne) SaveX(ctx context.Context) *Grant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GrantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GrantUpdateOne) check() error {
+	if v, ok := _u.mutation.PeerType(); ok {
+		if err := grant.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Grant.peer_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GrantUpdateOne) sqlSave(ctx context.Context) (_node *Grant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(grant.Table, grant.Columns, sqlgraph.NewFieldSpec(grant.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Grant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, grant.FieldID)
+		for _, f := range fields {
+			if !grant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != grant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeerType(); ok {
+		_spec.SetField(grant.FieldPeerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerID(); ok {
+		_spec.SetField(grant.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPeerID(); ok {
+		_spec.AddField(grant.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(grant.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(grant.FieldGrantedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGrantedBy(); ok {
+		_spec.AddField(grant.FieldGrantedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Invite(); ok {
+		_spec.SetField(grant.FieldInvite, field.TypeString, value)
+	}
+	if _u.mutation.InviteCleared() {
+		_spec.ClearField(grant.FieldInvite, field.TypeString)
+	}
+	_node = &Grant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,809 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/invite"
+)
+
+// InviteCreate is the builder for creating a Invite entity.
+type InviteCreate struct {
+	config
+	mutation *InviteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCode sets the "code" field.
+func (_c *InviteCreate) SetCode(v string) *InviteCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *InviteCreate) SetRole(v string) *InviteCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_c *InviteCreate) SetMaxUses(v int) *InviteCreate {
+	_c.mutation.SetMaxUses(v)
+	return _c
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_c *InviteCreate) SetNillableMaxUses(v *int) *InviteCreate {
+	if v != nil {
+		_c.SetMaxUses(*v)
+	}
+	return _c
+}
+
+// SetUses sets the "uses" field.
+func (_c *InviteCreate) SetUses(v int) *InviteCreate {
+	_c.mutation.SetUses(v)
+	return _c
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_c *InviteCreate) SetNillableUses(v *int) *InviteCreate {
+	if v != nil {
+		_c.SetUses(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *InviteCreate) SetCreatedBy(v int64) *InviteCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InviteCreate) SetCreatedAt(v time.Time) *InviteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InviteCreate) SetNillableCreatedAt(v *time.Time) *InviteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (_c *InviteCreate) Mutation() *InviteMutation {
+	return _c.mutation
+}
+
+// Save creates the Invite in the database.
+func (_c *InviteCreate) Save(ctx context.Context) (*Invite, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InviteCreate) SaveX(ctx context.Context) *Invite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InviteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InviteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InviteCreate) defaults() {
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		v := invite.DefaultMaxUses
+		_c.mutation.SetMaxUses(v)
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		v := invite.DefaultUses
+		_c.mutation.SetUses(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invite.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InviteCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Invite.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := invite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Invite.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Invite.role"`)}
+	}
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "Invite.max_uses"`)}
+	}
+	if v, ok := _c.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "Invite.uses"`)}
+	}
+	if v, ok := _c.mutation.Uses(); ok {
+		if err := invite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "Invite.uses": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Invite.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invite.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *InviteCreate) sqlSave(ctx context.Context) (*Invite, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InviteCreate) createSpec() (*Invite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invite{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invite.Table, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(invite.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(invite.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := _c.mutation.Uses(); ok {
+		_spec.SetField(invite.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(invite.FieldCreatedBy, field.TypeInt64, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invite.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InviteUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InviteCreate) OnConflict(opts ...sql.ConflictOption) *InviteUpsertOne {
+	_c.conflict = opts
+	return &InviteUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InviteCreate) OnConflictColumns(columns ...string) *InviteUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InviteUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InviteUpsertOne is the builder for "upsert"-ing
+	//  one Invite node.
+	InviteUpsertOne struct {
+		create *InviteCreate
+	}
+
+	// InviteUpsert is the "OnConflict" setter.
+	InviteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCode sets the "code" field.
+func (u *InviteUpsert) SetCode(v string) *InviteUpsert {
+	u.Set(invite.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateCode() *InviteUpsert {
+	u.SetExcluded(invite.FieldCode)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *InviteUpsert) SetRole(v string) *InviteUpsert {
+	u.Set(invite.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateRole() *InviteUpsert {
+	u.SetExcluded(invite.FieldRole)
+	return u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InviteUpsert) SetMaxUses(v int) *InviteUpsert {
+	u.Set(invite.FieldMaxUses, v)
+	return u
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateMaxUses() *InviteUpsert {
+	u.SetExcluded(invite.FieldMaxUses)
+	return u
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InviteUpsert) AddMaxUses(v int) *InviteUpsert {
+	u.Add(invite.FieldMaxUses, v)
+	return u
+}
+
+// SetUses sets the "uses" field.
+func (u *InviteUpsert) SetUses(v int) *InviteUpsert {
+	u.Set(invite.FieldUses, v)
+	return u
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateUses() *InviteUpsert {
+	u.SetExcluded(invite.FieldUses)
+	return u
+}
+
+// AddUses adds v to the "uses" field.
+func (u *InviteUpsert) AddUses(v int) *InviteUpsert {
+	u.Add(invite.FieldUses, v)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *InviteUpsert) SetCreatedBy(v int64) *InviteUpsert {
+	u.Set(invite.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateCreatedBy() *InviteUpsert {
+	u.SetExcluded(invite.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *InviteUpsert) AddCreatedBy(v int64) *InviteUpsert {
+	u.Add(invite.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InviteUpsertOne) UpdateNewValues() *InviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invite.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InviteUpsertOne) Ignore() *InviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InviteUpsertOne) DoNothing() *InviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InviteCreate.OnConflict
+// documentation for more info.
+func (u *InviteUpsertOne) Update(set func(*InviteUpsert)) *InviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *InviteUpsertOne) SetCode(v string) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateCode() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *InviteUpsertOne) SetRole(v string) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateRole() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InviteUpsertOne) SetMaxUses(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InviteUpsertOne) AddMaxUses(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateMaxUses() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *InviteUpsertOne) SetUses(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *InviteUpsertOne) AddUses(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateUses() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *InviteUpsertOne) SetCreatedBy(v int64) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *InviteUpsertOne) AddCreatedBy(v int64) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateCreatedBy() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *InviteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InviteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InviteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InviteUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InviteUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InviteCreateBulk is the builder for creating many Invite entities in bulk.
+type InviteCreateBulk struct {
+	config
+	err      error
+	builders []*InviteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invite entities in the database.
+func (_c *InviteCreateBulk) Save(ctx context.Context) ([]*Invite, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Invite, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InviteCreateBulk) SaveX(ctx context.Context) []*Invite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InviteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invite.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InviteUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InviteCreateBulk) OnConflict(opts ...sql.ConflictOption) *InviteUpsertBulk {
+	_c.conflict = opts
+	return &InviteUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InviteCreateBulk) OnConflictColumns(columns ...string) *InviteUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InviteUpsertBulk{
+		create: _c,
+	}
+}
+
+// InviteUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invite nodes.
+type InviteUpsertBulk struct {
+	create *InviteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InviteUpsertBulk) UpdateNewValues() *InviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invite.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InviteUpsertBulk) Ignore() *InviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InviteUpsertBulk) DoNothing() *InviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InviteCreateBulk.OnConflict
+// documentation for more info.
+func (u *InviteUpsertBulk) Update(set func(*InviteUpsert)) *InviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *InviteUpsertBulk) SetCode(v string) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateCode() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *InviteUpsertBulk) SetRole(v string) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateRole() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InviteUpsertBulk) SetMaxUses(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InviteUpsertBulk) AddMaxUses(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateMaxUses() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *InviteUpsertBulk) SetUses(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *InviteUpsertBulk) AddUses(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateUses() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *InviteUpsertBulk) SetCreatedBy(v int64) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *InviteUpsertBulk) AddCreatedBy(v int64) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateCreatedBy() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *InviteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InviteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InviteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InviteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
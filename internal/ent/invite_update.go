@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/invite"
+	"github.com/ernado/tentacle/internal/ent/predicate"
+)
+
+// InviteUpdate is the builder for updating Invite entities.
+type InviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (_u *InviteUpdate) Where(ps ...predicate.Invite) *InviteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *InviteUpdate) SetCode(v string) *InviteUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *InviteUpdate) SetNillableCode(v *string) *InviteUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *InviteUpdate) SetRole(v string) *InviteUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *InviteUpdate) SetNillableRole(v *string) *InviteUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *InviteUpdate) SetMaxUses(v int) *InviteUpdate {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *InviteUpdate) SetNillableMaxUses(v *int) *InviteUpdate {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *InviteUpdate) AddMaxUses(v int) *InviteUpdate {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *InviteUpdate) SetUses(v int) *InviteUpdate {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *InviteUpdate) SetNillableUses(v *int) *InviteUpdate {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *InviteUpdate) AddUses(v int) *InviteUpdate {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *InviteUpdate) SetCreatedBy(v int64) *InviteUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *InviteUpdate) SetNillableCreatedBy(v *int64) *InviteUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *InviteUpdate) AddCreatedBy(v int64) *InviteUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (_u *InviteUpdate) Mutation() *InviteMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InviteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InviteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InviteUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := invite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Invite.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Uses(); ok {
+		if err := invite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "Invite.uses": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InviteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(invite.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(invite.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(invite.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(invite.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InviteUpdateOne is the builder for updating a single Invite entity.
+type InviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// SetCode sets the "code" field.
+func (_u *InviteUpdateOne) SetCode(v string) *InviteUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *InviteUpdateOne) SetNillableCode(v *string) *InviteUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *InviteUpdateOne) SetRole(v string) *InviteUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *InviteUpdateOne) SetNillableRole(v *string) *InviteUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *InviteUpdateOne) SetMaxUses(v int) *InviteUpdateOne {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *InviteUpdateOne) SetNillableMaxUses(v *int) *InviteUpdateOne {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *InviteUpdateOne) AddMaxUses(v int) *InviteUpdateOne {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *InviteUpdateOne) SetUses(v int) *InviteUpdateOne {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *InviteUpdateOne) SetNillableUses(v *int) *InviteUpdateOne {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *InviteUpdateOne) AddUses(v int) *InviteUpdateOne {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *InviteUpdateOne) SetCreatedBy(v int64) *InviteUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *InviteUpdateOne) SetNillableCreatedBy(v *int64) *InviteUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *InviteUpdateOne) AddCreatedBy(v int64) *InviteUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (_u *InviteUpdateOne) Mutation() *InviteMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (_u *InviteUpdateOne) Where(ps ...predicate.Invite) *InviteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InviteUpdateOne) Select(field string, fields ...string) *InviteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Invite entity.
+func (_u *InviteUpdateOne) Save(ctx context.Context) (*Invite, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InviteUpdateOne) SaveX(ctx context.Context) *Invite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InviteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InviteUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := invite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Invite.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Uses(); ok {
+		if err := invite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "Invite.uses": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InviteUpdateOne) sqlSave(ctx context.Context) (_node *Invite, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invite.FieldID)
+		for _, f := range fields {
+			if !invite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(invite.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(invite.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(invite.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(invite.FieldCreatedBy, field.TypeInt64, value)
+	}
+	_node = &Invite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package job
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the job type in the database.
+	Label = "job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldUserType holds the string denoting the user_type field in the database.
+	FieldUserType = "user_type"
+	// FieldPeerType holds the string denoting the peer_type field in the database.
+	FieldPeerType = "peer_type"
+	// FieldPeerID holds the string denoting the peer_id field in the database.
+	FieldPeerID = "peer_id"
+	// FieldAccessHash holds the string denoting the access_hash field in the database.
+	FieldAccessHash = "access_hash"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldConversion holds the string denoting the conversion field in the database.
+	FieldConversion = "conversion"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// Table holds the table name of the job in the database.
+	Table = "jobs"
+)
+
+// Columns holds all SQL columns for job fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldUserType,
+	FieldPeerType,
+	FieldPeerID,
+	FieldAccessHash,
+	FieldMessageID,
+	FieldURL,
+	FieldOptions,
+	FieldState,
+	FieldAttempts,
+	FieldError,
+	FieldConversion,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldStartedAt,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAccessHash holds the default value on creation for the "access_hash" field.
+	DefaultAccessHash int64
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// UserType defines the type for the "user_type" enum field.
+type UserType string
+
+// UserTypeUser is the default value of the UserType enum.
+const DefaultUserType = UserTypeUser
+
+// UserType values.
+const (
+	UserTypeUser    UserType = "user"
+	UserTypeChannel UserType = "channel"
+)
+
+func (ut UserType) String() string {
+	return string(ut)
+}
+
+// UserTypeValidator is a validator for the "user_type" field enum values. It is called by the builders before save.
+func UserTypeValidator(ut UserType) error {
+	switch ut {
+	case UserTypeUser, UserTypeChannel:
+		return nil
+	default:
+		return fmt.Errorf("job: invalid enum value for user_type field: %q", ut)
+	}
+}
+
+// PeerType defines the type for the "peer_type" enum field.
+type PeerType string
+
+// PeerType values.
+const (
+	PeerTypeUser    PeerType = "user"
+	PeerTypeChat    PeerType = "chat"
+	PeerTypeChannel PeerType = "channel"
+)
+
+func (pt PeerType) String() string {
+	return string(pt)
+}
+
+// PeerTypeValidator is a validator for the "peer_type" field enum values. It is called by the builders before save.
+func PeerTypeValidator(pt PeerType) error {
+	switch pt {
+	case PeerTypeUser, PeerTypeChat, PeerTypeChannel:
+		return nil
+	default:
+		return fmt.Errorf("job: invalid enum value for peer_type field: %q", pt)
+	}
+}
+
+// State defines the type for the "state" enum field.
+type State string
+
+// StateQueued is the default value of the State enum.
+const DefaultState = StateQueued
+
+// State values.
+const (
+	StateQueued   State = "queued"
+	StateRunning  State = "running"
+	StateDone     State = "done"
+	StateFailed   State = "failed"
+	StateCanceled State = "canceled"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StateQueued, StateRunning, StateDone, StateFailed, StateCanceled:
+		return nil
+	default:
+		return fmt.Errorf("job: invalid enum value for state field: %q", s)
+	}
+}
+
+// Conversion defines the type for the "conversion" enum field.
+type Conversion string
+
+// Conversion values.
+const (
+	ConversionCopy  Conversion = "copy"
+	ConversionAudio Conversion = "audio"
+	ConversionVideo Conversion = "video"
+)
+
+func (c Conversion) String() string {
+	return string(c)
+}
+
+// ConversionValidator is a validator for the "conversion" field enum values. It is called by the builders before save.
+func ConversionValidator(c Conversion) error {
+	switch c {
+	case ConversionCopy, ConversionAudio, ConversionVideo:
+		return nil
+	default:
+		return fmt.Errorf("job: invalid enum value for conversion field: %q", c)
+	}
+}
+
+// OrderOption defines the ordering options for the Job queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByUserType orders the results by the user_type field.
+func ByUserType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserType, opts...).ToFunc()
+}
+
+// ByPeerType orders the results by the peer_type field.
+func ByPeerType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeerType, opts...).ToFunc()
+}
+
+// ByPeerID orders the results by the peer_id field.
+func ByPeerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeerID, opts...).ToFunc()
+}
+
+// ByAccessHash orders the results by the access_hash field.
+func ByAccessHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessHash, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByConversion orders the results by the conversion field.
+func ByConversion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConversion, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package job
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ernado/tentacle/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUserID, v))
+}
+
+// PeerID applies equality check predicate on the "peer_id" field. It's identical to PeerIDEQ.
+func PeerID(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldPeerID, v))
+}
+
+// AccessHash applies equality check predicate on the "access_hash" field. It's identical to AccessHashEQ.
+func AccessHash(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAccessHash, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldMessageID, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldURL, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAttempts, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserTypeEQ applies the EQ predicate on the "user_type" field.
+func UserTypeEQ(v UserType) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUserType, v))
+}
+
+// UserTypeNEQ applies the NEQ predicate on the "user_type" field.
+func UserTypeNEQ(v UserType) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUserType, v))
+}
+
+// UserTypeIn applies the In predicate on the "user_type" field.
+func UserTypeIn(vs ...UserType) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUserType, vs...))
+}
+
+// UserTypeNotIn applies the NotIn predicate on the "user_type" field.
+func UserTypeNotIn(vs ...UserType) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUserType, vs...))
+}
+
+// PeerTypeEQ applies the EQ predicate on the "peer_type" field.
+func PeerTypeEQ(v PeerType) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldPeerType, v))
+}
+
+// PeerTypeNEQ applies the NEQ predicate on the "peer_type" field.
+func PeerTypeNEQ(v PeerType) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldPeerType, v))
+}
+
+// PeerTypeIn applies the In predicate on the "peer_type" field.
+func PeerTypeIn(vs ...PeerType) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldPeerType, vs...))
+}
+
+// PeerTypeNotIn applies the NotIn predicate on the "peer_type" field.
+func PeerTypeNotIn(vs ...PeerType) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldPeerType, vs...))
+}
+
+// PeerIDEQ applies the EQ predicate on the "peer_id" field.
+func PeerIDEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldPeerID, v))
+}
+
+// PeerIDNEQ applies the NEQ predicate on the "peer_id" field.
+func PeerIDNEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldPeerID, v))
+}
+
+// PeerIDIn applies the In predicate on the "peer_id" field.
+func PeerIDIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldPeerID, vs...))
+}
+
+// PeerIDNotIn applies the NotIn predicate on the "peer_id" field.
+func PeerIDNotIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldPeerID, vs...))
+}
+
+// PeerIDGT applies the GT predicate on the "peer_id" field.
+func PeerIDGT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldPeerID, v))
+}
+
+// PeerIDGTE applies the GTE predicate on the "peer_id" field.
+func PeerIDGTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldPeerID, v))
+}
+
+// PeerIDLT applies the LT predicate on the "peer_id" field.
+func PeerIDLT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldPeerID, v))
+}
+
+// PeerIDLTE applies the LTE predicate on the "peer_id" field.
+func PeerIDLTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldPeerID, v))
+}
+
+// AccessHashEQ applies the EQ predicate on the "access_hash" field.
+func AccessHashEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAccessHash, v))
+}
+
+// AccessHashNEQ applies the NEQ predicate on the "access_hash" field.
+func AccessHashNEQ(v int64) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldAccessHash, v))
+}
+
+// AccessHashIn applies the In predicate on the "access_hash" field.
+func AccessHashIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldAccessHash, vs...))
+}
+
+// AccessHashNotIn applies the NotIn predicate on the "access_hash" field.
+func AccessHashNotIn(vs ...int64) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldAccessHash, vs...))
+}
+
+// AccessHashGT applies the GT predicate on the "access_hash" field.
+func AccessHashGT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldAccessHash, v))
+}
+
+// AccessHashGTE applies the GTE predicate on the "access_hash" field.
+func AccessHashGTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldAccessHash, v))
+}
+
+// AccessHashLT applies the LT predicate on the "access_hash" field.
+func AccessHashLT(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldAccessHash, v))
+}
+
+// AccessHashLTE applies the LTE predicate on the "access_hash" field.
+func AccessHashLTE(v int64) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldAccessHash, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldMessageID, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldURL, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldState, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldError, v))
+}
+
+// ConversionEQ applies the EQ predicate on the "conversion" field.
+func ConversionEQ(v Conversion) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldConversion, v))
+}
+
+// ConversionNEQ applies the NEQ predicate on the "conversion" field.
+func ConversionNEQ(v Conversion) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldConversion, v))
+}
+
+// ConversionIn applies the In predicate on the "conversion" field.
+func ConversionIn(vs ...Conversion) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldConversion, vs...))
+}
+
+// ConversionNotIn applies the NotIn predicate on the "conversion" field.
+func ConversionNotIn(vs ...Conversion) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldConversion, vs...))
+}
+
+// ConversionIsNil applies the IsNil predicate on the "conversion" field.
+func ConversionIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldConversion))
+}
+
+// ConversionNotNil applies the NotNil predicate on the "conversion" field.
+func ConversionNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldConversion))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Job) predicate.Job {
+	return predicate.Job(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Job) predicate.Job {
+	return predicate.Job(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Job) predicate.Job {
+	return predicate.Job(sql.NotPredicates(p))
+}
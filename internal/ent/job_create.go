@@ -0,0 +1,1521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/job"
+	"github.com/ernado/tentacle/internal/ent/schema"
+)
+
+// JobCreate is the builder for creating a Job entity.
+type JobCreate struct {
+	config
+	mutation *JobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *JobCreate) SetUserID(v int64) *JobCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetUserType sets the "user_type" field.
+func (_c *JobCreate) SetUserType(v job.UserType) *JobCreate {
+	_c.mutation.SetUserType(v)
+	return _c
+}
+
+// SetNillableUserType sets the "user_type" field if the given value is not nil.
+func (_c *JobCreate) SetNillableUserType(v *job.UserType) *JobCreate {
+	if v != nil {
+		_c.SetUserType(*v)
+	}
+	return _c
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_c *JobCreate) SetPeerType(v job.PeerType) *JobCreate {
+	_c.mutation.SetPeerType(v)
+	return _c
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_c *JobCreate) SetPeerID(v int64) *JobCreate {
+	_c.mutation.SetPeerID(v)
+	return _c
+}
+
+// SetAccessHash sets the "access_hash" field.
+func (_c *JobCreate) SetAccessHash(v int64) *JobCreate {
+	_c.mutation.SetAccessHash(v)
+	return _c
+}
+
+// SetNillableAccessHash sets the "access_hash" field if the given value is not nil.
+func (_c *JobCreate) SetNillableAccessHash(v *int64) *JobCreate {
+	if v != nil {
+		_c.SetAccessHash(*v)
+	}
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *JobCreate) SetMessageID(v int) *JobCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *JobCreate) SetURL(v string) *JobCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetOptions sets the "options" field.
+func (_c *JobCreate) SetOptions(v schema.JobOptions) *JobCreate {
+	_c.mutation.SetOptions(v)
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *JobCreate) SetState(v job.State) *JobCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *JobCreate) SetNillableState(v *job.State) *JobCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *JobCreate) SetAttempts(v int) *JobCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *JobCreate) SetNillableAttempts(v *int) *JobCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *JobCreate) SetError(v string) *JobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *JobCreate) SetNillableError(v *string) *JobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetConversion sets the "conversion" field.
+func (_c *JobCreate) SetConversion(v job.Conversion) *JobCreate {
+	_c.mutation.SetConversion(v)
+	return _c
+}
+
+// SetNillableConversion sets the "conversion" field if the given value is not nil.
+func (_c *JobCreate) SetNillableConversion(v *job.Conversion) *JobCreate {
+	if v != nil {
+		_c.SetConversion(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *JobCreate) SetCreatedAt(v time.Time) *JobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *JobCreate) SetNillableCreatedAt(v *time.Time) *JobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *JobCreate) SetUpdatedAt(v time.Time) *JobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *JobCreate) SetNillableUpdatedAt(v *time.Time) *JobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *JobCreate) SetStartedAt(v time.Time) *JobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *JobCreate) SetNillableStartedAt(v *time.Time) *JobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *JobCreate) SetFinishedAt(v time.Time) *JobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *JobCreate) SetNillableFinishedAt(v *time.Time) *JobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (_c *JobCreate) Mutation() *JobMutation {
+	return _c.mutation
+}
+
+// Save creates the Job in the database.
+func (_c *JobCreate) Save(ctx context.Context) (*Job, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JobCreate) SaveX(ctx context.Context) *Job {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JobCreate) defaults() {
+	if _, ok := _c.mutation.UserType(); !ok {
+		v := job.DefaultUserType
+		_c.mutation.SetUserType(v)
+	}
+	if _, ok := _c.mutation.AccessHash(); !ok {
+		v := job.DefaultAccessHash
+		_c.mutation.SetAccessHash(v)
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		v := job.DefaultState
+		_c.mutation.SetState(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := job.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := job.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := job.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JobCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Job.user_id"`)}
+	}
+	if _, ok := _c.mutation.UserType(); !ok {
+		return &ValidationError{Name: "user_type", err: errors.New(`ent: missing required field "Job.user_type"`)}
+	}
+	if v, ok := _c.mutation.UserType(); ok {
+		if err := job.UserTypeValidator(v); err != nil {
+			return &ValidationError{Name: "user_type", err: fmt.Errorf(`ent: validator failed for field "Job.user_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PeerType(); !ok {
+		return &ValidationError{Name: "peer_type", err: errors.New(`ent: missing required field "Job.peer_type"`)}
+	}
+	if v, ok := _c.mutation.PeerType(); ok {
+		if err := job.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Job.peer_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PeerID(); !ok {
+		return &ValidationError{Name: "peer_id", err: errors.New(`ent: missing required field "Job.peer_id"`)}
+	}
+	if _, ok := _c.mutation.AccessHash(); !ok {
+		return &ValidationError{Name: "access_hash", err: errors.New(`ent: missing required field "Job.access_hash"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "Job.message_id"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Job.url"`)}
+	}
+	if _, ok := _c.mutation.Options(); !ok {
+		return &ValidationError{Name: "options", err: errors.New(`ent: missing required field "Job.options"`)}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Job.state"`)}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := job.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Job.state": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Job.attempts"`)}
+	}
+	if v, ok := _c.mutation.Conversion(); ok {
+		if err := job.ConversionValidator(v); err != nil {
+			return &ValidationError{Name: "conversion", err: fmt.Errorf(`ent: validator failed for field "Job.conversion": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Job.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Job.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *JobCreate) sqlSave(ctx context.Context) (*Job, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Job{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(job.Table, sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(job.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UserType(); ok {
+		_spec.SetField(job.FieldUserType, field.TypeEnum, value)
+		_node.UserType = value
+	}
+	if value, ok := _c.mutation.PeerType(); ok {
+		_spec.SetField(job.FieldPeerType, field.TypeEnum, value)
+		_node.PeerType = value
+	}
+	if value, ok := _c.mutation.PeerID(); ok {
+		_spec.SetField(job.FieldPeerID, field.TypeInt64, value)
+		_node.PeerID = value
+	}
+	if value, ok := _c.mutation.AccessHash(); ok {
+		_spec.SetField(job.FieldAccessHash, field.TypeInt64, value)
+		_node.AccessHash = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(job.FieldMessageID, field.TypeInt, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(job.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Options(); ok {
+		_spec.SetField(job.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(job.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(job.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.Conversion(); ok {
+		_spec.SetField(job.FieldConversion, field.TypeEnum, value)
+		_node.Conversion = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(job.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(job.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobCreate) OnConflict(opts ...sql.ConflictOption) *JobUpsertOne {
+	_c.conflict = opts
+	return &JobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobCreate) OnConflictColumns(columns ...string) *JobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// JobUpsertOne is the builder for "upsert"-ing
+	//  one Job node.
+	JobUpsertOne struct {
+		create *JobCreate
+	}
+
+	// JobUpsert is the "OnConflict" setter.
+	JobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *JobUpsert) SetUserID(v int64) *JobUpsert {
+	u.Set(job.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *JobUpsert) UpdateUserID() *JobUpsert {
+	u.SetExcluded(job.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *JobUpsert) AddUserID(v int64) *JobUpsert {
+	u.Add(job.FieldUserID, v)
+	return u
+}
+
+// SetUserType sets the "user_type" field.
+func (u *JobUpsert) SetUserType(v job.UserType) *JobUpsert {
+	u.Set(job.FieldUserType, v)
+	return u
+}
+
+// UpdateUserType sets the "user_type" field to the value that was provided on create.
+func (u *JobUpsert) UpdateUserType() *JobUpsert {
+	u.SetExcluded(job.FieldUserType)
+	return u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (u *JobUpsert) SetPeerType(v job.PeerType) *JobUpsert {
+	u.Set(job.FieldPeerType, v)
+	return u
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *JobUpsert) UpdatePeerType() *JobUpsert {
+	u.SetExcluded(job.FieldPeerType)
+	return u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *JobUpsert) SetPeerID(v int64) *JobUpsert {
+	u.Set(job.FieldPeerID, v)
+	return u
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *JobUpsert) UpdatePeerID() *JobUpsert {
+	u.SetExcluded(job.FieldPeerID)
+	return u
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *JobUpsert) AddPeerID(v int64) *JobUpsert {
+	u.Add(job.FieldPeerID, v)
+	return u
+}
+
+// SetAccessHash sets the "access_hash" field.
+func (u *JobUpsert) SetAccessHash(v int64) *JobUpsert {
+	u.Set(job.FieldAccessHash, v)
+	return u
+}
+
+// UpdateAccessHash sets the "access_hash" field to the value that was provided on create.
+func (u *JobUpsert) UpdateAccessHash() *JobUpsert {
+	u.SetExcluded(job.FieldAccessHash)
+	return u
+}
+
+// AddAccessHash adds v to the "access_hash" field.
+func (u *JobUpsert) AddAccessHash(v int64) *JobUpsert {
+	u.Add(job.FieldAccessHash, v)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *JobUpsert) SetMessageID(v int) *JobUpsert {
+	u.Set(job.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *JobUpsert) UpdateMessageID() *JobUpsert {
+	u.SetExcluded(job.FieldMessageID)
+	return u
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *JobUpsert) AddMessageID(v int) *JobUpsert {
+	u.Add(job.FieldMessageID, v)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *JobUpsert) SetURL(v string) *JobUpsert {
+	u.Set(job.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *JobUpsert) UpdateURL() *JobUpsert {
+	u.SetExcluded(job.FieldURL)
+	return u
+}
+
+// SetOptions sets the "options" field.
+func (u *JobUpsert) SetOptions(v schema.JobOptions) *JobUpsert {
+	u.Set(job.FieldOptions, v)
+	return u
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *JobUpsert) UpdateOptions() *JobUpsert {
+	u.SetExcluded(job.FieldOptions)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *JobUpsert) SetState(v job.State) *JobUpsert {
+	u.Set(job.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *JobUpsert) UpdateState() *JobUpsert {
+	u.SetExcluded(job.FieldState)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsert) SetAttempts(v int) *JobUpsert {
+	u.Set(job.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsert) UpdateAttempts() *JobUpsert {
+	u.SetExcluded(job.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsert) AddAttempts(v int) *JobUpsert {
+	u.Add(job.FieldAttempts, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *JobUpsert) SetError(v string) *JobUpsert {
+	u.Set(job.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobUpsert) UpdateError() *JobUpsert {
+	u.SetExcluded(job.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobUpsert) ClearError() *JobUpsert {
+	u.SetNull(job.FieldError)
+	return u
+}
+
+// SetConversion sets the "conversion" field.
+func (u *JobUpsert) SetConversion(v job.Conversion) *JobUpsert {
+	u.Set(job.FieldConversion, v)
+	return u
+}
+
+// UpdateConversion sets the "conversion" field to the value that was provided on create.
+func (u *JobUpsert) UpdateConversion() *JobUpsert {
+	u.SetExcluded(job.FieldConversion)
+	return u
+}
+
+// ClearConversion clears the value of the "conversion" field.
+func (u *JobUpsert) ClearConversion() *JobUpsert {
+	u.SetNull(job.FieldConversion)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsert) SetUpdatedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateUpdatedAt() *JobUpsert {
+	u.SetExcluded(job.FieldUpdatedAt)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobUpsert) SetStartedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateStartedAt() *JobUpsert {
+	u.SetExcluded(job.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *JobUpsert) ClearStartedAt() *JobUpsert {
+	u.SetNull(job.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobUpsert) SetFinishedAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateFinishedAt() *JobUpsert {
+	u.SetExcluded(job.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *JobUpsert) ClearFinishedAt() *JobUpsert {
+	u.SetNull(job.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertOne) UpdateNewValues() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(job.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobUpsertOne) Ignore() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertOne) DoNothing() *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreate.OnConflict
+// documentation for more info.
+func (u *JobUpsertOne) Update(set func(*JobUpsert)) *JobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *JobUpsertOne) SetUserID(v int64) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *JobUpsertOne) AddUserID(v int64) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateUserID() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetUserType sets the "user_type" field.
+func (u *JobUpsertOne) SetUserType(v job.UserType) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUserType(v)
+	})
+}
+
+// UpdateUserType sets the "user_type" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateUserType() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUserType()
+	})
+}
+
+// SetPeerType sets the "peer_type" field.
+func (u *JobUpsertOne) SetPeerType(v job.PeerType) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPeerType(v)
+	})
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdatePeerType() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePeerType()
+	})
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *JobUpsertOne) SetPeerID(v int64) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPeerID(v)
+	})
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *JobUpsertOne) AddPeerID(v int64) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddPeerID(v)
+	})
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdatePeerID() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePeerID()
+	})
+}
+
+// SetAccessHash sets the "access_hash" field.
+func (u *JobUpsertOne) SetAccessHash(v int64) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAccessHash(v)
+	})
+}
+
+// AddAccessHash adds v to the "access_hash" field.
+func (u *JobUpsertOne) AddAccessHash(v int64) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAccessHash(v)
+	})
+}
+
+// UpdateAccessHash sets the "access_hash" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateAccessHash() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAccessHash()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *JobUpsertOne) SetMessageID(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *JobUpsertOne) AddMessageID(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateMessageID() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *JobUpsertOne) SetURL(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateURL() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetOptions sets the "options" field.
+func (u *JobUpsertOne) SetOptions(v schema.JobOptions) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateOptions() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *JobUpsertOne) SetState(v job.State) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateState() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertOne) SetAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertOne) AddAttempts(v int) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateAttempts() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobUpsertOne) SetError(v string) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobUpsertOne) ClearError() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetConversion sets the "conversion" field.
+func (u *JobUpsertOne) SetConversion(v job.Conversion) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetConversion(v)
+	})
+}
+
+// UpdateConversion sets the "conversion" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateConversion() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateConversion()
+	})
+}
+
+// ClearConversion clears the value of the "conversion" field.
+func (u *JobUpsertOne) ClearConversion() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearConversion()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsertOne) SetUpdatedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateUpdatedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobUpsertOne) SetStartedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateStartedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *JobUpsertOne) ClearStartedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobUpsertOne) SetFinishedAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateFinishedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *JobUpsertOne) ClearFinishedAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobCreateBulk is the builder for creating many Job entities in bulk.
+type JobCreateBulk struct {
+	config
+	err      error
+	builders []*JobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Job entities in the database.
+func (_c *JobCreateBulk) Save(ctx context.Context) ([]*Job, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Job, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JobCreateBulk) SaveX(ctx context.Context) []*Job {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Job.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *JobCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobUpsertBulk {
+	_c.conflict = opts
+	return &JobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *JobCreateBulk) OnConflictColumns(columns ...string) *JobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &JobUpsertBulk{
+		create: _c,
+	}
+}
+
+// JobUpsertBulk is the builder for "upsert"-ing
+// a bulk of Job nodes.
+type JobUpsertBulk struct {
+	create *JobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *JobUpsertBulk) UpdateNewValues() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(job.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Job.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobUpsertBulk) Ignore() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobUpsertBulk) DoNothing() *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobUpsertBulk) Update(set func(*JobUpsert)) *JobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *JobUpsertBulk) SetUserID(v int64) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *JobUpsertBulk) AddUserID(v int64) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateUserID() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetUserType sets the "user_type" field.
+func (u *JobUpsertBulk) SetUserType(v job.UserType) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUserType(v)
+	})
+}
+
+// UpdateUserType sets the "user_type" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateUserType() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUserType()
+	})
+}
+
+// SetPeerType sets the "peer_type" field.
+func (u *JobUpsertBulk) SetPeerType(v job.PeerType) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPeerType(v)
+	})
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdatePeerType() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePeerType()
+	})
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *JobUpsertBulk) SetPeerID(v int64) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetPeerID(v)
+	})
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *JobUpsertBulk) AddPeerID(v int64) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddPeerID(v)
+	})
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdatePeerID() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdatePeerID()
+	})
+}
+
+// SetAccessHash sets the "access_hash" field.
+func (u *JobUpsertBulk) SetAccessHash(v int64) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAccessHash(v)
+	})
+}
+
+// AddAccessHash adds v to the "access_hash" field.
+func (u *JobUpsertBulk) AddAccessHash(v int64) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAccessHash(v)
+	})
+}
+
+// UpdateAccessHash sets the "access_hash" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateAccessHash() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAccessHash()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *JobUpsertBulk) SetMessageID(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *JobUpsertBulk) AddMessageID(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateMessageID() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *JobUpsertBulk) SetURL(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateURL() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetOptions sets the "options" field.
+func (u *JobUpsertBulk) SetOptions(v schema.JobOptions) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateOptions() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *JobUpsertBulk) SetState(v job.State) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateState() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobUpsertBulk) SetAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobUpsertBulk) AddAttempts(v int) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateAttempts() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobUpsertBulk) SetError(v string) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *JobUpsertBulk) ClearError() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetConversion sets the "conversion" field.
+func (u *JobUpsertBulk) SetConversion(v job.Conversion) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetConversion(v)
+	})
+}
+
+// UpdateConversion sets the "conversion" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateConversion() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateConversion()
+	})
+}
+
+// ClearConversion clears the value of the "conversion" field.
+func (u *JobUpsertBulk) ClearConversion() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearConversion()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobUpsertBulk) SetUpdatedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateUpdatedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobUpsertBulk) SetStartedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateStartedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *JobUpsertBulk) ClearStartedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobUpsertBulk) SetFinishedAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateFinishedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *JobUpsertBulk) ClearFinishedAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,917 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/job"
+	"github.com/ernado/tentacle/internal/ent/predicate"
+	"github.com/ernado/tentacle/internal/ent/schema"
+)
+
+// JobUpdate is the builder for updating Job entities.
+type JobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (_u *JobUpdate) Where(ps ...predicate.Job) *JobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *JobUpdate) SetUserID(v int64) *JobUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableUserID(v *int64) *JobUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *JobUpdate) AddUserID(v int64) *JobUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetUserType sets the "user_type" field.
+func (_u *JobUpdate) SetUserType(v job.UserType) *JobUpdate {
+	_u.mutation.SetUserType(v)
+	return _u
+}
+
+// SetNillableUserType sets the "user_type" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableUserType(v *job.UserType) *JobUpdate {
+	if v != nil {
+		_u.SetUserType(*v)
+	}
+	return _u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_u *JobUpdate) SetPeerType(v job.PeerType) *JobUpdate {
+	_u.mutation.SetPeerType(v)
+	return _u
+}
+
+// SetNillablePeerType sets the "peer_type" field if the given value is not nil.
+func (_u *JobUpdate) SetNillablePeerType(v *job.PeerType) *JobUpdate {
+	if v != nil {
+		_u.SetPeerType(*v)
+	}
+	return _u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_u *JobUpdate) SetPeerID(v int64) *JobUpdate {
+	_u.mutation.ResetPeerID()
+	_u.mutation.SetPeerID(v)
+	return _u
+}
+
+// SetNillablePeerID sets the "peer_id" field if the given value is not nil.
+func (_u *JobUpdate) SetNillablePeerID(v *int64) *JobUpdate {
+	if v != nil {
+		_u.SetPeerID(*v)
+	}
+	return _u
+}
+
+// AddPeerID adds value to the "peer_id" field.
+func (_u *JobUpdate) AddPeerID(v int64) *JobUpdate {
+	_u.mutation.AddPeerID(v)
+	return _u
+}
+
+// SetAccessHash sets the "access_hash" field.
+func (_u *JobUpdate) SetAccessHash(v int64) *JobUpdate {
+	_u.mutation.ResetAccessHash()
+	_u.mutation.SetAccessHash(v)
+	return _u
+}
+
+// SetNillableAccessHash sets the "access_hash" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableAccessHash(v *int64) *JobUpdate {
+	if v != nil {
+		_u.SetAccessHash(*v)
+	}
+	return _u
+}
+
+// AddAccessHash adds value to the "access_hash" field.
+func (_u *JobUpdate) AddAccessHash(v int64) *JobUpdate {
+	_u.mutation.AddAccessHash(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *JobUpdate) SetMessageID(v int) *JobUpdate {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableMessageID(v *int) *JobUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *JobUpdate) AddMessageID(v int) *JobUpdate {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *JobUpdate) SetURL(v string) *JobUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableURL(v *string) *JobUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetOptions sets the "options" field.
+func (_u *JobUpdate) SetOptions(v schema.JobOptions) *JobUpdate {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// SetNillableOptions sets the "options" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableOptions(v *schema.JobOptions) *JobUpdate {
+	if v != nil {
+		_u.SetOptions(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *JobUpdate) SetState(v job.State) *JobUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableState(v *job.State) *JobUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *JobUpdate) SetAttempts(v int) *JobUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableAttempts(v *int) *JobUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *JobUpdate) AddAttempts(v int) *JobUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *JobUpdate) SetError(v string) *JobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableError(v *string) *JobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *JobUpdate) ClearError() *JobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetConversion sets the "conversion" field.
+func (_u *JobUpdate) SetConversion(v job.Conversion) *JobUpdate {
+	_u.mutation.SetConversion(v)
+	return _u
+}
+
+// SetNillableConversion sets the "conversion" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableConversion(v *job.Conversion) *JobUpdate {
+	if v != nil {
+		_u.SetConversion(*v)
+	}
+	return _u
+}
+
+// ClearConversion clears the value of the "conversion" field.
+func (_u *JobUpdate) ClearConversion() *JobUpdate {
+	_u.mutation.ClearConversion()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *JobUpdate) SetUpdatedAt(v time.Time) *JobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *JobUpdate) SetStartedAt(v time.Time) *JobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableStartedAt(v *time.Time) *JobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *JobUpdate) ClearStartedAt() *JobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *JobUpdate) SetFinishedAt(v time.Time) *JobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *JobUpdate) SetNillableFinishedAt(v *time.Time) *JobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *JobUpdate) ClearFinishedAt() *JobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (_u *JobUpdate) Mutation() *JobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *JobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := job.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobUpdate) check() error {
+	if v, ok := _u.mutation.UserType(); ok {
+		if err := job.UserTypeValidator(v); err != nil {
+			return &ValidationError{Name: "user_type", err: fmt.Errorf(`ent: validator failed for field "Job.user_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PeerType(); ok {
+		if err := job.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Job.peer_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := job.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Job.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Conversion(); ok {
+		if err := job.ConversionValidator(v); err != nil {
+			return &ValidationError{Name: "conversion", err: fmt.Errorf(`ent: validator failed for field "Job.conversion": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(job.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(job.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UserType(); ok {
+		_spec.SetField(job.FieldUserType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerType(); ok {
+		_spec.SetField(job.FieldPeerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerID(); ok {
+		_spec.SetField(job.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPeerID(); ok {
+		_spec.AddField(job.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AccessHash(); ok {
+		_spec.SetField(job.FieldAccessHash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAccessHash(); ok {
+		_spec.AddField(job.FieldAccessHash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(job.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(job.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(job.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(job.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(job.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(job.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(job.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Conversion(); ok {
+		_spec.SetField(job.FieldConversion, field.TypeEnum, value)
+	}
+	if _u.mutation.ConversionCleared() {
+		_spec.ClearField(job.FieldConversion, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(job.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(job.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(job.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(job.FieldFinishedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JobUpdateOne is the builder for updating a single Job entity.
+type JobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *JobUpdateOne) SetUserID(v int64) *JobUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableUserID(v *int64) *JobUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *JobUpdateOne) AddUserID(v int64) *JobUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetUserType sets the "user_type" field.
+func (_u *JobUpdateOne) SetUserType(v job.UserType) *JobUpdateOne {
+	_u.mutation.SetUserType(v)
+	return _u
+}
+
+// SetNillableUserType sets the "user_type" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableUserType(v *job.UserType) *JobUpdateOne {
+	if v != nil {
+		_u.SetUserType(*v)
+	}
+	return _u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_u *JobUpdateOne) SetPeerType(v job.PeerType) *JobUpdateOne {
+	_u.mutation.SetPeerType(v)
+	return _u
+}
+
+// SetNillablePeerType sets the "peer_type" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillablePeerType(v *job.PeerType) *JobUpdateOne {
+	if v != nil {
+		_u.SetPeerType(*v)
+	}
+	return _u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_u *JobUpdateOne) SetPeerID(v int64) *JobUpdateOne {
+	_u.mutation.ResetPeerID()
+	_u.mutation.SetPeerID(v)
+	return _u
+}
+
+// SetNillablePeerID sets the "peer_id" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillablePeerID(v *int64) *JobUpdateOne {
+	if v != nil {
+		_u.SetPeerID(*v)
+	}
+	return _u
+}
+
+// AddPeerID adds value to the "peer_id" field.
+func (_u *JobUpdateOne) AddPeerID(v int64) *JobUpdateOne {
+	_u.mutation.AddPeerID(v)
+	return _u
+}
+
+// SetAccessHash sets the "access_hash" field.
+func (_u *JobUpdateOne) SetAccessHash(v int64) *JobUpdateOne {
+	_u.mutation.ResetAccessHash()
+	_u.mutation.SetAccessHash(v)
+	return _u
+}
+
+// SetNillableAccessHash sets the "access_hash" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableAccessHash(v *int64) *JobUpdateOne {
+	if v != nil {
+		_u.SetAccessHash(*v)
+	}
+	return _u
+}
+
+// AddAccessHash adds value to the "access_hash" field.
+func (_u *JobUpdateOne) AddAccessHash(v int64) *JobUpdateOne {
+	_u.mutation.AddAccessHash(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *JobUpdateOne) SetMessageID(v int) *JobUpdateOne {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableMessageID(v *int) *JobUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *JobUpdateOne) AddMessageID(v int) *JobUpdateOne {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *JobUpdateOne) SetURL(v string) *JobUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableURL(v *string) *JobUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetOptions sets the "options" field.
+func (_u *JobUpdateOne) SetOptions(v schema.JobOptions) *JobUpdateOne {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// SetNillableOptions sets the "options" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableOptions(v *schema.JobOptions) *JobUpdateOne {
+	if v != nil {
+		_u.SetOptions(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *JobUpdateOne) SetState(v job.State) *JobUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableState(v *job.State) *JobUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *JobUpdateOne) SetAttempts(v int) *JobUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableAttempts(v *int) *JobUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *JobUpdateOne) AddAttempts(v int) *JobUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *JobUpdateOne) SetError(v string) *JobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableError(v *string) *JobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *JobUpdateOne) ClearError() *JobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetConversion sets the "conversion" field.
+func (_u *JobUpdateOne) SetConversion(v job.Conversion) *JobUpdateOne {
+	_u.mutation.SetConversion(v)
+	return _u
+}
+
+// SetNillableConversion sets the "conversion" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableConversion(v *job.Conversion) *JobUpdateOne {
+	if v != nil {
+		_u.SetConversion(*v)
+	}
+	return _u
+}
+
+// ClearConversion clears the value of the "conversion" field.
+func (_u *JobUpdateOne) ClearConversion() *JobUpdateOne {
+	_u.mutation.ClearConversion()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *JobUpdateOne) SetUpdatedAt(v time.Time) *JobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *JobUpdateOne) SetStartedAt(v time.Time) *JobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableStartedAt(v *time.Time) *JobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *JobUpdateOne) ClearStartedAt() *JobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *JobUpdateOne) SetFinishedAt(v time.Time) *JobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *JobUpdateOne) SetNillableFinishedAt(v *time.Time) *JobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *JobUpdateOne) ClearFinishedAt() *JobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (_u *JobUpdateOne) Mutation() *JobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (_u *JobUpdateOne) Where(ps ...predicate.Job) *JobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JobUpdateOne) Select(field string, fields ...string) *JobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Job entity.
+func (_u *JobUpdateOne) Save(ctx context.Context) (*Job, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobUpdateOne) SaveX(ctx context.Context) *Job {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *JobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := job.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JobUpdateOne) check() error {
+	if v, ok := _u.mutation.UserType(); ok {
+		if err := job.UserTypeValidator(v); err != nil {
+			return &ValidationError{Name: "user_type", err: fmt.Errorf(`ent: validator failed for field "Job.user_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PeerType(); ok {
+		if err := job.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Job.peer_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := job.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Job.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Conversion(); ok {
+		if err := job.ConversionValidator(v); err != nil {
+			return &ValidationError{Name: "conversion", err: fmt.Errorf(`ent: validator failed for field "Job.conversion": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Job.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, job.FieldID)
+		for _, f := range fields {
+			if !job.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != job.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(job.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(job.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UserType(); ok {
+		_spec.SetField(job.FieldUserType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerType(); ok {
+		_spec.SetField(job.FieldPeerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerID(); ok {
+		_spec.SetField(job.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPeerID(); ok {
+		_spec.AddField(job.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AccessHash(); ok {
+		_spec.SetField(job.FieldAccessHash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAccessHash(); ok {
+		_spec.AddField(job.FieldAccessHash, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(job.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(job.FieldMessageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(job.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(job.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(job.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(job.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(job.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Conversion(); ok {
+		_spec.SetField(job.FieldConversion, field.TypeEnum, value)
+	}
+	if _u.mutation.ConversionCleared() {
+		_spec.ClearField(job.FieldConversion, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(job.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(job.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(job.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(job.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(job.FieldFinishedAt, field.TypeTime)
+	}
+	_node = &Job{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,121 @@ import (
 )
 
 var (
+	// GrantsColumns holds the columns for the "grants" table.
+	GrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "peer_type", Type: field.TypeEnum, Enums: []string{"user", "chat", "channel"}},
+		{Name: "peer_id", Type: field.TypeInt64},
+		{Name: "role", Type: field.TypeString},
+		{Name: "granted_by", Type: field.TypeInt64},
+		{Name: "invite", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// GrantsTable holds the schema information for the "grants" table.
+	GrantsTable = &schema.Table{
+		Name:       "grants",
+		Columns:    GrantsColumns,
+		PrimaryKey: []*schema.Column{GrantsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "grant_peer_type_peer_id",
+				Unique:  true,
+				Columns: []*schema.Column{GrantsColumns[1], GrantsColumns[2]},
+			},
+		},
+	}
+	// InvitesColumns holds the columns for the "invites" table.
+	InvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "role", Type: field.TypeString},
+		{Name: "max_uses", Type: field.TypeInt, Default: 1},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "created_by", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// InvitesTable holds the schema information for the "invites" table.
+	InvitesTable = &schema.Table{
+		Name:       "invites",
+		Columns:    InvitesColumns,
+		PrimaryKey: []*schema.Column{InvitesColumns[0]},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "user_type", Type: field.TypeEnum, Enums: []string{"user", "channel"}, Default: "user"},
+		{Name: "peer_type", Type: field.TypeEnum, Enums: []string{"user", "chat", "channel"}},
+		{Name: "peer_id", Type: field.TypeInt64},
+		{Name: "access_hash", Type: field.TypeInt64, Default: 0},
+		{Name: "message_id", Type: field.TypeInt},
+		{Name: "url", Type: field.TypeString},
+		{Name: "options", Type: field.TypeJSON},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"queued", "running", "done", "failed", "canceled"}, Default: "queued"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "conversion", Type: field.TypeEnum, Nullable: true, Enums: []string{"copy", "audio", "video"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_state",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[9]},
+			},
+		},
+	}
+	// PreferencesColumns holds the columns for the "preferences" table.
+	PreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "peer_type", Type: field.TypeEnum, Enums: []string{"user", "chat", "channel"}},
+		{Name: "peer_id", Type: field.TypeInt64},
+		{Name: "caption", Type: field.TypeString, Nullable: true},
+		{Name: "originals", Type: field.TypeBool, Default: false},
+		{Name: "auto_fetch", Type: field.TypeBool, Default: false},
+		{Name: "quality", Type: field.TypeInt, Default: 0},
+		{Name: "codec", Type: field.TypeString, Nullable: true},
+		{Name: "default_format", Type: field.TypeEnum, Enums: []string{"ask", "video", "audio"}, Default: "ask"},
+		{Name: "subtitles", Type: field.TypeJSON, Nullable: true},
+		{Name: "document", Type: field.TypeBool, Default: false},
+		{Name: "language", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PreferencesTable holds the schema information for the "preferences" table.
+	PreferencesTable = &schema.Table{
+		Name:       "preferences",
+		Columns:    PreferencesColumns,
+		PrimaryKey: []*schema.Column{PreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "preference_peer_type_peer_id",
+				Unique:  true,
+				Columns: []*schema.Column{PreferencesColumns[1], PreferencesColumns[2]},
+			},
+		},
+	}
+	// RolesColumns holds the columns for the "roles" table.
+	RolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "admin", Type: field.TypeBool, Default: false},
+		{Name: "limits", Type: field.TypeJSON},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RolesTable holds the schema information for the "roles" table.
+	RolesTable = &schema.Table{
+		Name:       "roles",
+		Columns:    RolesColumns,
+		PrimaryKey: []*schema.Column{RolesColumns[0]},
+	}
 	// TelegramBlobsColumns holds the columns for the "telegram_blobs" table.
 	TelegramBlobsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -51,11 +166,44 @@ var (
 		Columns:    TelegramSessionsColumns,
 		PrimaryKey: []*schema.Column{TelegramSessionsColumns[0]},
 	}
+	// UsagesColumns holds the columns for the "usages" table.
+	UsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "peer_type", Type: field.TypeEnum, Enums: []string{"user", "chat", "channel"}},
+		{Name: "peer_id", Type: field.TypeInt64},
+		{Name: "job_id", Type: field.TypeInt},
+		{Name: "bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UsagesTable holds the schema information for the "usages" table.
+	UsagesTable = &schema.Table{
+		Name:       "usages",
+		Columns:    UsagesColumns,
+		PrimaryKey: []*schema.Column{UsagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usage_peer_type_peer_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsagesColumns[1], UsagesColumns[2], UsagesColumns[5]},
+			},
+			{
+				Name:    "usage_job_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsagesColumns[3]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		GrantsTable,
+		InvitesTable,
+		JobsTable,
+		PreferencesTable,
+		RolesTable,
 		TelegramBlobsTable,
 		TelegramChannelsTable,
 		TelegramSessionsTable,
+		UsagesTable,
 	}
 )
 
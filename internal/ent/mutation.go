@@ -7,13 +7,22 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ernado/tentacle/internal/access"
+	"github.com/ernado/tentacle/internal/ent/grant"
+	"github.com/ernado/tentacle/internal/ent/invite"
+	"github.com/ernado/tentacle/internal/ent/job"
 	"github.com/ernado/tentacle/internal/ent/predicate"
+	"github.com/ernado/tentacle/internal/ent/preference"
+	"github.com/ernado/tentacle/internal/ent/role"
+	"github.com/ernado/tentacle/internal/ent/schema"
 	"github.com/ernado/tentacle/internal/ent/telegramblob"
 	"github.com/ernado/tentacle/internal/ent/telegramchannel"
 	"github.com/ernado/tentacle/internal/ent/telegramsession"
+	"github.com/ernado/tentacle/internal/ent/usage"
 	"github.com/google/uuid"
 )
 
@@ -26,44 +35,48 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeGrant           = "Grant"
+	TypeInvite          = "Invite"
+	TypeJob             = "Job"
+	TypePreference      = "Preference"
+	TypeRole            = "Role"
 	TypeTelegramBlob    = "TelegramBlob"
 	TypeTelegramChannel = "TelegramChannel"
 	TypeTelegramSession = "TelegramSession"
+	TypeUsage           = "Usage"
 )
 
-// TelegramBlobMutation represents an operation that mutates the TelegramBlob nodes in the graph.
-type TelegramBlobMutation struct {
+// GrantMutation represents an operation that mutates the Grant nodes in the graph.
+type GrantMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	size           *int64
-	addsize        *int64
-	_path          *string
-	uri            *string
-	sha256         *string
-	file_reference *[]byte
-	file_id        *int64
-	addfile_id     *int64
-	access_hash    *int64
-	addaccess_hash *int64
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*TelegramBlob, error)
-	predicates     []predicate.TelegramBlob
+	op            Op
+	typ           string
+	id            *int
+	peer_type     *grant.PeerType
+	peer_id       *int64
+	addpeer_id    *int64
+	role          *string
+	granted_by    *int64
+	addgranted_by *int64
+	invite        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Grant, error)
+	predicates    []predicate.Grant
 }
 
-var _ ent.Mutation = (*TelegramBlobMutation)(nil)
+var _ ent.Mutation = (*GrantMutation)(nil)
 
-// telegramblobOption allows management of the mutation configuration using functional options.
-type telegramblobOption func(*TelegramBlobMutation)
+// grantOption allows management of the mutation configuration using functional options.
+type grantOption func(*GrantMutation)
 
-// newTelegramBlobMutation creates new mutation for the TelegramBlob entity.
-func newTelegramBlobMutation(c config, op Op, opts ...telegramblobOption) *TelegramBlobMutation {
-	m := &TelegramBlobMutation{
+// newGrantMutation creates new mutation for the Grant entity.
+func newGrantMutation(c config, op Op, opts ...grantOption) *GrantMutation {
+	m := &GrantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTelegramBlob,
+		typ:           TypeGrant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -72,20 +85,20 @@ func newTelegramBlobMutation(c config, op Op, opts ...telegramblobOption) *Teleg
 	return m
 }
 
-// withTelegramBlobID sets the ID field of the mutation.
-func withTelegramBlobID(id uuid.UUID) telegramblobOption {
-	return func(m *TelegramBlobMutation) {
+// withGrantID sets the ID field of the mutation.
+func withGrantID(id int) grantOption {
+	return func(m *GrantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TelegramBlob
+			value *Grant
 		)
-		m.oldValue = func(ctx context.Context) (*TelegramBlob, error) {
+		m.oldValue = func(ctx context.Context) (*Grant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TelegramBlob.Get(ctx, id)
+					value, err = m.Client().Grant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -94,10 +107,10 @@ func withTelegramBlobID(id uuid.UUID) telegramblobOption {
 	}
 }
 
-// withTelegramBlob sets the old TelegramBlob of the mutation.
-func withTelegramBlob(node *TelegramBlob) telegramblobOption {
-	return func(m *TelegramBlobMutation) {
-		m.oldValue = func(context.Context) (*TelegramBlob, error) {
+// withGrant sets the old Grant of the mutation.
+func withGrant(node *Grant) grantOption {
+	return func(m *GrantMutation) {
+		m.oldValue = func(context.Context) (*Grant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -106,7 +119,7 @@ func withTelegramBlob(node *TelegramBlob) telegramblobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TelegramBlobMutation) Client() *Client {
+func (m GrantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -114,7 +127,7 @@ func (m TelegramBlobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TelegramBlobMutation) Tx() (*Tx, error) {
+func (m GrantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -123,15 +136,9 @@ func (m TelegramBlobMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TelegramBlob entities.
-func (m *TelegramBlobMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TelegramBlobMutation) ID() (id uuid.UUID, exists bool) {
+func (m *GrantMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -142,342 +149,299 @@ func (m *TelegramBlobMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TelegramBlobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *GrantMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TelegramBlob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Grant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSize sets the "size" field.
-func (m *TelegramBlobMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// SetPeerType sets the "peer_type" field.
+func (m *GrantMutation) SetPeerType(gt grant.PeerType) {
+	m.peer_type = &gt
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *TelegramBlobMutation) Size() (r int64, exists bool) {
-	v := m.size
+// PeerType returns the value of the "peer_type" field in the mutation.
+func (m *GrantMutation) PeerType() (r grant.PeerType, exists bool) {
+	v := m.peer_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the TelegramBlob entity.
-// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// OldPeerType returns the old "peer_type" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramBlobMutation) OldSize(ctx context.Context) (v int64, err error) {
+func (m *GrantMutation) OldPeerType(ctx context.Context) (v grant.PeerType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeerType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldPeerType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
-	}
-	return oldValue.Size, nil
-}
-
-// AddSize adds i to the "size" field.
-func (m *TelegramBlobMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
-}
-
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *TelegramBlobMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPeerType: %w", err)
 	}
-	return *v, true
+	return oldValue.PeerType, nil
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *TelegramBlobMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// ResetPeerType resets all changes to the "peer_type" field.
+func (m *GrantMutation) ResetPeerType() {
+	m.peer_type = nil
 }
 
-// SetPath sets the "path" field.
-func (m *TelegramBlobMutation) SetPath(s string) {
-	m._path = &s
+// SetPeerID sets the "peer_id" field.
+func (m *GrantMutation) SetPeerID(i int64) {
+	m.peer_id = &i
+	m.addpeer_id = nil
 }
 
-// Path returns the value of the "path" field in the mutation.
-func (m *TelegramBlobMutation) Path() (r string, exists bool) {
-	v := m._path
+// PeerID returns the value of the "peer_id" field in the mutation.
+func (m *GrantMutation) PeerID() (r int64, exists bool) {
+	v := m.peer_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the TelegramBlob entity.
-// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// OldPeerID returns the old "peer_id" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramBlobMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *GrantMutation) OldPeerID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldPeerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeerID: %w", err)
 	}
-	return oldValue.Path, nil
-}
-
-// ResetPath resets all changes to the "path" field.
-func (m *TelegramBlobMutation) ResetPath() {
-	m._path = nil
+	return oldValue.PeerID, nil
 }
 
-// SetURI sets the "uri" field.
-func (m *TelegramBlobMutation) SetURI(s string) {
-	m.uri = &s
+// AddPeerID adds i to the "peer_id" field.
+func (m *GrantMutation) AddPeerID(i int64) {
+	if m.addpeer_id != nil {
+		*m.addpeer_id += i
+	} else {
+		m.addpeer_id = &i
+	}
 }
 
-// URI returns the value of the "uri" field in the mutation.
-func (m *TelegramBlobMutation) URI() (r string, exists bool) {
-	v := m.uri
+// AddedPeerID returns the value that was added to the "peer_id" field in this mutation.
+func (m *GrantMutation) AddedPeerID() (r int64, exists bool) {
+	v := m.addpeer_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURI returns the old "uri" field's value of the TelegramBlob entity.
-// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramBlobMutation) OldURI(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURI is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURI requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURI: %w", err)
-	}
-	return oldValue.URI, nil
-}
-
-// ResetURI resets all changes to the "uri" field.
-func (m *TelegramBlobMutation) ResetURI() {
-	m.uri = nil
+// ResetPeerID resets all changes to the "peer_id" field.
+func (m *GrantMutation) ResetPeerID() {
+	m.peer_id = nil
+	m.addpeer_id = nil
 }
 
-// SetSha256 sets the "sha256" field.
-func (m *TelegramBlobMutation) SetSha256(s string) {
-	m.sha256 = &s
+// SetRole sets the "role" field.
+func (m *GrantMutation) SetRole(s string) {
+	m.role = &s
 }
 
-// Sha256 returns the value of the "sha256" field in the mutation.
-func (m *TelegramBlobMutation) Sha256() (r string, exists bool) {
-	v := m.sha256
+// Role returns the value of the "role" field in the mutation.
+func (m *GrantMutation) Role() (r string, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSha256 returns the old "sha256" field's value of the TelegramBlob entity.
-// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramBlobMutation) OldSha256(ctx context.Context) (v string, err error) {
+func (m *GrantMutation) OldRole(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSha256 is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSha256 requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSha256: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.Sha256, nil
+	return oldValue.Role, nil
 }
 
-// ResetSha256 resets all changes to the "sha256" field.
-func (m *TelegramBlobMutation) ResetSha256() {
-	m.sha256 = nil
+// ResetRole resets all changes to the "role" field.
+func (m *GrantMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetFileReference sets the "file_reference" field.
-func (m *TelegramBlobMutation) SetFileReference(b []byte) {
-	m.file_reference = &b
+// SetGrantedBy sets the "granted_by" field.
+func (m *GrantMutation) SetGrantedBy(i int64) {
+	m.granted_by = &i
+	m.addgranted_by = nil
 }
 
-// FileReference returns the value of the "file_reference" field in the mutation.
-func (m *TelegramBlobMutation) FileReference() (r []byte, exists bool) {
-	v := m.file_reference
+// GrantedBy returns the value of the "granted_by" field in the mutation.
+func (m *GrantMutation) GrantedBy() (r int64, exists bool) {
+	v := m.granted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileReference returns the old "file_reference" field's value of the TelegramBlob entity.
-// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// OldGrantedBy returns the old "granted_by" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramBlobMutation) OldFileReference(ctx context.Context) (v []byte, err error) {
+func (m *GrantMutation) OldGrantedBy(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileReference is only allowed on UpdateOne operations")
+		return v, errors.New("OldGrantedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileReference requires an ID field in the mutation")
+		return v, errors.New("OldGrantedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileReference: %w", err)
+		return v, fmt.Errorf("querying old value for OldGrantedBy: %w", err)
 	}
-	return oldValue.FileReference, nil
+	return oldValue.GrantedBy, nil
 }
 
-// ResetFileReference resets all changes to the "file_reference" field.
-func (m *TelegramBlobMutation) ResetFileReference() {
-	m.file_reference = nil
+// AddGrantedBy adds i to the "granted_by" field.
+func (m *GrantMutation) AddGrantedBy(i int64) {
+	if m.addgranted_by != nil {
+		*m.addgranted_by += i
+	} else {
+		m.addgranted_by = &i
+	}
 }
 
-// SetFileID sets the "file_id" field.
-func (m *TelegramBlobMutation) SetFileID(i int64) {
-	m.file_id = &i
-	m.addfile_id = nil
+// AddedGrantedBy returns the value that was added to the "granted_by" field in this mutation.
+func (m *GrantMutation) AddedGrantedBy() (r int64, exists bool) {
+	v := m.addgranted_by
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FileID returns the value of the "file_id" field in the mutation.
-func (m *TelegramBlobMutation) FileID() (r int64, exists bool) {
-	v := m.file_id
+// ResetGrantedBy resets all changes to the "granted_by" field.
+func (m *GrantMutation) ResetGrantedBy() {
+	m.granted_by = nil
+	m.addgranted_by = nil
+}
+
+// SetInvite sets the "invite" field.
+func (m *GrantMutation) SetInvite(s string) {
+	m.invite = &s
+}
+
+// Invite returns the value of the "invite" field in the mutation.
+func (m *GrantMutation) Invite() (r string, exists bool) {
+	v := m.invite
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileID returns the old "file_id" field's value of the TelegramBlob entity.
-// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// OldInvite returns the old "invite" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramBlobMutation) OldFileID(ctx context.Context) (v int64, err error) {
+func (m *GrantMutation) OldInvite(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+		return v, errors.New("OldInvite is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileID requires an ID field in the mutation")
+		return v, errors.New("OldInvite requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+		return v, fmt.Errorf("querying old value for OldInvite: %w", err)
 	}
-	return oldValue.FileID, nil
+	return oldValue.Invite, nil
 }
 
-// AddFileID adds i to the "file_id" field.
-func (m *TelegramBlobMutation) AddFileID(i int64) {
-	if m.addfile_id != nil {
-		*m.addfile_id += i
-	} else {
-		m.addfile_id = &i
-	}
+// ClearInvite clears the value of the "invite" field.
+func (m *GrantMutation) ClearInvite() {
+	m.invite = nil
+	m.clearedFields[grant.FieldInvite] = struct{}{}
 }
 
-// AddedFileID returns the value that was added to the "file_id" field in this mutation.
-func (m *TelegramBlobMutation) AddedFileID() (r int64, exists bool) {
-	v := m.addfile_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// InviteCleared returns if the "invite" field was cleared in this mutation.
+func (m *GrantMutation) InviteCleared() bool {
+	_, ok := m.clearedFields[grant.FieldInvite]
+	return ok
 }
 
-// ResetFileID resets all changes to the "file_id" field.
-func (m *TelegramBlobMutation) ResetFileID() {
-	m.file_id = nil
-	m.addfile_id = nil
+// ResetInvite resets all changes to the "invite" field.
+func (m *GrantMutation) ResetInvite() {
+	m.invite = nil
+	delete(m.clearedFields, grant.FieldInvite)
 }
 
-// SetAccessHash sets the "access_hash" field.
-func (m *TelegramBlobMutation) SetAccessHash(i int64) {
-	m.access_hash = &i
-	m.addaccess_hash = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *GrantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AccessHash returns the value of the "access_hash" field in the mutation.
-func (m *TelegramBlobMutation) AccessHash() (r int64, exists bool) {
-	v := m.access_hash
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GrantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccessHash returns the old "access_hash" field's value of the TelegramBlob entity.
-// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Grant entity.
+// If the Grant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramBlobMutation) OldAccessHash(ctx context.Context) (v int64, err error) {
+func (m *GrantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessHash requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.AccessHash, nil
-}
-
-// AddAccessHash adds i to the "access_hash" field.
-func (m *TelegramBlobMutation) AddAccessHash(i int64) {
-	if m.addaccess_hash != nil {
-		*m.addaccess_hash += i
-	} else {
-		m.addaccess_hash = &i
-	}
-}
-
-// AddedAccessHash returns the value that was added to the "access_hash" field in this mutation.
-func (m *TelegramBlobMutation) AddedAccessHash() (r int64, exists bool) {
-	v := m.addaccess_hash
-	if v == nil {
-		return
-	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetAccessHash resets all changes to the "access_hash" field.
-func (m *TelegramBlobMutation) ResetAccessHash() {
-	m.access_hash = nil
-	m.addaccess_hash = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GrantMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the TelegramBlobMutation builder.
-func (m *TelegramBlobMutation) Where(ps ...predicate.TelegramBlob) {
+// Where appends a list predicates to the GrantMutation builder.
+func (m *GrantMutation) Where(ps ...predicate.Grant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TelegramBlobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the GrantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TelegramBlobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TelegramBlob, len(ps))
+func (m *GrantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Grant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -485,45 +449,42 @@ func (m *TelegramBlobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TelegramBlobMutation) Op() Op {
+func (m *GrantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TelegramBlobMutation) SetOp(op Op) {
+func (m *GrantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TelegramBlob).
-func (m *TelegramBlobMutation) Type() string {
+// Type returns the node type of this mutation (Grant).
+func (m *GrantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TelegramBlobMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.size != nil {
-		fields = append(fields, telegramblob.FieldSize)
+func (m *GrantMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.peer_type != nil {
+		fields = append(fields, grant.FieldPeerType)
 	}
-	if m._path != nil {
-		fields = append(fields, telegramblob.FieldPath)
-	}
-	if m.uri != nil {
-		fields = append(fields, telegramblob.FieldURI)
+	if m.peer_id != nil {
+		fields = append(fields, grant.FieldPeerID)
 	}
-	if m.sha256 != nil {
-		fields = append(fields, telegramblob.FieldSha256)
+	if m.role != nil {
+		fields = append(fields, grant.FieldRole)
 	}
-	if m.file_reference != nil {
-		fields = append(fields, telegramblob.FieldFileReference)
+	if m.granted_by != nil {
+		fields = append(fields, grant.FieldGrantedBy)
 	}
-	if m.file_id != nil {
-		fields = append(fields, telegramblob.FieldFileID)
+	if m.invite != nil {
+		fields = append(fields, grant.FieldInvite)
 	}
-	if m.access_hash != nil {
-		fields = append(fields, telegramblob.FieldAccessHash)
+	if m.created_at != nil {
+		fields = append(fields, grant.FieldCreatedAt)
 	}
 	return fields
 }
@@ -531,22 +492,20 @@ func (m *TelegramBlobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TelegramBlobMutation) Field(name string) (ent.Value, bool) {
+func (m *GrantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case telegramblob.FieldSize:
-		return m.Size()
-	case telegramblob.FieldPath:
-		return m.Path()
-	case telegramblob.FieldURI:
-		return m.URI()
-	case telegramblob.FieldSha256:
-		return m.Sha256()
-	case telegramblob.FieldFileReference:
-		return m.FileReference()
-	case telegramblob.FieldFileID:
-		return m.FileID()
-	case telegramblob.FieldAccessHash:
-		return m.AccessHash()
+	case grant.FieldPeerType:
+		return m.PeerType()
+	case grant.FieldPeerID:
+		return m.PeerID()
+	case grant.FieldRole:
+		return m.Role()
+	case grant.FieldGrantedBy:
+		return m.GrantedBy()
+	case grant.FieldInvite:
+		return m.Invite()
+	case grant.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -554,96 +513,84 @@ func (m *TelegramBlobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TelegramBlobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case telegramblob.FieldSize:
-		return m.OldSize(ctx)
-	case telegramblob.FieldPath:
-		return m.OldPath(ctx)
-	case telegramblob.FieldURI:
-		return m.OldURI(ctx)
-	case telegramblob.FieldSha256:
-		return m.OldSha256(ctx)
-	case telegramblob.FieldFileReference:
-		return m.OldFileReference(ctx)
-	case telegramblob.FieldFileID:
-		return m.OldFileID(ctx)
-	case telegramblob.FieldAccessHash:
-		return m.OldAccessHash(ctx)
+	case grant.FieldPeerType:
+		return m.OldPeerType(ctx)
+	case grant.FieldPeerID:
+		return m.OldPeerID(ctx)
+	case grant.FieldRole:
+		return m.OldRole(ctx)
+	case grant.FieldGrantedBy:
+		return m.OldGrantedBy(ctx)
+	case grant.FieldInvite:
+		return m.OldInvite(ctx)
+	case grant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TelegramBlob field %s", name)
+	return nil, fmt.Errorf("unknown Grant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramBlobMutation) SetField(name string, value ent.Value) error {
+func (m *GrantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case telegramblob.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSize(v)
-		return nil
-	case telegramblob.FieldPath:
-		v, ok := value.(string)
+	case grant.FieldPeerType:
+		v, ok := value.(grant.PeerType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
+		m.SetPeerType(v)
 		return nil
-	case telegramblob.FieldURI:
-		v, ok := value.(string)
+	case grant.FieldPeerID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURI(v)
+		m.SetPeerID(v)
 		return nil
-	case telegramblob.FieldSha256:
+	case grant.FieldRole:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSha256(v)
+		m.SetRole(v)
 		return nil
-	case telegramblob.FieldFileReference:
-		v, ok := value.([]byte)
+	case grant.FieldGrantedBy:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileReference(v)
+		m.SetGrantedBy(v)
 		return nil
-	case telegramblob.FieldFileID:
-		v, ok := value.(int64)
+	case grant.FieldInvite:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileID(v)
+		m.SetInvite(v)
 		return nil
-	case telegramblob.FieldAccessHash:
-		v, ok := value.(int64)
+	case grant.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccessHash(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramBlob field %s", name)
+	return fmt.Errorf("unknown Grant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TelegramBlobMutation) AddedFields() []string {
+func (m *GrantMutation) AddedFields() []string {
 	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, telegramblob.FieldSize)
+	if m.addpeer_id != nil {
+		fields = append(fields, grant.FieldPeerID)
 	}
-	if m.addfile_id != nil {
-		fields = append(fields, telegramblob.FieldFileID)
-	}
-	if m.addaccess_hash != nil {
-		fields = append(fields, telegramblob.FieldAccessHash)
+	if m.addgranted_by != nil {
+		fields = append(fields, grant.FieldGrantedBy)
 	}
 	return fields
 }
@@ -651,14 +598,12 @@ func (m *TelegramBlobMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TelegramBlobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GrantMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case telegramblob.FieldSize:
-		return m.AddedSize()
-	case telegramblob.FieldFileID:
-		return m.AddedFileID()
-	case telegramblob.FieldAccessHash:
-		return m.AddedAccessHash()
+	case grant.FieldPeerID:
+		return m.AddedPeerID()
+	case grant.FieldGrantedBy:
+		return m.AddedGrantedBy()
 	}
 	return nil, false
 }
@@ -666,158 +611,160 @@ func (m *TelegramBlobMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramBlobMutation) AddField(name string, value ent.Value) error {
+func (m *GrantMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case telegramblob.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
-	case telegramblob.FieldFileID:
+	case grant.FieldPeerID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFileID(v)
+		m.AddPeerID(v)
 		return nil
-	case telegramblob.FieldAccessHash:
+	case grant.FieldGrantedBy:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAccessHash(v)
+		m.AddGrantedBy(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramBlob numeric field %s", name)
+	return fmt.Errorf("unknown Grant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TelegramBlobMutation) ClearedFields() []string {
-	return nil
+func (m *GrantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(grant.FieldInvite) {
+		fields = append(fields, grant.FieldInvite)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TelegramBlobMutation) FieldCleared(name string) bool {
+func (m *GrantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TelegramBlobMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TelegramBlob nullable field %s", name)
+func (m *GrantMutation) ClearField(name string) error {
+	switch name {
+	case grant.FieldInvite:
+		m.ClearInvite()
+		return nil
+	}
+	return fmt.Errorf("unknown Grant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TelegramBlobMutation) ResetField(name string) error {
+func (m *GrantMutation) ResetField(name string) error {
 	switch name {
-	case telegramblob.FieldSize:
-		m.ResetSize()
-		return nil
-	case telegramblob.FieldPath:
-		m.ResetPath()
+	case grant.FieldPeerType:
+		m.ResetPeerType()
 		return nil
-	case telegramblob.FieldURI:
-		m.ResetURI()
+	case grant.FieldPeerID:
+		m.ResetPeerID()
 		return nil
-	case telegramblob.FieldSha256:
-		m.ResetSha256()
+	case grant.FieldRole:
+		m.ResetRole()
 		return nil
-	case telegramblob.FieldFileReference:
-		m.ResetFileReference()
+	case grant.FieldGrantedBy:
+		m.ResetGrantedBy()
 		return nil
-	case telegramblob.FieldFileID:
-		m.ResetFileID()
+	case grant.FieldInvite:
+		m.ResetInvite()
 		return nil
-	case telegramblob.FieldAccessHash:
-		m.ResetAccessHash()
+	case grant.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramBlob field %s", name)
+	return fmt.Errorf("unknown Grant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TelegramBlobMutation) AddedEdges() []string {
+func (m *GrantMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TelegramBlobMutation) AddedIDs(name string) []ent.Value {
+func (m *GrantMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TelegramBlobMutation) RemovedEdges() []string {
+func (m *GrantMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TelegramBlobMutation) RemovedIDs(name string) []ent.Value {
+func (m *GrantMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TelegramBlobMutation) ClearedEdges() []string {
+func (m *GrantMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TelegramBlobMutation) EdgeCleared(name string) bool {
+func (m *GrantMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TelegramBlobMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TelegramBlob unique edge %s", name)
+func (m *GrantMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Grant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TelegramBlobMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TelegramBlob edge %s", name)
+func (m *GrantMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Grant edge %s", name)
 }
 
-// TelegramChannelMutation represents an operation that mutates the TelegramChannel nodes in the graph.
-type TelegramChannelMutation struct {
+// InviteMutation represents an operation that mutates the Invite nodes in the graph.
+type InviteMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int64
-	access_hash           *int64
-	addaccess_hash        *int64
-	title                 *string
-	save_records          *bool
-	save_favorite_records *bool
-	active                *bool
-	clearedFields         map[string]struct{}
-	done                  bool
-	oldValue              func(context.Context) (*TelegramChannel, error)
-	predicates            []predicate.TelegramChannel
+	op            Op
+	typ           string
+	id            *int
+	code          *string
+	role          *string
+	max_uses      *int
+	addmax_uses   *int
+	uses          *int
+	adduses       *int
+	created_by    *int64
+	addcreated_by *int64
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Invite, error)
+	predicates    []predicate.Invite
 }
 
-var _ ent.Mutation = (*TelegramChannelMutation)(nil)
+var _ ent.Mutation = (*InviteMutation)(nil)
 
-// telegramchannelOption allows management of the mutation configuration using functional options.
-type telegramchannelOption func(*TelegramChannelMutation)
+// inviteOption allows management of the mutation configuration using functional options.
+type inviteOption func(*InviteMutation)
 
-// newTelegramChannelMutation creates new mutation for the TelegramChannel entity.
-func newTelegramChannelMutation(c config, op Op, opts ...telegramchannelOption) *TelegramChannelMutation {
-	m := &TelegramChannelMutation{
+// newInviteMutation creates new mutation for the Invite entity.
+func newInviteMutation(c config, op Op, opts ...inviteOption) *InviteMutation {
+	m := &InviteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTelegramChannel,
+		typ:           TypeInvite,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -826,20 +773,20 @@ func newTelegramChannelMutation(c config, op Op, opts ...telegramchannelOption)
 	return m
 }
 
-// withTelegramChannelID sets the ID field of the mutation.
-func withTelegramChannelID(id int64) telegramchannelOption {
-	return func(m *TelegramChannelMutation) {
+// withInviteID sets the ID field of the mutation.
+func withInviteID(id int) inviteOption {
+	return func(m *InviteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TelegramChannel
+			value *Invite
 		)
-		m.oldValue = func(ctx context.Context) (*TelegramChannel, error) {
+		m.oldValue = func(ctx context.Context) (*Invite, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TelegramChannel.Get(ctx, id)
+					value, err = m.Client().Invite.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -848,10 +795,10 @@ func withTelegramChannelID(id int64) telegramchannelOption {
 	}
 }
 
-// withTelegramChannel sets the old TelegramChannel of the mutation.
-func withTelegramChannel(node *TelegramChannel) telegramchannelOption {
-	return func(m *TelegramChannelMutation) {
-		m.oldValue = func(context.Context) (*TelegramChannel, error) {
+// withInvite sets the old Invite of the mutation.
+func withInvite(node *Invite) inviteOption {
+	return func(m *InviteMutation) {
+		m.oldValue = func(context.Context) (*Invite, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -860,7 +807,7 @@ func withTelegramChannel(node *TelegramChannel) telegramchannelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TelegramChannelMutation) Client() *Client {
+func (m InviteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -868,7 +815,7 @@ func (m TelegramChannelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TelegramChannelMutation) Tx() (*Tx, error) {
+func (m InviteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -877,15 +824,9 @@ func (m TelegramChannelMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TelegramChannel entities.
-func (m *TelegramChannelMutation) SetID(id int64) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TelegramChannelMutation) ID() (id int64, exists bool) {
+func (m *InviteMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -896,256 +837,306 @@ func (m *TelegramChannelMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TelegramChannelMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *InviteMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int64{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TelegramChannel.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Invite.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAccessHash sets the "access_hash" field.
-func (m *TelegramChannelMutation) SetAccessHash(i int64) {
-	m.access_hash = &i
-	m.addaccess_hash = nil
+// SetCode sets the "code" field.
+func (m *InviteMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// AccessHash returns the value of the "access_hash" field in the mutation.
-func (m *TelegramChannelMutation) AccessHash() (r int64, exists bool) {
-	v := m.access_hash
+// Code returns the value of the "code" field in the mutation.
+func (m *InviteMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccessHash returns the old "access_hash" field's value of the TelegramChannel entity.
-// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChannelMutation) OldAccessHash(ctx context.Context) (v int64, err error) {
+func (m *InviteMutation) OldCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessHash requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessHash: %w", err)
-	}
-	return oldValue.AccessHash, nil
-}
-
-// AddAccessHash adds i to the "access_hash" field.
-func (m *TelegramChannelMutation) AddAccessHash(i int64) {
-	if m.addaccess_hash != nil {
-		*m.addaccess_hash += i
-	} else {
-		m.addaccess_hash = &i
-	}
-}
-
-// AddedAccessHash returns the value that was added to the "access_hash" field in this mutation.
-func (m *TelegramChannelMutation) AddedAccessHash() (r int64, exists bool) {
-	v := m.addaccess_hash
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return *v, true
+	return oldValue.Code, nil
 }
 
-// ResetAccessHash resets all changes to the "access_hash" field.
-func (m *TelegramChannelMutation) ResetAccessHash() {
-	m.access_hash = nil
-	m.addaccess_hash = nil
+// ResetCode resets all changes to the "code" field.
+func (m *InviteMutation) ResetCode() {
+	m.code = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *TelegramChannelMutation) SetTitle(s string) {
-	m.title = &s
+// SetRole sets the "role" field.
+func (m *InviteMutation) SetRole(s string) {
+	m.role = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *TelegramChannelMutation) Title() (r string, exists bool) {
-	v := m.title
+// Role returns the value of the "role" field in the mutation.
+func (m *InviteMutation) Role() (r string, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the TelegramChannel entity.
-// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChannelMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *InviteMutation) OldRole(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Role, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *TelegramChannelMutation) ResetTitle() {
-	m.title = nil
+// ResetRole resets all changes to the "role" field.
+func (m *InviteMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetSaveRecords sets the "save_records" field.
-func (m *TelegramChannelMutation) SetSaveRecords(b bool) {
-	m.save_records = &b
+// SetMaxUses sets the "max_uses" field.
+func (m *InviteMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
 }
 
-// SaveRecords returns the value of the "save_records" field in the mutation.
-func (m *TelegramChannelMutation) SaveRecords() (r bool, exists bool) {
-	v := m.save_records
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *InviteMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSaveRecords returns the old "save_records" field's value of the TelegramChannel entity.
-// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxUses returns the old "max_uses" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChannelMutation) OldSaveRecords(ctx context.Context) (v bool, err error) {
+func (m *InviteMutation) OldMaxUses(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSaveRecords is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSaveRecords requires an ID field in the mutation")
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSaveRecords: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
 	}
-	return oldValue.SaveRecords, nil
+	return oldValue.MaxUses, nil
 }
 
-// ClearSaveRecords clears the value of the "save_records" field.
-func (m *TelegramChannelMutation) ClearSaveRecords() {
-	m.save_records = nil
-	m.clearedFields[telegramchannel.FieldSaveRecords] = struct{}{}
+// AddMaxUses adds i to the "max_uses" field.
+func (m *InviteMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
 }
 
-// SaveRecordsCleared returns if the "save_records" field was cleared in this mutation.
-func (m *TelegramChannelMutation) SaveRecordsCleared() bool {
-	_, ok := m.clearedFields[telegramchannel.FieldSaveRecords]
-	return ok
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *InviteMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSaveRecords resets all changes to the "save_records" field.
-func (m *TelegramChannelMutation) ResetSaveRecords() {
-	m.save_records = nil
-	delete(m.clearedFields, telegramchannel.FieldSaveRecords)
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *InviteMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
 }
 
-// SetSaveFavoriteRecords sets the "save_favorite_records" field.
-func (m *TelegramChannelMutation) SetSaveFavoriteRecords(b bool) {
-	m.save_favorite_records = &b
+// SetUses sets the "uses" field.
+func (m *InviteMutation) SetUses(i int) {
+	m.uses = &i
+	m.adduses = nil
 }
 
-// SaveFavoriteRecords returns the value of the "save_favorite_records" field in the mutation.
-func (m *TelegramChannelMutation) SaveFavoriteRecords() (r bool, exists bool) {
-	v := m.save_favorite_records
+// Uses returns the value of the "uses" field in the mutation.
+func (m *InviteMutation) Uses() (r int, exists bool) {
+	v := m.uses
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSaveFavoriteRecords returns the old "save_favorite_records" field's value of the TelegramChannel entity.
-// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldUses returns the old "uses" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChannelMutation) OldSaveFavoriteRecords(ctx context.Context) (v bool, err error) {
+func (m *InviteMutation) OldUses(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSaveFavoriteRecords is only allowed on UpdateOne operations")
+		return v, errors.New("OldUses is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSaveFavoriteRecords requires an ID field in the mutation")
+		return v, errors.New("OldUses requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSaveFavoriteRecords: %w", err)
+		return v, fmt.Errorf("querying old value for OldUses: %w", err)
 	}
-	return oldValue.SaveFavoriteRecords, nil
+	return oldValue.Uses, nil
 }
 
-// ClearSaveFavoriteRecords clears the value of the "save_favorite_records" field.
-func (m *TelegramChannelMutation) ClearSaveFavoriteRecords() {
-	m.save_favorite_records = nil
-	m.clearedFields[telegramchannel.FieldSaveFavoriteRecords] = struct{}{}
+// AddUses adds i to the "uses" field.
+func (m *InviteMutation) AddUses(i int) {
+	if m.adduses != nil {
+		*m.adduses += i
+	} else {
+		m.adduses = &i
+	}
 }
 
-// SaveFavoriteRecordsCleared returns if the "save_favorite_records" field was cleared in this mutation.
-func (m *TelegramChannelMutation) SaveFavoriteRecordsCleared() bool {
-	_, ok := m.clearedFields[telegramchannel.FieldSaveFavoriteRecords]
-	return ok
+// AddedUses returns the value that was added to the "uses" field in this mutation.
+func (m *InviteMutation) AddedUses() (r int, exists bool) {
+	v := m.adduses
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSaveFavoriteRecords resets all changes to the "save_favorite_records" field.
-func (m *TelegramChannelMutation) ResetSaveFavoriteRecords() {
-	m.save_favorite_records = nil
-	delete(m.clearedFields, telegramchannel.FieldSaveFavoriteRecords)
+// ResetUses resets all changes to the "uses" field.
+func (m *InviteMutation) ResetUses() {
+	m.uses = nil
+	m.adduses = nil
 }
 
-// SetActive sets the "active" field.
-func (m *TelegramChannelMutation) SetActive(b bool) {
-	m.active = &b
+// SetCreatedBy sets the "created_by" field.
+func (m *InviteMutation) SetCreatedBy(i int64) {
+	m.created_by = &i
+	m.addcreated_by = nil
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *TelegramChannelMutation) Active() (r bool, exists bool) {
-	v := m.active
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *InviteMutation) CreatedBy() (r int64, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the TelegramChannel entity.
-// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramChannelMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *InviteMutation) OldCreatedBy(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *TelegramChannelMutation) ResetActive() {
-	m.active = nil
+// AddCreatedBy adds i to the "created_by" field.
+func (m *InviteMutation) AddCreatedBy(i int64) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += i
+	} else {
+		m.addcreated_by = &i
+	}
 }
 
-// Where appends a list predicates to the TelegramChannelMutation builder.
-func (m *TelegramChannelMutation) Where(ps ...predicate.TelegramChannel) {
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *InviteMutation) AddedCreatedBy() (r int64, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *InviteMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *InviteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InviteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InviteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the InviteMutation builder.
+func (m *InviteMutation) Where(ps ...predicate.Invite) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TelegramChannelMutation builder. Using this method,
+// WhereP appends storage-level predicates to the InviteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TelegramChannelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TelegramChannel, len(ps))
+func (m *InviteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invite, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1153,39 +1144,42 @@ func (m *TelegramChannelMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TelegramChannelMutation) Op() Op {
+func (m *InviteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TelegramChannelMutation) SetOp(op Op) {
+func (m *InviteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TelegramChannel).
-func (m *TelegramChannelMutation) Type() string {
+// Type returns the node type of this mutation (Invite).
+func (m *InviteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TelegramChannelMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.access_hash != nil {
-		fields = append(fields, telegramchannel.FieldAccessHash)
+func (m *InviteMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.code != nil {
+		fields = append(fields, invite.FieldCode)
 	}
-	if m.title != nil {
-		fields = append(fields, telegramchannel.FieldTitle)
+	if m.role != nil {
+		fields = append(fields, invite.FieldRole)
 	}
-	if m.save_records != nil {
-		fields = append(fields, telegramchannel.FieldSaveRecords)
+	if m.max_uses != nil {
+		fields = append(fields, invite.FieldMaxUses)
 	}
-	if m.save_favorite_records != nil {
-		fields = append(fields, telegramchannel.FieldSaveFavoriteRecords)
+	if m.uses != nil {
+		fields = append(fields, invite.FieldUses)
 	}
-	if m.active != nil {
-		fields = append(fields, telegramchannel.FieldActive)
+	if m.created_by != nil {
+		fields = append(fields, invite.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, invite.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1193,18 +1187,20 @@ func (m *TelegramChannelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TelegramChannelMutation) Field(name string) (ent.Value, bool) {
+func (m *InviteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case telegramchannel.FieldAccessHash:
-		return m.AccessHash()
-	case telegramchannel.FieldTitle:
-		return m.Title()
-	case telegramchannel.FieldSaveRecords:
-		return m.SaveRecords()
-	case telegramchannel.FieldSaveFavoriteRecords:
-		return m.SaveFavoriteRecords()
-	case telegramchannel.FieldActive:
-		return m.Active()
+	case invite.FieldCode:
+		return m.Code()
+	case invite.FieldRole:
+		return m.Role()
+	case invite.FieldMaxUses:
+		return m.MaxUses()
+	case invite.FieldUses:
+		return m.Uses()
+	case invite.FieldCreatedBy:
+		return m.CreatedBy()
+	case invite.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1212,72 +1208,87 @@ func (m *TelegramChannelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TelegramChannelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case telegramchannel.FieldAccessHash:
-		return m.OldAccessHash(ctx)
-	case telegramchannel.FieldTitle:
-		return m.OldTitle(ctx)
-	case telegramchannel.FieldSaveRecords:
-		return m.OldSaveRecords(ctx)
-	case telegramchannel.FieldSaveFavoriteRecords:
-		return m.OldSaveFavoriteRecords(ctx)
-	case telegramchannel.FieldActive:
-		return m.OldActive(ctx)
+	case invite.FieldCode:
+		return m.OldCode(ctx)
+	case invite.FieldRole:
+		return m.OldRole(ctx)
+	case invite.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case invite.FieldUses:
+		return m.OldUses(ctx)
+	case invite.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case invite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TelegramChannel field %s", name)
+	return nil, fmt.Errorf("unknown Invite field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChannelMutation) SetField(name string, value ent.Value) error {
+func (m *InviteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case telegramchannel.FieldAccessHash:
-		v, ok := value.(int64)
+	case invite.FieldCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccessHash(v)
+		m.SetCode(v)
 		return nil
-	case telegramchannel.FieldTitle:
+	case invite.FieldRole:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetRole(v)
 		return nil
-	case telegramchannel.FieldSaveRecords:
-		v, ok := value.(bool)
+	case invite.FieldMaxUses:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSaveRecords(v)
+		m.SetMaxUses(v)
 		return nil
-	case telegramchannel.FieldSaveFavoriteRecords:
-		v, ok := value.(bool)
+	case invite.FieldUses:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSaveFavoriteRecords(v)
+		m.SetUses(v)
 		return nil
-	case telegramchannel.FieldActive:
-		v, ok := value.(bool)
+	case invite.FieldCreatedBy:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetCreatedBy(v)
+		return nil
+	case invite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChannel field %s", name)
+	return fmt.Errorf("unknown Invite field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TelegramChannelMutation) AddedFields() []string {
+func (m *InviteMutation) AddedFields() []string {
 	var fields []string
-	if m.addaccess_hash != nil {
-		fields = append(fields, telegramchannel.FieldAccessHash)
+	if m.addmax_uses != nil {
+		fields = append(fields, invite.FieldMaxUses)
+	}
+	if m.adduses != nil {
+		fields = append(fields, invite.FieldUses)
+	}
+	if m.addcreated_by != nil {
+		fields = append(fields, invite.FieldCreatedBy)
 	}
 	return fields
 }
@@ -1285,10 +1296,14 @@ func (m *TelegramChannelMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TelegramChannelMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InviteMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case telegramchannel.FieldAccessHash:
-		return m.AddedAccessHash()
+	case invite.FieldMaxUses:
+		return m.AddedMaxUses()
+	case invite.FieldUses:
+		return m.AddedUses()
+	case invite.FieldCreatedBy:
+		return m.AddedCreatedBy()
 	}
 	return nil, false
 }
@@ -1296,148 +1311,4828 @@ func (m *TelegramChannelMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramChannelMutation) AddField(name string, value ent.Value) error {
+func (m *InviteMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case telegramchannel.FieldAccessHash:
+	case invite.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUses(v)
+		return nil
+	case invite.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUses(v)
+		return nil
+	case invite.FieldCreatedBy:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAccessHash(v)
+		m.AddCreatedBy(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChannel numeric field %s", name)
+	return fmt.Errorf("unknown Invite numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TelegramChannelMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(telegramchannel.FieldSaveRecords) {
-		fields = append(fields, telegramchannel.FieldSaveRecords)
-	}
-	if m.FieldCleared(telegramchannel.FieldSaveFavoriteRecords) {
-		fields = append(fields, telegramchannel.FieldSaveFavoriteRecords)
-	}
-	return fields
+func (m *InviteMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TelegramChannelMutation) FieldCleared(name string) bool {
+func (m *InviteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TelegramChannelMutation) ClearField(name string) error {
-	switch name {
-	case telegramchannel.FieldSaveRecords:
-		m.ClearSaveRecords()
-		return nil
-	case telegramchannel.FieldSaveFavoriteRecords:
-		m.ClearSaveFavoriteRecords()
-		return nil
-	}
-	return fmt.Errorf("unknown TelegramChannel nullable field %s", name)
+func (m *InviteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Invite nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TelegramChannelMutation) ResetField(name string) error {
+func (m *InviteMutation) ResetField(name string) error {
 	switch name {
-	case telegramchannel.FieldAccessHash:
-		m.ResetAccessHash()
+	case invite.FieldCode:
+		m.ResetCode()
 		return nil
-	case telegramchannel.FieldTitle:
-		m.ResetTitle()
+	case invite.FieldRole:
+		m.ResetRole()
 		return nil
-	case telegramchannel.FieldSaveRecords:
-		m.ResetSaveRecords()
+	case invite.FieldMaxUses:
+		m.ResetMaxUses()
 		return nil
-	case telegramchannel.FieldSaveFavoriteRecords:
-		m.ResetSaveFavoriteRecords()
+	case invite.FieldUses:
+		m.ResetUses()
 		return nil
-	case telegramchannel.FieldActive:
-		m.ResetActive()
+	case invite.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case invite.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramChannel field %s", name)
+	return fmt.Errorf("unknown Invite field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TelegramChannelMutation) AddedEdges() []string {
+func (m *InviteMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TelegramChannelMutation) AddedIDs(name string) []ent.Value {
+func (m *InviteMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TelegramChannelMutation) RemovedEdges() []string {
+func (m *InviteMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TelegramChannelMutation) RemovedIDs(name string) []ent.Value {
+func (m *InviteMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TelegramChannelMutation) ClearedEdges() []string {
+func (m *InviteMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TelegramChannelMutation) EdgeCleared(name string) bool {
+func (m *InviteMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TelegramChannelMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TelegramChannel unique edge %s", name)
+func (m *InviteMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Invite unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TelegramChannelMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TelegramChannel edge %s", name)
+func (m *InviteMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Invite edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	user_id        *int64
+	adduser_id     *int64
+	user_type      *job.UserType
+	peer_type      *job.PeerType
+	peer_id        *int64
+	addpeer_id     *int64
+	access_hash    *int64
+	addaccess_hash *int64
+	message_id     *int
+	addmessage_id  *int
+	url            *string
+	options        *schema.JobOptions
+	state          *job.State
+	attempts       *int
+	addattempts    *int
+	error          *string
+	conversion     *job.Conversion
+	created_at     *time.Time
+	updated_at     *time.Time
+	started_at     *time.Time
+	finished_at    *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Job, error)
+	predicates     []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id int) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *JobMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *JobMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *JobMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *JobMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *JobMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetUserType sets the "user_type" field.
+func (m *JobMutation) SetUserType(jt job.UserType) {
+	m.user_type = &jt
+}
+
+// UserType returns the value of the "user_type" field in the mutation.
+func (m *JobMutation) UserType() (r job.UserType, exists bool) {
+	v := m.user_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserType returns the old "user_type" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUserType(ctx context.Context) (v job.UserType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserType: %w", err)
+	}
+	return oldValue.UserType, nil
+}
+
+// ResetUserType resets all changes to the "user_type" field.
+func (m *JobMutation) ResetUserType() {
+	m.user_type = nil
+}
+
+// SetPeerType sets the "peer_type" field.
+func (m *JobMutation) SetPeerType(jt job.PeerType) {
+	m.peer_type = &jt
+}
+
+// PeerType returns the value of the "peer_type" field in the mutation.
+func (m *JobMutation) PeerType() (r job.PeerType, exists bool) {
+	v := m.peer_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeerType returns the old "peer_type" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldPeerType(ctx context.Context) (v job.PeerType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeerType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeerType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeerType: %w", err)
+	}
+	return oldValue.PeerType, nil
+}
+
+// ResetPeerType resets all changes to the "peer_type" field.
+func (m *JobMutation) ResetPeerType() {
+	m.peer_type = nil
+}
+
+// SetPeerID sets the "peer_id" field.
+func (m *JobMutation) SetPeerID(i int64) {
+	m.peer_id = &i
+	m.addpeer_id = nil
+}
+
+// PeerID returns the value of the "peer_id" field in the mutation.
+func (m *JobMutation) PeerID() (r int64, exists bool) {
+	v := m.peer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeerID returns the old "peer_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldPeerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeerID: %w", err)
+	}
+	return oldValue.PeerID, nil
+}
+
+// AddPeerID adds i to the "peer_id" field.
+func (m *JobMutation) AddPeerID(i int64) {
+	if m.addpeer_id != nil {
+		*m.addpeer_id += i
+	} else {
+		m.addpeer_id = &i
+	}
+}
+
+// AddedPeerID returns the value that was added to the "peer_id" field in this mutation.
+func (m *JobMutation) AddedPeerID() (r int64, exists bool) {
+	v := m.addpeer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPeerID resets all changes to the "peer_id" field.
+func (m *JobMutation) ResetPeerID() {
+	m.peer_id = nil
+	m.addpeer_id = nil
+}
+
+// SetAccessHash sets the "access_hash" field.
+func (m *JobMutation) SetAccessHash(i int64) {
+	m.access_hash = &i
+	m.addaccess_hash = nil
+}
+
+// AccessHash returns the value of the "access_hash" field in the mutation.
+func (m *JobMutation) AccessHash() (r int64, exists bool) {
+	v := m.access_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessHash returns the old "access_hash" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAccessHash(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessHash: %w", err)
+	}
+	return oldValue.AccessHash, nil
+}
+
+// AddAccessHash adds i to the "access_hash" field.
+func (m *JobMutation) AddAccessHash(i int64) {
+	if m.addaccess_hash != nil {
+		*m.addaccess_hash += i
+	} else {
+		m.addaccess_hash = &i
+	}
+}
+
+// AddedAccessHash returns the value that was added to the "access_hash" field in this mutation.
+func (m *JobMutation) AddedAccessHash() (r int64, exists bool) {
+	v := m.addaccess_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAccessHash resets all changes to the "access_hash" field.
+func (m *JobMutation) ResetAccessHash() {
+	m.access_hash = nil
+	m.addaccess_hash = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *JobMutation) SetMessageID(i int) {
+	m.message_id = &i
+	m.addmessage_id = nil
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *JobMutation) MessageID() (r int, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldMessageID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// AddMessageID adds i to the "message_id" field.
+func (m *JobMutation) AddMessageID(i int) {
+	if m.addmessage_id != nil {
+		*m.addmessage_id += i
+	} else {
+		m.addmessage_id = &i
+	}
+}
+
+// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
+func (m *JobMutation) AddedMessageID() (r int, exists bool) {
+	v := m.addmessage_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *JobMutation) ResetMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
+}
+
+// SetURL sets the "url" field.
+func (m *JobMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *JobMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *JobMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetOptions sets the "options" field.
+func (m *JobMutation) SetOptions(so schema.JobOptions) {
+	m.options = &so
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *JobMutation) Options() (r schema.JobOptions, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldOptions(ctx context.Context) (v schema.JobOptions, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *JobMutation) ResetOptions() {
+	m.options = nil
+}
+
+// SetState sets the "state" field.
+func (m *JobMutation) SetState(j job.State) {
+	m.state = &j
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *JobMutation) State() (r job.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldState(ctx context.Context) (v job.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *JobMutation) ResetState() {
+	m.state = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetError sets the "error" field.
+func (m *JobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *JobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[job.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, job.FieldError)
+}
+
+// SetConversion sets the "conversion" field.
+func (m *JobMutation) SetConversion(j job.Conversion) {
+	m.conversion = &j
+}
+
+// Conversion returns the value of the "conversion" field in the mutation.
+func (m *JobMutation) Conversion() (r job.Conversion, exists bool) {
+	v := m.conversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConversion returns the old "conversion" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldConversion(ctx context.Context) (v job.Conversion, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConversion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConversion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConversion: %w", err)
+	}
+	return oldValue.Conversion, nil
+}
+
+// ClearConversion clears the value of the "conversion" field.
+func (m *JobMutation) ClearConversion() {
+	m.conversion = nil
+	m.clearedFields[job.FieldConversion] = struct{}{}
+}
+
+// ConversionCleared returns if the "conversion" field was cleared in this mutation.
+func (m *JobMutation) ConversionCleared() bool {
+	_, ok := m.clearedFields[job.FieldConversion]
+	return ok
+}
+
+// ResetConversion resets all changes to the "conversion" field.
+func (m *JobMutation) ResetConversion() {
+	m.conversion = nil
+	delete(m.clearedFields, job.FieldConversion)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *JobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *JobMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[job.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *JobMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, job.FieldStartedAt)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *JobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[job.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *JobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, job.FieldFinishedAt)
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.user_id != nil {
+		fields = append(fields, job.FieldUserID)
+	}
+	if m.user_type != nil {
+		fields = append(fields, job.FieldUserType)
+	}
+	if m.peer_type != nil {
+		fields = append(fields, job.FieldPeerType)
+	}
+	if m.peer_id != nil {
+		fields = append(fields, job.FieldPeerID)
+	}
+	if m.access_hash != nil {
+		fields = append(fields, job.FieldAccessHash)
+	}
+	if m.message_id != nil {
+		fields = append(fields, job.FieldMessageID)
+	}
+	if m.url != nil {
+		fields = append(fields, job.FieldURL)
+	}
+	if m.options != nil {
+		fields = append(fields, job.FieldOptions)
+	}
+	if m.state != nil {
+		fields = append(fields, job.FieldState)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.error != nil {
+		fields = append(fields, job.FieldError)
+	}
+	if m.conversion != nil {
+		fields = append(fields, job.FieldConversion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, job.FieldUpdatedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, job.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldUserID:
+		return m.UserID()
+	case job.FieldUserType:
+		return m.UserType()
+	case job.FieldPeerType:
+		return m.PeerType()
+	case job.FieldPeerID:
+		return m.PeerID()
+	case job.FieldAccessHash:
+		return m.AccessHash()
+	case job.FieldMessageID:
+		return m.MessageID()
+	case job.FieldURL:
+		return m.URL()
+	case job.FieldOptions:
+		return m.Options()
+	case job.FieldState:
+		return m.State()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldError:
+		return m.Error()
+	case job.FieldConversion:
+		return m.Conversion()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case job.FieldStartedAt:
+		return m.StartedAt()
+	case job.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldUserID:
+		return m.OldUserID(ctx)
+	case job.FieldUserType:
+		return m.OldUserType(ctx)
+	case job.FieldPeerType:
+		return m.OldPeerType(ctx)
+	case job.FieldPeerID:
+		return m.OldPeerID(ctx)
+	case job.FieldAccessHash:
+		return m.OldAccessHash(ctx)
+	case job.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case job.FieldURL:
+		return m.OldURL(ctx)
+	case job.FieldOptions:
+		return m.OldOptions(ctx)
+	case job.FieldState:
+		return m.OldState(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldError:
+		return m.OldError(ctx)
+	case job.FieldConversion:
+		return m.OldConversion(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case job.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case job.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case job.FieldUserType:
+		v, ok := value.(job.UserType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserType(v)
+		return nil
+	case job.FieldPeerType:
+		v, ok := value.(job.PeerType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeerType(v)
+		return nil
+	case job.FieldPeerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeerID(v)
+		return nil
+	case job.FieldAccessHash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessHash(v)
+		return nil
+	case job.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case job.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case job.FieldOptions:
+		v, ok := value.(schema.JobOptions)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case job.FieldState:
+		v, ok := value.(job.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case job.FieldConversion:
+		v, ok := value.(job.Conversion)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConversion(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case job.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case job.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, job.FieldUserID)
+	}
+	if m.addpeer_id != nil {
+		fields = append(fields, job.FieldPeerID)
+	}
+	if m.addaccess_hash != nil {
+		fields = append(fields, job.FieldAccessHash)
+	}
+	if m.addmessage_id != nil {
+		fields = append(fields, job.FieldMessageID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldUserID:
+		return m.AddedUserID()
+	case job.FieldPeerID:
+		return m.AddedPeerID()
+	case job.FieldAccessHash:
+		return m.AddedAccessHash()
+	case job.FieldMessageID:
+		return m.AddedMessageID()
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case job.FieldPeerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPeerID(v)
+		return nil
+	case job.FieldAccessHash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccessHash(v)
+		return nil
+	case job.FieldMessageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageID(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldError) {
+		fields = append(fields, job.FieldError)
+	}
+	if m.FieldCleared(job.FieldConversion) {
+		fields = append(fields, job.FieldConversion)
+	}
+	if m.FieldCleared(job.FieldStartedAt) {
+		fields = append(fields, job.FieldStartedAt)
+	}
+	if m.FieldCleared(job.FieldFinishedAt) {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldError:
+		m.ClearError()
+		return nil
+	case job.FieldConversion:
+		m.ClearConversion()
+		return nil
+	case job.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case job.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case job.FieldUserType:
+		m.ResetUserType()
+		return nil
+	case job.FieldPeerType:
+		m.ResetPeerType()
+		return nil
+	case job.FieldPeerID:
+		m.ResetPeerID()
+		return nil
+	case job.FieldAccessHash:
+		m.ResetAccessHash()
+		return nil
+	case job.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case job.FieldURL:
+		m.ResetURL()
+		return nil
+	case job.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case job.FieldState:
+		m.ResetState()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldError:
+		m.ResetError()
+		return nil
+	case job.FieldConversion:
+		m.ResetConversion()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case job.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case job.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// PreferenceMutation represents an operation that mutates the Preference nodes in the graph.
+type PreferenceMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	peer_type       *preference.PeerType
+	peer_id         *int64
+	addpeer_id      *int64
+	caption         *string
+	originals       *bool
+	auto_fetch      *bool
+	quality         *int
+	addquality      *int
+	codec           *string
+	default_format  *preference.DefaultFormat
+	subtitles       *[]string
+	appendsubtitles []string
+	document        *bool
+	language        *string
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Preference, error)
+	predicates      []predicate.Preference
+}
+
+var _ ent.Mutation = (*PreferenceMutation)(nil)
+
+// preferenceOption allows management of the mutation configuration using functional options.
+type preferenceOption func(*PreferenceMutation)
+
+// newPreferenceMutation creates new mutation for the Preference entity.
+func newPreferenceMutation(c config, op Op, opts ...preferenceOption) *PreferenceMutation {
+	m := &PreferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePreference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPreferenceID sets the ID field of the mutation.
+func withPreferenceID(id int) preferenceOption {
+	return func(m *PreferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Preference
+		)
+		m.oldValue = func(ctx context.Context) (*Preference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Preference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPreference sets the old Preference of the mutation.
+func withPreference(node *Preference) preferenceOption {
+	return func(m *PreferenceMutation) {
+		m.oldValue = func(context.Context) (*Preference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PreferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PreferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PreferenceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PreferenceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Preference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPeerType sets the "peer_type" field.
+func (m *PreferenceMutation) SetPeerType(pt preference.PeerType) {
+	m.peer_type = &pt
+}
+
+// PeerType returns the value of the "peer_type" field in the mutation.
+func (m *PreferenceMutation) PeerType() (r preference.PeerType, exists bool) {
+	v := m.peer_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeerType returns the old "peer_type" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldPeerType(ctx context.Context) (v preference.PeerType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeerType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeerType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeerType: %w", err)
+	}
+	return oldValue.PeerType, nil
+}
+
+// ResetPeerType resets all changes to the "peer_type" field.
+func (m *PreferenceMutation) ResetPeerType() {
+	m.peer_type = nil
+}
+
+// SetPeerID sets the "peer_id" field.
+func (m *PreferenceMutation) SetPeerID(i int64) {
+	m.peer_id = &i
+	m.addpeer_id = nil
+}
+
+// PeerID returns the value of the "peer_id" field in the mutation.
+func (m *PreferenceMutation) PeerID() (r int64, exists bool) {
+	v := m.peer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeerID returns the old "peer_id" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldPeerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeerID: %w", err)
+	}
+	return oldValue.PeerID, nil
+}
+
+// AddPeerID adds i to the "peer_id" field.
+func (m *PreferenceMutation) AddPeerID(i int64) {
+	if m.addpeer_id != nil {
+		*m.addpeer_id += i
+	} else {
+		m.addpeer_id = &i
+	}
+}
+
+// AddedPeerID returns the value that was added to the "peer_id" field in this mutation.
+func (m *PreferenceMutation) AddedPeerID() (r int64, exists bool) {
+	v := m.addpeer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPeerID resets all changes to the "peer_id" field.
+func (m *PreferenceMutation) ResetPeerID() {
+	m.peer_id = nil
+	m.addpeer_id = nil
+}
+
+// SetCaption sets the "caption" field.
+func (m *PreferenceMutation) SetCaption(s string) {
+	m.caption = &s
+}
+
+// Caption returns the value of the "caption" field in the mutation.
+func (m *PreferenceMutation) Caption() (r string, exists bool) {
+	v := m.caption
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaption returns the old "caption" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldCaption(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaption is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaption requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaption: %w", err)
+	}
+	return oldValue.Caption, nil
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (m *PreferenceMutation) ClearCaption() {
+	m.caption = nil
+	m.clearedFields[preference.FieldCaption] = struct{}{}
+}
+
+// CaptionCleared returns if the "caption" field was cleared in this mutation.
+func (m *PreferenceMutation) CaptionCleared() bool {
+	_, ok := m.clearedFields[preference.FieldCaption]
+	return ok
+}
+
+// ResetCaption resets all changes to the "caption" field.
+func (m *PreferenceMutation) ResetCaption() {
+	m.caption = nil
+	delete(m.clearedFields, preference.FieldCaption)
+}
+
+// SetOriginals sets the "originals" field.
+func (m *PreferenceMutation) SetOriginals(b bool) {
+	m.originals = &b
+}
+
+// Originals returns the value of the "originals" field in the mutation.
+func (m *PreferenceMutation) Originals() (r bool, exists bool) {
+	v := m.originals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginals returns the old "originals" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldOriginals(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginals: %w", err)
+	}
+	return oldValue.Originals, nil
+}
+
+// ResetOriginals resets all changes to the "originals" field.
+func (m *PreferenceMutation) ResetOriginals() {
+	m.originals = nil
+}
+
+// SetAutoFetch sets the "auto_fetch" field.
+func (m *PreferenceMutation) SetAutoFetch(b bool) {
+	m.auto_fetch = &b
+}
+
+// AutoFetch returns the value of the "auto_fetch" field in the mutation.
+func (m *PreferenceMutation) AutoFetch() (r bool, exists bool) {
+	v := m.auto_fetch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoFetch returns the old "auto_fetch" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldAutoFetch(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoFetch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoFetch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoFetch: %w", err)
+	}
+	return oldValue.AutoFetch, nil
+}
+
+// ResetAutoFetch resets all changes to the "auto_fetch" field.
+func (m *PreferenceMutation) ResetAutoFetch() {
+	m.auto_fetch = nil
+}
+
+// SetQuality sets the "quality" field.
+func (m *PreferenceMutation) SetQuality(i int) {
+	m.quality = &i
+	m.addquality = nil
+}
+
+// Quality returns the value of the "quality" field in the mutation.
+func (m *PreferenceMutation) Quality() (r int, exists bool) {
+	v := m.quality
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuality returns the old "quality" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldQuality(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuality is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuality requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuality: %w", err)
+	}
+	return oldValue.Quality, nil
+}
+
+// AddQuality adds i to the "quality" field.
+func (m *PreferenceMutation) AddQuality(i int) {
+	if m.addquality != nil {
+		*m.addquality += i
+	} else {
+		m.addquality = &i
+	}
+}
+
+// AddedQuality returns the value that was added to the "quality" field in this mutation.
+func (m *PreferenceMutation) AddedQuality() (r int, exists bool) {
+	v := m.addquality
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuality resets all changes to the "quality" field.
+func (m *PreferenceMutation) ResetQuality() {
+	m.quality = nil
+	m.addquality = nil
+}
+
+// SetCodec sets the "codec" field.
+func (m *PreferenceMutation) SetCodec(s string) {
+	m.codec = &s
+}
+
+// Codec returns the value of the "codec" field in the mutation.
+func (m *PreferenceMutation) Codec() (r string, exists bool) {
+	v := m.codec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodec returns the old "codec" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldCodec(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodec: %w", err)
+	}
+	return oldValue.Codec, nil
+}
+
+// ClearCodec clears the value of the "codec" field.
+func (m *PreferenceMutation) ClearCodec() {
+	m.codec = nil
+	m.clearedFields[preference.FieldCodec] = struct{}{}
+}
+
+// CodecCleared returns if the "codec" field was cleared in this mutation.
+func (m *PreferenceMutation) CodecCleared() bool {
+	_, ok := m.clearedFields[preference.FieldCodec]
+	return ok
+}
+
+// ResetCodec resets all changes to the "codec" field.
+func (m *PreferenceMutation) ResetCodec() {
+	m.codec = nil
+	delete(m.clearedFields, preference.FieldCodec)
+}
+
+// SetDefaultFormat sets the "default_format" field.
+func (m *PreferenceMutation) SetDefaultFormat(pf preference.DefaultFormat) {
+	m.default_format = &pf
+}
+
+// DefaultFormat returns the value of the "default_format" field in the mutation.
+func (m *PreferenceMutation) DefaultFormat() (r preference.DefaultFormat, exists bool) {
+	v := m.default_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultFormat returns the old "default_format" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldDefaultFormat(ctx context.Context) (v preference.DefaultFormat, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultFormat: %w", err)
+	}
+	return oldValue.DefaultFormat, nil
+}
+
+// ResetDefaultFormat resets all changes to the "default_format" field.
+func (m *PreferenceMutation) ResetDefaultFormat() {
+	m.default_format = nil
+}
+
+// SetSubtitles sets the "subtitles" field.
+func (m *PreferenceMutation) SetSubtitles(s []string) {
+	m.subtitles = &s
+	m.appendsubtitles = nil
+}
+
+// Subtitles returns the value of the "subtitles" field in the mutation.
+func (m *PreferenceMutation) Subtitles() (r []string, exists bool) {
+	v := m.subtitles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtitles returns the old "subtitles" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldSubtitles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtitles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtitles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtitles: %w", err)
+	}
+	return oldValue.Subtitles, nil
+}
+
+// AppendSubtitles adds s to the "subtitles" field.
+func (m *PreferenceMutation) AppendSubtitles(s []string) {
+	m.appendsubtitles = append(m.appendsubtitles, s...)
+}
+
+// AppendedSubtitles returns the list of values that were appended to the "subtitles" field in this mutation.
+func (m *PreferenceMutation) AppendedSubtitles() ([]string, bool) {
+	if len(m.appendsubtitles) == 0 {
+		return nil, false
+	}
+	return m.appendsubtitles, true
+}
+
+// ClearSubtitles clears the value of the "subtitles" field.
+func (m *PreferenceMutation) ClearSubtitles() {
+	m.subtitles = nil
+	m.appendsubtitles = nil
+	m.clearedFields[preference.FieldSubtitles] = struct{}{}
+}
+
+// SubtitlesCleared returns if the "subtitles" field was cleared in this mutation.
+func (m *PreferenceMutation) SubtitlesCleared() bool {
+	_, ok := m.clearedFields[preference.FieldSubtitles]
+	return ok
+}
+
+// ResetSubtitles resets all changes to the "subtitles" field.
+func (m *PreferenceMutation) ResetSubtitles() {
+	m.subtitles = nil
+	m.appendsubtitles = nil
+	delete(m.clearedFields, preference.FieldSubtitles)
+}
+
+// SetDocument sets the "document" field.
+func (m *PreferenceMutation) SetDocument(b bool) {
+	m.document = &b
+}
+
+// Document returns the value of the "document" field in the mutation.
+func (m *PreferenceMutation) Document() (r bool, exists bool) {
+	v := m.document
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocument returns the old "document" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldDocument(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocument is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocument requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocument: %w", err)
+	}
+	return oldValue.Document, nil
+}
+
+// ResetDocument resets all changes to the "document" field.
+func (m *PreferenceMutation) ResetDocument() {
+	m.document = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *PreferenceMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *PreferenceMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (m *PreferenceMutation) ClearLanguage() {
+	m.language = nil
+	m.clearedFields[preference.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *PreferenceMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[preference.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *PreferenceMutation) ResetLanguage() {
+	m.language = nil
+	delete(m.clearedFields, preference.FieldLanguage)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PreferenceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PreferenceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Preference entity.
+// If the Preference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreferenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PreferenceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the PreferenceMutation builder.
+func (m *PreferenceMutation) Where(ps ...predicate.Preference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PreferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Preference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PreferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PreferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Preference).
+func (m *PreferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.peer_type != nil {
+		fields = append(fields, preference.FieldPeerType)
+	}
+	if m.peer_id != nil {
+		fields = append(fields, preference.FieldPeerID)
+	}
+	if m.caption != nil {
+		fields = append(fields, preference.FieldCaption)
+	}
+	if m.originals != nil {
+		fields = append(fields, preference.FieldOriginals)
+	}
+	if m.auto_fetch != nil {
+		fields = append(fields, preference.FieldAutoFetch)
+	}
+	if m.quality != nil {
+		fields = append(fields, preference.FieldQuality)
+	}
+	if m.codec != nil {
+		fields = append(fields, preference.FieldCodec)
+	}
+	if m.default_format != nil {
+		fields = append(fields, preference.FieldDefaultFormat)
+	}
+	if m.subtitles != nil {
+		fields = append(fields, preference.FieldSubtitles)
+	}
+	if m.document != nil {
+		fields = append(fields, preference.FieldDocument)
+	}
+	if m.language != nil {
+		fields = append(fields, preference.FieldLanguage)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, preference.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PreferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case preference.FieldPeerType:
+		return m.PeerType()
+	case preference.FieldPeerID:
+		return m.PeerID()
+	case preference.FieldCaption:
+		return m.Caption()
+	case preference.FieldOriginals:
+		return m.Originals()
+	case preference.FieldAutoFetch:
+		return m.AutoFetch()
+	case preference.FieldQuality:
+		return m.Quality()
+	case preference.FieldCodec:
+		return m.Codec()
+	case preference.FieldDefaultFormat:
+		return m.DefaultFormat()
+	case preference.FieldSubtitles:
+		return m.Subtitles()
+	case preference.FieldDocument:
+		return m.Document()
+	case preference.FieldLanguage:
+		return m.Language()
+	case preference.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case preference.FieldPeerType:
+		return m.OldPeerType(ctx)
+	case preference.FieldPeerID:
+		return m.OldPeerID(ctx)
+	case preference.FieldCaption:
+		return m.OldCaption(ctx)
+	case preference.FieldOriginals:
+		return m.OldOriginals(ctx)
+	case preference.FieldAutoFetch:
+		return m.OldAutoFetch(ctx)
+	case preference.FieldQuality:
+		return m.OldQuality(ctx)
+	case preference.FieldCodec:
+		return m.OldCodec(ctx)
+	case preference.FieldDefaultFormat:
+		return m.OldDefaultFormat(ctx)
+	case preference.FieldSubtitles:
+		return m.OldSubtitles(ctx)
+	case preference.FieldDocument:
+		return m.OldDocument(ctx)
+	case preference.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case preference.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Preference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PreferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case preference.FieldPeerType:
+		v, ok := value.(preference.PeerType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeerType(v)
+		return nil
+	case preference.FieldPeerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeerID(v)
+		return nil
+	case preference.FieldCaption:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaption(v)
+		return nil
+	case preference.FieldOriginals:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginals(v)
+		return nil
+	case preference.FieldAutoFetch:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoFetch(v)
+		return nil
+	case preference.FieldQuality:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuality(v)
+		return nil
+	case preference.FieldCodec:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodec(v)
+		return nil
+	case preference.FieldDefaultFormat:
+		v, ok := value.(preference.DefaultFormat)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultFormat(v)
+		return nil
+	case preference.FieldSubtitles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtitles(v)
+		return nil
+	case preference.FieldDocument:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocument(v)
+		return nil
+	case preference.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case preference.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Preference field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PreferenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addpeer_id != nil {
+		fields = append(fields, preference.FieldPeerID)
+	}
+	if m.addquality != nil {
+		fields = append(fields, preference.FieldQuality)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PreferenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case preference.FieldPeerID:
+		return m.AddedPeerID()
+	case preference.FieldQuality:
+		return m.AddedQuality()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PreferenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case preference.FieldPeerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPeerID(v)
+		return nil
+	case preference.FieldQuality:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuality(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Preference numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PreferenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(preference.FieldCaption) {
+		fields = append(fields, preference.FieldCaption)
+	}
+	if m.FieldCleared(preference.FieldCodec) {
+		fields = append(fields, preference.FieldCodec)
+	}
+	if m.FieldCleared(preference.FieldSubtitles) {
+		fields = append(fields, preference.FieldSubtitles)
+	}
+	if m.FieldCleared(preference.FieldLanguage) {
+		fields = append(fields, preference.FieldLanguage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PreferenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PreferenceMutation) ClearField(name string) error {
+	switch name {
+	case preference.FieldCaption:
+		m.ClearCaption()
+		return nil
+	case preference.FieldCodec:
+		m.ClearCodec()
+		return nil
+	case preference.FieldSubtitles:
+		m.ClearSubtitles()
+		return nil
+	case preference.FieldLanguage:
+		m.ClearLanguage()
+		return nil
+	}
+	return fmt.Errorf("unknown Preference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PreferenceMutation) ResetField(name string) error {
+	switch name {
+	case preference.FieldPeerType:
+		m.ResetPeerType()
+		return nil
+	case preference.FieldPeerID:
+		m.ResetPeerID()
+		return nil
+	case preference.FieldCaption:
+		m.ResetCaption()
+		return nil
+	case preference.FieldOriginals:
+		m.ResetOriginals()
+		return nil
+	case preference.FieldAutoFetch:
+		m.ResetAutoFetch()
+		return nil
+	case preference.FieldQuality:
+		m.ResetQuality()
+		return nil
+	case preference.FieldCodec:
+		m.ResetCodec()
+		return nil
+	case preference.FieldDefaultFormat:
+		m.ResetDefaultFormat()
+		return nil
+	case preference.FieldSubtitles:
+		m.ResetSubtitles()
+		return nil
+	case preference.FieldDocument:
+		m.ResetDocument()
+		return nil
+	case preference.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case preference.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Preference field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PreferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PreferenceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PreferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PreferenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PreferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PreferenceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PreferenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Preference unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PreferenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Preference edge %s", name)
+}
+
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	admin         *bool
+	limits        *access.Limits
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Role, error)
+	predicates    []predicate.Role
+}
+
+var _ ent.Mutation = (*RoleMutation)(nil)
+
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
+
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id int) roleOption {
+	return func(m *RoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Role
+		)
+		m.oldValue = func(ctx context.Context) (*Role, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Role.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAdmin sets the "admin" field.
+func (m *RoleMutation) SetAdmin(b bool) {
+	m.admin = &b
+}
+
+// Admin returns the value of the "admin" field in the mutation.
+func (m *RoleMutation) Admin() (r bool, exists bool) {
+	v := m.admin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdmin returns the old "admin" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldAdmin(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdmin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdmin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdmin: %w", err)
+	}
+	return oldValue.Admin, nil
+}
+
+// ResetAdmin resets all changes to the "admin" field.
+func (m *RoleMutation) ResetAdmin() {
+	m.admin = nil
+}
+
+// SetLimits sets the "limits" field.
+func (m *RoleMutation) SetLimits(a access.Limits) {
+	m.limits = &a
+}
+
+// Limits returns the value of the "limits" field in the mutation.
+func (m *RoleMutation) Limits() (r access.Limits, exists bool) {
+	v := m.limits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLimits returns the old "limits" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldLimits(ctx context.Context) (v access.Limits, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLimits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLimits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLimits: %w", err)
+	}
+	return oldValue.Limits, nil
+}
+
+// ResetLimits resets all changes to the "limits" field.
+func (m *RoleMutation) ResetLimits() {
+	m.limits = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
+	}
+	if m.admin != nil {
+		fields = append(fields, role.FieldAdmin)
+	}
+	if m.limits != nil {
+		fields = append(fields, role.FieldLimits)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, role.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldName:
+		return m.Name()
+	case role.FieldAdmin:
+		return m.Admin()
+	case role.FieldLimits:
+		return m.Limits()
+	case role.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case role.FieldName:
+		return m.OldName(ctx)
+	case role.FieldAdmin:
+		return m.OldAdmin(ctx)
+	case role.FieldLimits:
+		return m.OldLimits(ctx)
+	case role.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Role field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case role.FieldAdmin:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdmin(v)
+		return nil
+	case role.FieldLimits:
+		v, ok := value.(access.Limits)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLimits(v)
+		return nil
+	case role.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Role nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleMutation) ResetField(name string) error {
+	switch name {
+	case role.FieldName:
+		m.ResetName()
+		return nil
+	case role.FieldAdmin:
+		m.ResetAdmin()
+		return nil
+	case role.FieldLimits:
+		m.ResetLimits()
+		return nil
+	case role.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Role unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Role edge %s", name)
+}
+
+// TelegramBlobMutation represents an operation that mutates the TelegramBlob nodes in the graph.
+type TelegramBlobMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	size           *int64
+	addsize        *int64
+	_path          *string
+	uri            *string
+	sha256         *string
+	file_reference *[]byte
+	file_id        *int64
+	addfile_id     *int64
+	access_hash    *int64
+	addaccess_hash *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*TelegramBlob, error)
+	predicates     []predicate.TelegramBlob
+}
+
+var _ ent.Mutation = (*TelegramBlobMutation)(nil)
+
+// telegramblobOption allows management of the mutation configuration using functional options.
+type telegramblobOption func(*TelegramBlobMutation)
+
+// newTelegramBlobMutation creates new mutation for the TelegramBlob entity.
+func newTelegramBlobMutation(c config, op Op, opts ...telegramblobOption) *TelegramBlobMutation {
+	m := &TelegramBlobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTelegramBlob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTelegramBlobID sets the ID field of the mutation.
+func withTelegramBlobID(id uuid.UUID) telegramblobOption {
+	return func(m *TelegramBlobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TelegramBlob
+		)
+		m.oldValue = func(ctx context.Context) (*TelegramBlob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TelegramBlob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTelegramBlob sets the old TelegramBlob of the mutation.
+func withTelegramBlob(node *TelegramBlob) telegramblobOption {
+	return func(m *TelegramBlobMutation) {
+		m.oldValue = func(context.Context) (*TelegramBlob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TelegramBlobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TelegramBlobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TelegramBlob entities.
+func (m *TelegramBlobMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TelegramBlobMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TelegramBlobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TelegramBlob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSize sets the "size" field.
+func (m *TelegramBlobMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *TelegramBlobMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the TelegramBlob entity.
+// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramBlobMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *TelegramBlobMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *TelegramBlobMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *TelegramBlobMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetPath sets the "path" field.
+func (m *TelegramBlobMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *TelegramBlobMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the TelegramBlob entity.
+// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramBlobMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *TelegramBlobMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetURI sets the "uri" field.
+func (m *TelegramBlobMutation) SetURI(s string) {
+	m.uri = &s
+}
+
+// URI returns the value of the "uri" field in the mutation.
+func (m *TelegramBlobMutation) URI() (r string, exists bool) {
+	v := m.uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURI returns the old "uri" field's value of the TelegramBlob entity.
+// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramBlobMutation) OldURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURI: %w", err)
+	}
+	return oldValue.URI, nil
+}
+
+// ResetURI resets all changes to the "uri" field.
+func (m *TelegramBlobMutation) ResetURI() {
+	m.uri = nil
+}
+
+// SetSha256 sets the "sha256" field.
+func (m *TelegramBlobMutation) SetSha256(s string) {
+	m.sha256 = &s
+}
+
+// Sha256 returns the value of the "sha256" field in the mutation.
+func (m *TelegramBlobMutation) Sha256() (r string, exists bool) {
+	v := m.sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSha256 returns the old "sha256" field's value of the TelegramBlob entity.
+// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramBlobMutation) OldSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSha256: %w", err)
+	}
+	return oldValue.Sha256, nil
+}
+
+// ResetSha256 resets all changes to the "sha256" field.
+func (m *TelegramBlobMutation) ResetSha256() {
+	m.sha256 = nil
+}
+
+// SetFileReference sets the "file_reference" field.
+func (m *TelegramBlobMutation) SetFileReference(b []byte) {
+	m.file_reference = &b
+}
+
+// FileReference returns the value of the "file_reference" field in the mutation.
+func (m *TelegramBlobMutation) FileReference() (r []byte, exists bool) {
+	v := m.file_reference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileReference returns the old "file_reference" field's value of the TelegramBlob entity.
+// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramBlobMutation) OldFileReference(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileReference is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileReference requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileReference: %w", err)
+	}
+	return oldValue.FileReference, nil
+}
+
+// ResetFileReference resets all changes to the "file_reference" field.
+func (m *TelegramBlobMutation) ResetFileReference() {
+	m.file_reference = nil
+}
+
+// SetFileID sets the "file_id" field.
+func (m *TelegramBlobMutation) SetFileID(i int64) {
+	m.file_id = &i
+	m.addfile_id = nil
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *TelegramBlobMutation) FileID() (r int64, exists bool) {
+	v := m.file_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the TelegramBlob entity.
+// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramBlobMutation) OldFileID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// AddFileID adds i to the "file_id" field.
+func (m *TelegramBlobMutation) AddFileID(i int64) {
+	if m.addfile_id != nil {
+		*m.addfile_id += i
+	} else {
+		m.addfile_id = &i
+	}
+}
+
+// AddedFileID returns the value that was added to the "file_id" field in this mutation.
+func (m *TelegramBlobMutation) AddedFileID() (r int64, exists bool) {
+	v := m.addfile_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *TelegramBlobMutation) ResetFileID() {
+	m.file_id = nil
+	m.addfile_id = nil
+}
+
+// SetAccessHash sets the "access_hash" field.
+func (m *TelegramBlobMutation) SetAccessHash(i int64) {
+	m.access_hash = &i
+	m.addaccess_hash = nil
+}
+
+// AccessHash returns the value of the "access_hash" field in the mutation.
+func (m *TelegramBlobMutation) AccessHash() (r int64, exists bool) {
+	v := m.access_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessHash returns the old "access_hash" field's value of the TelegramBlob entity.
+// If the TelegramBlob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramBlobMutation) OldAccessHash(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessHash: %w", err)
+	}
+	return oldValue.AccessHash, nil
+}
+
+// AddAccessHash adds i to the "access_hash" field.
+func (m *TelegramBlobMutation) AddAccessHash(i int64) {
+	if m.addaccess_hash != nil {
+		*m.addaccess_hash += i
+	} else {
+		m.addaccess_hash = &i
+	}
+}
+
+// AddedAccessHash returns the value that was added to the "access_hash" field in this mutation.
+func (m *TelegramBlobMutation) AddedAccessHash() (r int64, exists bool) {
+	v := m.addaccess_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAccessHash resets all changes to the "access_hash" field.
+func (m *TelegramBlobMutation) ResetAccessHash() {
+	m.access_hash = nil
+	m.addaccess_hash = nil
+}
+
+// Where appends a list predicates to the TelegramBlobMutation builder.
+func (m *TelegramBlobMutation) Where(ps ...predicate.TelegramBlob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TelegramBlobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TelegramBlobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramBlob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TelegramBlobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TelegramBlobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TelegramBlob).
+func (m *TelegramBlobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TelegramBlobMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.size != nil {
+		fields = append(fields, telegramblob.FieldSize)
+	}
+	if m._path != nil {
+		fields = append(fields, telegramblob.FieldPath)
+	}
+	if m.uri != nil {
+		fields = append(fields, telegramblob.FieldURI)
+	}
+	if m.sha256 != nil {
+		fields = append(fields, telegramblob.FieldSha256)
+	}
+	if m.file_reference != nil {
+		fields = append(fields, telegramblob.FieldFileReference)
+	}
+	if m.file_id != nil {
+		fields = append(fields, telegramblob.FieldFileID)
+	}
+	if m.access_hash != nil {
+		fields = append(fields, telegramblob.FieldAccessHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TelegramBlobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case telegramblob.FieldSize:
+		return m.Size()
+	case telegramblob.FieldPath:
+		return m.Path()
+	case telegramblob.FieldURI:
+		return m.URI()
+	case telegramblob.FieldSha256:
+		return m.Sha256()
+	case telegramblob.FieldFileReference:
+		return m.FileReference()
+	case telegramblob.FieldFileID:
+		return m.FileID()
+	case telegramblob.FieldAccessHash:
+		return m.AccessHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TelegramBlobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case telegramblob.FieldSize:
+		return m.OldSize(ctx)
+	case telegramblob.FieldPath:
+		return m.OldPath(ctx)
+	case telegramblob.FieldURI:
+		return m.OldURI(ctx)
+	case telegramblob.FieldSha256:
+		return m.OldSha256(ctx)
+	case telegramblob.FieldFileReference:
+		return m.OldFileReference(ctx)
+	case telegramblob.FieldFileID:
+		return m.OldFileID(ctx)
+	case telegramblob.FieldAccessHash:
+		return m.OldAccessHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown TelegramBlob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramBlobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case telegramblob.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case telegramblob.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case telegramblob.FieldURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURI(v)
+		return nil
+	case telegramblob.FieldSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSha256(v)
+		return nil
+	case telegramblob.FieldFileReference:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileReference(v)
+		return nil
+	case telegramblob.FieldFileID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case telegramblob.FieldAccessHash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramBlob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TelegramBlobMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, telegramblob.FieldSize)
+	}
+	if m.addfile_id != nil {
+		fields = append(fields, telegramblob.FieldFileID)
+	}
+	if m.addaccess_hash != nil {
+		fields = append(fields, telegramblob.FieldAccessHash)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TelegramBlobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case telegramblob.FieldSize:
+		return m.AddedSize()
+	case telegramblob.FieldFileID:
+		return m.AddedFileID()
+	case telegramblob.FieldAccessHash:
+		return m.AddedAccessHash()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramBlobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case telegramblob.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case telegramblob.FieldFileID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileID(v)
+		return nil
+	case telegramblob.FieldAccessHash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccessHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramBlob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TelegramBlobMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TelegramBlobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TelegramBlobMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TelegramBlob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TelegramBlobMutation) ResetField(name string) error {
+	switch name {
+	case telegramblob.FieldSize:
+		m.ResetSize()
+		return nil
+	case telegramblob.FieldPath:
+		m.ResetPath()
+		return nil
+	case telegramblob.FieldURI:
+		m.ResetURI()
+		return nil
+	case telegramblob.FieldSha256:
+		m.ResetSha256()
+		return nil
+	case telegramblob.FieldFileReference:
+		m.ResetFileReference()
+		return nil
+	case telegramblob.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case telegramblob.FieldAccessHash:
+		m.ResetAccessHash()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramBlob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TelegramBlobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TelegramBlobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TelegramBlobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TelegramBlobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TelegramBlobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TelegramBlobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TelegramBlobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TelegramBlob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TelegramBlobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TelegramBlob edge %s", name)
+}
+
+// TelegramChannelMutation represents an operation that mutates the TelegramChannel nodes in the graph.
+type TelegramChannelMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int64
+	access_hash           *int64
+	addaccess_hash        *int64
+	title                 *string
+	save_records          *bool
+	save_favorite_records *bool
+	active                *bool
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*TelegramChannel, error)
+	predicates            []predicate.TelegramChannel
+}
+
+var _ ent.Mutation = (*TelegramChannelMutation)(nil)
+
+// telegramchannelOption allows management of the mutation configuration using functional options.
+type telegramchannelOption func(*TelegramChannelMutation)
+
+// newTelegramChannelMutation creates new mutation for the TelegramChannel entity.
+func newTelegramChannelMutation(c config, op Op, opts ...telegramchannelOption) *TelegramChannelMutation {
+	m := &TelegramChannelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTelegramChannel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTelegramChannelID sets the ID field of the mutation.
+func withTelegramChannelID(id int64) telegramchannelOption {
+	return func(m *TelegramChannelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TelegramChannel
+		)
+		m.oldValue = func(ctx context.Context) (*TelegramChannel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TelegramChannel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTelegramChannel sets the old TelegramChannel of the mutation.
+func withTelegramChannel(node *TelegramChannel) telegramchannelOption {
+	return func(m *TelegramChannelMutation) {
+		m.oldValue = func(context.Context) (*TelegramChannel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TelegramChannelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TelegramChannelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TelegramChannel entities.
+func (m *TelegramChannelMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TelegramChannelMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TelegramChannelMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TelegramChannel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAccessHash sets the "access_hash" field.
+func (m *TelegramChannelMutation) SetAccessHash(i int64) {
+	m.access_hash = &i
+	m.addaccess_hash = nil
+}
+
+// AccessHash returns the value of the "access_hash" field in the mutation.
+func (m *TelegramChannelMutation) AccessHash() (r int64, exists bool) {
+	v := m.access_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessHash returns the old "access_hash" field's value of the TelegramChannel entity.
+// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChannelMutation) OldAccessHash(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessHash: %w", err)
+	}
+	return oldValue.AccessHash, nil
+}
+
+// AddAccessHash adds i to the "access_hash" field.
+func (m *TelegramChannelMutation) AddAccessHash(i int64) {
+	if m.addaccess_hash != nil {
+		*m.addaccess_hash += i
+	} else {
+		m.addaccess_hash = &i
+	}
+}
+
+// AddedAccessHash returns the value that was added to the "access_hash" field in this mutation.
+func (m *TelegramChannelMutation) AddedAccessHash() (r int64, exists bool) {
+	v := m.addaccess_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAccessHash resets all changes to the "access_hash" field.
+func (m *TelegramChannelMutation) ResetAccessHash() {
+	m.access_hash = nil
+	m.addaccess_hash = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *TelegramChannelMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TelegramChannelMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the TelegramChannel entity.
+// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChannelMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TelegramChannelMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetSaveRecords sets the "save_records" field.
+func (m *TelegramChannelMutation) SetSaveRecords(b bool) {
+	m.save_records = &b
+}
+
+// SaveRecords returns the value of the "save_records" field in the mutation.
+func (m *TelegramChannelMutation) SaveRecords() (r bool, exists bool) {
+	v := m.save_records
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSaveRecords returns the old "save_records" field's value of the TelegramChannel entity.
+// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChannelMutation) OldSaveRecords(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSaveRecords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSaveRecords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSaveRecords: %w", err)
+	}
+	return oldValue.SaveRecords, nil
+}
+
+// ClearSaveRecords clears the value of the "save_records" field.
+func (m *TelegramChannelMutation) ClearSaveRecords() {
+	m.save_records = nil
+	m.clearedFields[telegramchannel.FieldSaveRecords] = struct{}{}
+}
+
+// SaveRecordsCleared returns if the "save_records" field was cleared in this mutation.
+func (m *TelegramChannelMutation) SaveRecordsCleared() bool {
+	_, ok := m.clearedFields[telegramchannel.FieldSaveRecords]
+	return ok
+}
+
+// ResetSaveRecords resets all changes to the "save_records" field.
+func (m *TelegramChannelMutation) ResetSaveRecords() {
+	m.save_records = nil
+	delete(m.clearedFields, telegramchannel.FieldSaveRecords)
+}
+
+// SetSaveFavoriteRecords sets the "save_favorite_records" field.
+func (m *TelegramChannelMutation) SetSaveFavoriteRecords(b bool) {
+	m.save_favorite_records = &b
+}
+
+// SaveFavoriteRecords returns the value of the "save_favorite_records" field in the mutation.
+func (m *TelegramChannelMutation) SaveFavoriteRecords() (r bool, exists bool) {
+	v := m.save_favorite_records
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSaveFavoriteRecords returns the old "save_favorite_records" field's value of the TelegramChannel entity.
+// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChannelMutation) OldSaveFavoriteRecords(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSaveFavoriteRecords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSaveFavoriteRecords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSaveFavoriteRecords: %w", err)
+	}
+	return oldValue.SaveFavoriteRecords, nil
+}
+
+// ClearSaveFavoriteRecords clears the value of the "save_favorite_records" field.
+func (m *TelegramChannelMutation) ClearSaveFavoriteRecords() {
+	m.save_favorite_records = nil
+	m.clearedFields[telegramchannel.FieldSaveFavoriteRecords] = struct{}{}
+}
+
+// SaveFavoriteRecordsCleared returns if the "save_favorite_records" field was cleared in this mutation.
+func (m *TelegramChannelMutation) SaveFavoriteRecordsCleared() bool {
+	_, ok := m.clearedFields[telegramchannel.FieldSaveFavoriteRecords]
+	return ok
+}
+
+// ResetSaveFavoriteRecords resets all changes to the "save_favorite_records" field.
+func (m *TelegramChannelMutation) ResetSaveFavoriteRecords() {
+	m.save_favorite_records = nil
+	delete(m.clearedFields, telegramchannel.FieldSaveFavoriteRecords)
+}
+
+// SetActive sets the "active" field.
+func (m *TelegramChannelMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *TelegramChannelMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the TelegramChannel entity.
+// If the TelegramChannel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramChannelMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *TelegramChannelMutation) ResetActive() {
+	m.active = nil
+}
+
+// Where appends a list predicates to the TelegramChannelMutation builder.
+func (m *TelegramChannelMutation) Where(ps ...predicate.TelegramChannel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TelegramChannelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TelegramChannelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramChannel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TelegramChannelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TelegramChannelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TelegramChannel).
+func (m *TelegramChannelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TelegramChannelMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.access_hash != nil {
+		fields = append(fields, telegramchannel.FieldAccessHash)
+	}
+	if m.title != nil {
+		fields = append(fields, telegramchannel.FieldTitle)
+	}
+	if m.save_records != nil {
+		fields = append(fields, telegramchannel.FieldSaveRecords)
+	}
+	if m.save_favorite_records != nil {
+		fields = append(fields, telegramchannel.FieldSaveFavoriteRecords)
+	}
+	if m.active != nil {
+		fields = append(fields, telegramchannel.FieldActive)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TelegramChannelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case telegramchannel.FieldAccessHash:
+		return m.AccessHash()
+	case telegramchannel.FieldTitle:
+		return m.Title()
+	case telegramchannel.FieldSaveRecords:
+		return m.SaveRecords()
+	case telegramchannel.FieldSaveFavoriteRecords:
+		return m.SaveFavoriteRecords()
+	case telegramchannel.FieldActive:
+		return m.Active()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TelegramChannelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case telegramchannel.FieldAccessHash:
+		return m.OldAccessHash(ctx)
+	case telegramchannel.FieldTitle:
+		return m.OldTitle(ctx)
+	case telegramchannel.FieldSaveRecords:
+		return m.OldSaveRecords(ctx)
+	case telegramchannel.FieldSaveFavoriteRecords:
+		return m.OldSaveFavoriteRecords(ctx)
+	case telegramchannel.FieldActive:
+		return m.OldActive(ctx)
+	}
+	return nil, fmt.Errorf("unknown TelegramChannel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramChannelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case telegramchannel.FieldAccessHash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessHash(v)
+		return nil
+	case telegramchannel.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case telegramchannel.FieldSaveRecords:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSaveRecords(v)
+		return nil
+	case telegramchannel.FieldSaveFavoriteRecords:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSaveFavoriteRecords(v)
+		return nil
+	case telegramchannel.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChannel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TelegramChannelMutation) AddedFields() []string {
+	var fields []string
+	if m.addaccess_hash != nil {
+		fields = append(fields, telegramchannel.FieldAccessHash)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TelegramChannelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case telegramchannel.FieldAccessHash:
+		return m.AddedAccessHash()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramChannelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case telegramchannel.FieldAccessHash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccessHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChannel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TelegramChannelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(telegramchannel.FieldSaveRecords) {
+		fields = append(fields, telegramchannel.FieldSaveRecords)
+	}
+	if m.FieldCleared(telegramchannel.FieldSaveFavoriteRecords) {
+		fields = append(fields, telegramchannel.FieldSaveFavoriteRecords)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TelegramChannelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TelegramChannelMutation) ClearField(name string) error {
+	switch name {
+	case telegramchannel.FieldSaveRecords:
+		m.ClearSaveRecords()
+		return nil
+	case telegramchannel.FieldSaveFavoriteRecords:
+		m.ClearSaveFavoriteRecords()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChannel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TelegramChannelMutation) ResetField(name string) error {
+	switch name {
+	case telegramchannel.FieldAccessHash:
+		m.ResetAccessHash()
+		return nil
+	case telegramchannel.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case telegramchannel.FieldSaveRecords:
+		m.ResetSaveRecords()
+		return nil
+	case telegramchannel.FieldSaveFavoriteRecords:
+		m.ResetSaveFavoriteRecords()
+		return nil
+	case telegramchannel.FieldActive:
+		m.ResetActive()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramChannel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TelegramChannelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TelegramChannelMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TelegramChannelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TelegramChannelMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TelegramChannelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TelegramChannelMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TelegramChannelMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TelegramChannel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TelegramChannelMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TelegramChannel edge %s", name)
+}
+
+// TelegramSessionMutation represents an operation that mutates the TelegramSession nodes in the graph.
+type TelegramSessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	data          *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TelegramSession, error)
+	predicates    []predicate.TelegramSession
+}
+
+var _ ent.Mutation = (*TelegramSessionMutation)(nil)
+
+// telegramsessionOption allows management of the mutation configuration using functional options.
+type telegramsessionOption func(*TelegramSessionMutation)
+
+// newTelegramSessionMutation creates new mutation for the TelegramSession entity.
+func newTelegramSessionMutation(c config, op Op, opts ...telegramsessionOption) *TelegramSessionMutation {
+	m := &TelegramSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTelegramSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTelegramSessionID sets the ID field of the mutation.
+func withTelegramSessionID(id int) telegramsessionOption {
+	return func(m *TelegramSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TelegramSession
+		)
+		m.oldValue = func(ctx context.Context) (*TelegramSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TelegramSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTelegramSession sets the old TelegramSession of the mutation.
+func withTelegramSession(node *TelegramSession) telegramsessionOption {
+	return func(m *TelegramSessionMutation) {
+		m.oldValue = func(context.Context) (*TelegramSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TelegramSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TelegramSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TelegramSession entities.
+func (m *TelegramSessionMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TelegramSessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TelegramSessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TelegramSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetData sets the "data" field.
+func (m *TelegramSessionMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *TelegramSessionMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the TelegramSession entity.
+// If the TelegramSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TelegramSessionMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *TelegramSessionMutation) ResetData() {
+	m.data = nil
+}
+
+// Where appends a list predicates to the TelegramSessionMutation builder.
+func (m *TelegramSessionMutation) Where(ps ...predicate.TelegramSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TelegramSessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TelegramSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TelegramSession, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TelegramSessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TelegramSessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TelegramSession).
+func (m *TelegramSessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TelegramSessionMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.data != nil {
+		fields = append(fields, telegramsession.FieldData)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TelegramSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case telegramsession.FieldData:
+		return m.Data()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TelegramSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case telegramsession.FieldData:
+		return m.OldData(ctx)
+	}
+	return nil, fmt.Errorf("unknown TelegramSession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case telegramsession.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramSession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TelegramSessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TelegramSessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TelegramSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TelegramSession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TelegramSessionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TelegramSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TelegramSessionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TelegramSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TelegramSessionMutation) ResetField(name string) error {
+	switch name {
+	case telegramsession.FieldData:
+		m.ResetData()
+		return nil
+	}
+	return fmt.Errorf("unknown TelegramSession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TelegramSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TelegramSessionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TelegramSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TelegramSessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TelegramSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TelegramSessionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TelegramSessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TelegramSession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TelegramSessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TelegramSession edge %s", name)
 }
 
-// TelegramSessionMutation represents an operation that mutates the TelegramSession nodes in the graph.
-type TelegramSessionMutation struct {
+// UsageMutation represents an operation that mutates the Usage nodes in the graph.
+type UsageMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	data          *[]byte
+	peer_type     *usage.PeerType
+	peer_id       *int64
+	addpeer_id    *int64
+	job_id        *int
+	addjob_id     *int
+	bytes         *int64
+	addbytes      *int64
+	created_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*TelegramSession, error)
-	predicates    []predicate.TelegramSession
+	oldValue      func(context.Context) (*Usage, error)
+	predicates    []predicate.Usage
 }
 
-var _ ent.Mutation = (*TelegramSessionMutation)(nil)
+var _ ent.Mutation = (*UsageMutation)(nil)
 
-// telegramsessionOption allows management of the mutation configuration using functional options.
-type telegramsessionOption func(*TelegramSessionMutation)
+// usageOption allows management of the mutation configuration using functional options.
+type usageOption func(*UsageMutation)
 
-// newTelegramSessionMutation creates new mutation for the TelegramSession entity.
-func newTelegramSessionMutation(c config, op Op, opts ...telegramsessionOption) *TelegramSessionMutation {
-	m := &TelegramSessionMutation{
+// newUsageMutation creates new mutation for the Usage entity.
+func newUsageMutation(c config, op Op, opts ...usageOption) *UsageMutation {
+	m := &UsageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTelegramSession,
+		typ:           TypeUsage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1446,20 +6141,20 @@ func newTelegramSessionMutation(c config, op Op, opts ...telegramsessionOption)
 	return m
 }
 
-// withTelegramSessionID sets the ID field of the mutation.
-func withTelegramSessionID(id int) telegramsessionOption {
-	return func(m *TelegramSessionMutation) {
+// withUsageID sets the ID field of the mutation.
+func withUsageID(id int) usageOption {
+	return func(m *UsageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TelegramSession
+			value *Usage
 		)
-		m.oldValue = func(ctx context.Context) (*TelegramSession, error) {
+		m.oldValue = func(ctx context.Context) (*Usage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TelegramSession.Get(ctx, id)
+					value, err = m.Client().Usage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1468,10 +6163,10 @@ func withTelegramSessionID(id int) telegramsessionOption {
 	}
 }
 
-// withTelegramSession sets the old TelegramSession of the mutation.
-func withTelegramSession(node *TelegramSession) telegramsessionOption {
-	return func(m *TelegramSessionMutation) {
-		m.oldValue = func(context.Context) (*TelegramSession, error) {
+// withUsage sets the old Usage of the mutation.
+func withUsage(node *Usage) usageOption {
+	return func(m *UsageMutation) {
+		m.oldValue = func(context.Context) (*Usage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1480,7 +6175,7 @@ func withTelegramSession(node *TelegramSession) telegramsessionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TelegramSessionMutation) Client() *Client {
+func (m UsageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1488,7 +6183,7 @@ func (m TelegramSessionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TelegramSessionMutation) Tx() (*Tx, error) {
+func (m UsageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1497,15 +6192,9 @@ func (m TelegramSessionMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TelegramSession entities.
-func (m *TelegramSessionMutation) SetID(id int) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TelegramSessionMutation) ID() (id int, exists bool) {
+func (m *UsageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1516,7 +6205,7 @@ func (m *TelegramSessionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TelegramSessionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UsageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1525,57 +6214,261 @@ func (m *TelegramSessionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TelegramSession.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Usage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetData sets the "data" field.
-func (m *TelegramSessionMutation) SetData(b []byte) {
-	m.data = &b
+// SetPeerType sets the "peer_type" field.
+func (m *UsageMutation) SetPeerType(ut usage.PeerType) {
+	m.peer_type = &ut
 }
 
-// Data returns the value of the "data" field in the mutation.
-func (m *TelegramSessionMutation) Data() (r []byte, exists bool) {
-	v := m.data
+// PeerType returns the value of the "peer_type" field in the mutation.
+func (m *UsageMutation) PeerType() (r usage.PeerType, exists bool) {
+	v := m.peer_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldData returns the old "data" field's value of the TelegramSession entity.
-// If the TelegramSession object wasn't provided to the builder, the object is fetched from the database.
+// OldPeerType returns the old "peer_type" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TelegramSessionMutation) OldData(ctx context.Context) (v []byte, err error) {
+func (m *UsageMutation) OldPeerType(ctx context.Context) (v usage.PeerType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldData is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeerType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldData requires an ID field in the mutation")
+		return v, errors.New("OldPeerType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldData: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeerType: %w", err)
 	}
-	return oldValue.Data, nil
+	return oldValue.PeerType, nil
 }
 
-// ResetData resets all changes to the "data" field.
-func (m *TelegramSessionMutation) ResetData() {
-	m.data = nil
+// ResetPeerType resets all changes to the "peer_type" field.
+func (m *UsageMutation) ResetPeerType() {
+	m.peer_type = nil
 }
 
-// Where appends a list predicates to the TelegramSessionMutation builder.
-func (m *TelegramSessionMutation) Where(ps ...predicate.TelegramSession) {
+// SetPeerID sets the "peer_id" field.
+func (m *UsageMutation) SetPeerID(i int64) {
+	m.peer_id = &i
+	m.addpeer_id = nil
+}
+
+// PeerID returns the value of the "peer_id" field in the mutation.
+func (m *UsageMutation) PeerID() (r int64, exists bool) {
+	v := m.peer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeerID returns the old "peer_id" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageMutation) OldPeerID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeerID: %w", err)
+	}
+	return oldValue.PeerID, nil
+}
+
+// AddPeerID adds i to the "peer_id" field.
+func (m *UsageMutation) AddPeerID(i int64) {
+	if m.addpeer_id != nil {
+		*m.addpeer_id += i
+	} else {
+		m.addpeer_id = &i
+	}
+}
+
+// AddedPeerID returns the value that was added to the "peer_id" field in this mutation.
+func (m *UsageMutation) AddedPeerID() (r int64, exists bool) {
+	v := m.addpeer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPeerID resets all changes to the "peer_id" field.
+func (m *UsageMutation) ResetPeerID() {
+	m.peer_id = nil
+	m.addpeer_id = nil
+}
+
+// SetJobID sets the "job_id" field.
+func (m *UsageMutation) SetJobID(i int) {
+	m.job_id = &i
+	m.addjob_id = nil
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *UsageMutation) JobID() (r int, exists bool) {
+	v := m.job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageMutation) OldJobID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// AddJobID adds i to the "job_id" field.
+func (m *UsageMutation) AddJobID(i int) {
+	if m.addjob_id != nil {
+		*m.addjob_id += i
+	} else {
+		m.addjob_id = &i
+	}
+}
+
+// AddedJobID returns the value that was added to the "job_id" field in this mutation.
+func (m *UsageMutation) AddedJobID() (r int, exists bool) {
+	v := m.addjob_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *UsageMutation) ResetJobID() {
+	m.job_id = nil
+	m.addjob_id = nil
+}
+
+// SetBytes sets the "bytes" field.
+func (m *UsageMutation) SetBytes(i int64) {
+	m.bytes = &i
+	m.addbytes = nil
+}
+
+// Bytes returns the value of the "bytes" field in the mutation.
+func (m *UsageMutation) Bytes() (r int64, exists bool) {
+	v := m.bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBytes returns the old "bytes" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageMutation) OldBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBytes: %w", err)
+	}
+	return oldValue.Bytes, nil
+}
+
+// AddBytes adds i to the "bytes" field.
+func (m *UsageMutation) AddBytes(i int64) {
+	if m.addbytes != nil {
+		*m.addbytes += i
+	} else {
+		m.addbytes = &i
+	}
+}
+
+// AddedBytes returns the value that was added to the "bytes" field in this mutation.
+func (m *UsageMutation) AddedBytes() (r int64, exists bool) {
+	v := m.addbytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBytes resets all changes to the "bytes" field.
+func (m *UsageMutation) ResetBytes() {
+	m.bytes = nil
+	m.addbytes = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UsageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UsageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Usage entity.
+// If the Usage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UsageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the UsageMutation builder.
+func (m *UsageMutation) Where(ps ...predicate.Usage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TelegramSessionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TelegramSessionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TelegramSession, len(ps))
+func (m *UsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Usage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1583,27 +6476,39 @@ func (m *TelegramSessionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TelegramSessionMutation) Op() Op {
+func (m *UsageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TelegramSessionMutation) SetOp(op Op) {
+func (m *UsageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TelegramSession).
-func (m *TelegramSessionMutation) Type() string {
+// Type returns the node type of this mutation (Usage).
+func (m *UsageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TelegramSessionMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.data != nil {
-		fields = append(fields, telegramsession.FieldData)
+func (m *UsageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.peer_type != nil {
+		fields = append(fields, usage.FieldPeerType)
+	}
+	if m.peer_id != nil {
+		fields = append(fields, usage.FieldPeerID)
+	}
+	if m.job_id != nil {
+		fields = append(fields, usage.FieldJobID)
+	}
+	if m.bytes != nil {
+		fields = append(fields, usage.FieldBytes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, usage.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1611,10 +6516,18 @@ func (m *TelegramSessionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TelegramSessionMutation) Field(name string) (ent.Value, bool) {
+func (m *UsageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case telegramsession.FieldData:
-		return m.Data()
+	case usage.FieldPeerType:
+		return m.PeerType()
+	case usage.FieldPeerID:
+		return m.PeerID()
+	case usage.FieldJobID:
+		return m.JobID()
+	case usage.FieldBytes:
+		return m.Bytes()
+	case usage.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1622,126 +6535,213 @@ func (m *TelegramSessionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TelegramSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case telegramsession.FieldData:
-		return m.OldData(ctx)
+	case usage.FieldPeerType:
+		return m.OldPeerType(ctx)
+	case usage.FieldPeerID:
+		return m.OldPeerID(ctx)
+	case usage.FieldJobID:
+		return m.OldJobID(ctx)
+	case usage.FieldBytes:
+		return m.OldBytes(ctx)
+	case usage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TelegramSession field %s", name)
+	return nil, fmt.Errorf("unknown Usage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramSessionMutation) SetField(name string, value ent.Value) error {
+func (m *UsageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case telegramsession.FieldData:
-		v, ok := value.([]byte)
+	case usage.FieldPeerType:
+		v, ok := value.(usage.PeerType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetData(v)
+		m.SetPeerType(v)
+		return nil
+	case usage.FieldPeerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeerID(v)
+		return nil
+	case usage.FieldJobID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case usage.FieldBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBytes(v)
+		return nil
+	case usage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramSession field %s", name)
+	return fmt.Errorf("unknown Usage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TelegramSessionMutation) AddedFields() []string {
-	return nil
+func (m *UsageMutation) AddedFields() []string {
+	var fields []string
+	if m.addpeer_id != nil {
+		fields = append(fields, usage.FieldPeerID)
+	}
+	if m.addjob_id != nil {
+		fields = append(fields, usage.FieldJobID)
+	}
+	if m.addbytes != nil {
+		fields = append(fields, usage.FieldBytes)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TelegramSessionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case usage.FieldPeerID:
+		return m.AddedPeerID()
+	case usage.FieldJobID:
+		return m.AddedJobID()
+	case usage.FieldBytes:
+		return m.AddedBytes()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TelegramSessionMutation) AddField(name string, value ent.Value) error {
+func (m *UsageMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case usage.FieldPeerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPeerID(v)
+		return nil
+	case usage.FieldJobID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddJobID(v)
+		return nil
+	case usage.FieldBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBytes(v)
+		return nil
 	}
-	return fmt.Errorf("unknown TelegramSession numeric field %s", name)
+	return fmt.Errorf("unknown Usage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TelegramSessionMutation) ClearedFields() []string {
+func (m *UsageMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TelegramSessionMutation) FieldCleared(name string) bool {
+func (m *UsageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TelegramSessionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TelegramSession nullable field %s", name)
+func (m *UsageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Usage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TelegramSessionMutation) ResetField(name string) error {
+func (m *UsageMutation) ResetField(name string) error {
 	switch name {
-	case telegramsession.FieldData:
-		m.ResetData()
+	case usage.FieldPeerType:
+		m.ResetPeerType()
+		return nil
+	case usage.FieldPeerID:
+		m.ResetPeerID()
+		return nil
+	case usage.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case usage.FieldBytes:
+		m.ResetBytes()
+		return nil
+	case usage.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TelegramSession field %s", name)
+	return fmt.Errorf("unknown Usage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TelegramSessionMutation) AddedEdges() []string {
+func (m *UsageMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TelegramSessionMutation) AddedIDs(name string) []ent.Value {
+func (m *UsageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TelegramSessionMutation) RemovedEdges() []string {
+func (m *UsageMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TelegramSessionMutation) RemovedIDs(name string) []ent.Value {
+func (m *UsageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TelegramSessionMutation) ClearedEdges() []string {
+func (m *UsageMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TelegramSessionMutation) EdgeCleared(name string) bool {
+func (m *UsageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TelegramSessionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TelegramSession unique edge %s", name)
+func (m *UsageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Usage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TelegramSessionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TelegramSession edge %s", name)
+func (m *UsageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Usage edge %s", name)
 }
@@ -6,6 +6,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Grant is the predicate function for grant builders.
+type Grant func(*sql.Selector)
+
+// Invite is the predicate function for invite builders.
+type Invite func(*sql.Selector)
+
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
+// Preference is the predicate function for preference builders.
+type Preference func(*sql.Selector)
+
+// Role is the predicate function for role builders.
+type Role func(*sql.Selector)
+
 // TelegramBlob is the predicate function for telegramblob builders.
 type TelegramBlob func(*sql.Selector)
 
@@ -14,3 +29,6 @@ type TelegramChannel func(*sql.Selector)
 
 // TelegramSession is the predicate function for telegramsession builders.
 type TelegramSession func(*sql.Selector)
+
+// Usage is the predicate function for usage builders.
+type Usage func(*sql.Selector)
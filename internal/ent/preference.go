@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ernado/tentacle/internal/ent/preference"
+)
+
+// Preference is the model entity for the Preference schema.
+type Preference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PeerType holds the value of the "peer_type" field.
+	PeerType preference.PeerType `json:"peer_type,omitempty"`
+	// PeerID holds the value of the "peer_id" field.
+	PeerID int64 `json:"peer_id,omitempty"`
+	// caption template, default if empty
+	Caption string `json:"caption,omitempty"`
+	// attach original files of photos as documents
+	Originals bool `json:"originals,omitempty"`
+	// fetch every link in group, not only ones addressed to bot
+	AutoFetch bool `json:"auto_fetch,omitempty"`
+	// maximum short side of video, unlimited if zero
+	Quality int `json:"quality,omitempty"`
+	// preferred video codec family, playable everywhere if empty
+	Codec string `json:"codec,omitempty"`
+	// what links without command are downloaded as
+	DefaultFormat preference.DefaultFormat `json:"default_format,omitempty"`
+	// languages of subtitles that are sent with video
+	Subtitles []string `json:"subtitles,omitempty"`
+	// send video as file instead of streamable video
+	Document bool `json:"document,omitempty"`
+	// language of bot messages, from telegram if empty
+	Language string `json:"language,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Preference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case preference.FieldSubtitles:
+			values[i] = new([]byte)
+		case preference.FieldOriginals, preference.FieldAutoFetch, preference.FieldDocument:
+			values[i] = new(sql.NullBool)
+		case preference.FieldID, preference.FieldPeerID, preference.FieldQuality:
+			values[i] = new(sql.NullInt64)
+		case preference.FieldPeerType, preference.FieldCaption, preference.FieldCodec, preference.FieldDefaultFormat, preference.FieldLanguage:
+			values[i] = new(sql.NullString)
+		case preference.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Preference fields.
+func (_m *Preference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case preference.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case preference.FieldPeerType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field peer_type", values[i])
+			} else if value.Valid {
+				_m.PeerType = preference.PeerType(value.String)
+			}
+		case preference.FieldPeerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field peer_id", values[i])
+			} else if value.Valid {
+				_m.PeerID = value.Int64
+			}
+		case preference.FieldCaption:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caption", values[i])
+			} else if value.Valid {
+				_m.Caption = value.String
+			}
+		case preference.FieldOriginals:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field originals", values[i])
+			} else if value.Valid {
+				_m.Originals = value.Bool
+			}
+		case preference.FieldAutoFetch:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_fetch", values[i])
+			} else if value.Valid {
+				_m.AutoFetch = value.Bool
+			}
+		case preference.FieldQuality:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quality", values[i])
+			} else if value.Valid {
+				_m.Quality = int(value.Int64)
+			}
+		case preference.FieldCodec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field codec", values[i])
+			} else if value.Valid {
+				_m.Codec = value.String
+			}
+		case preference.FieldDefaultFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_format", values[i])
+			} else if value.Valid {
+				_m.DefaultFormat = preference.DefaultFormat(value.String)
+			}
+		case preference.FieldSubtitles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field subtitles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Subtitles); err != nil {
+					return fmt.Errorf("unmarshal field subtitles: %w", err)
+				}
+			}
+		case preference.FieldDocument:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field document", values[i])
+			} else if value.Valid {
+				_m.Document = value.Bool
+			}
+		case preference.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = value.String
+			}
+		case preference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Preference.
+// This includes values selected through modifiers, order, etc.
+func (_m *Preference) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Preference.
+// Note that you need to call Preference.Unwrap() before calling this method if this Preference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Preference) Update() *PreferenceUpdateOne {
+	return NewPreferenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Preference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Preference) Unwrap() *Preference {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Preference is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Preference) String() string {
+	var builder strings.Builder
+	builder.WriteString("Preference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("peer_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeerType))
+	builder.WriteString(", ")
+	builder.WriteString("peer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeerID))
+	builder.WriteString(", ")
+	builder.WriteString("caption=")
+	builder.WriteString(_m.Caption)
+	builder.WriteString(", ")
+	builder.WriteString("originals=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Originals))
+	builder.WriteString(", ")
+	builder.WriteString("auto_fetch=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoFetch))
+	builder.WriteString(", ")
+	builder.WriteString("quality=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quality))
+	builder.WriteString(", ")
+	builder.WriteString("codec=")
+	builder.WriteString(_m.Codec)
+	builder.WriteString(", ")
+	builder.WriteString("default_format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultFormat))
+	builder.WriteString(", ")
+	builder.WriteString("subtitles=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Subtitles))
+	builder.WriteString(", ")
+	builder.WriteString("document=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Document))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(_m.Language)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Preferences is a parsable slice of Preference.
+type Preferences []*Preference
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package preference
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the preference type in the database.
+	Label = "preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPeerType holds the string denoting the peer_type field in the database.
+	FieldPeerType = "peer_type"
+	// FieldPeerID holds the string denoting the peer_id field in the database.
+	FieldPeerID = "peer_id"
+	// FieldCaption holds the string denoting the caption field in the database.
+	FieldCaption = "caption"
+	// FieldOriginals holds the string denoting the originals field in the database.
+	FieldOriginals = "originals"
+	// FieldAutoFetch holds the string denoting the auto_fetch field in the database.
+	FieldAutoFetch = "auto_fetch"
+	// FieldQuality holds the string denoting the quality field in the database.
+	FieldQuality = "quality"
+	// FieldCodec holds the string denoting the codec field in the database.
+	FieldCodec = "codec"
+	// FieldDefaultFormat holds the string denoting the default_format field in the database.
+	FieldDefaultFormat = "default_format"
+	// FieldSubtitles holds the string denoting the subtitles field in the database.
+	FieldSubtitles = "subtitles"
+	// FieldDocument holds the string denoting the document field in the database.
+	FieldDocument = "document"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the preference in the database.
+	Table = "preferences"
+)
+
+// Columns holds all SQL columns for preference fields.
+var Columns = []string{
+	FieldID,
+	FieldPeerType,
+	FieldPeerID,
+	FieldCaption,
+	FieldOriginals,
+	FieldAutoFetch,
+	FieldQuality,
+	FieldCodec,
+	FieldDefaultFormat,
+	FieldSubtitles,
+	FieldDocument,
+	FieldLanguage,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultOriginals holds the default value on creation for the "originals" field.
+	DefaultOriginals bool
+	// DefaultAutoFetch holds the default value on creation for the "auto_fetch" field.
+	DefaultAutoFetch bool
+	// DefaultQuality holds the default value on creation for the "quality" field.
+	DefaultQuality int
+	// QualityValidator is a validator for the "quality" field. It is called by the builders before save.
+	QualityValidator func(int) error
+	// DefaultDocument holds the default value on creation for the "document" field.
+	DefaultDocument bool
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// PeerType defines the type for the "peer_type" enum field.
+type PeerType string
+
+// PeerType values.
+const (
+	PeerTypeUser    PeerType = "user"
+	PeerTypeChat    PeerType = "chat"
+	PeerTypeChannel PeerType = "channel"
+)
+
+func (pt PeerType) String() string {
+	return string(pt)
+}
+
+// PeerTypeValidator is a validator for the "peer_type" field enum values. It is called by the builders before save.
+func PeerTypeValidator(pt PeerType) error {
+	switch pt {
+	case PeerTypeUser, PeerTypeChat, PeerTypeChannel:
+		return nil
+	default:
+		return fmt.Errorf("preference: invalid enum value for peer_type field: %q", pt)
+	}
+}
+
+// DefaultFormat defines the type for the "default_format" enum field.
+type DefaultFormat string
+
+// DefaultFormatAsk is the default value of the DefaultFormat enum.
+const DefaultDefaultFormat = DefaultFormatAsk
+
+// DefaultFormat values.
+const (
+	DefaultFormatAsk   DefaultFormat = "ask"
+	DefaultFormatVideo DefaultFormat = "video"
+	DefaultFormatAudio DefaultFormat = "audio"
+)
+
+func (df DefaultFormat) String() string {
+	return string(df)
+}
+
+// DefaultFormatValidator is a validator for the "default_format" field enum values. It is called by the builders before save.
+func DefaultFormatValidator(df DefaultFormat) error {
+	switch df {
+	case DefaultFormatAsk, DefaultFormatVideo, DefaultFormatAudio:
+		return nil
+	default:
+		return fmt.Errorf("preference: invalid enum value for default_format field: %q", df)
+	}
+}
+
+// OrderOption defines the ordering options for the Preference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPeerType orders the results by the peer_type field.
+func ByPeerType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeerType, opts...).ToFunc()
+}
+
+// ByPeerID orders the results by the peer_id field.
+func ByPeerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeerID, opts...).ToFunc()
+}
+
+// ByCaption orders the results by the caption field.
+func ByCaption(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaption, opts...).ToFunc()
+}
+
+// ByOriginals orders the results by the originals field.
+func ByOriginals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginals, opts...).ToFunc()
+}
+
+// ByAutoFetch orders the results by the auto_fetch field.
+func ByAutoFetch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoFetch, opts...).ToFunc()
+}
+
+// ByQuality orders the results by the quality field.
+func ByQuality(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuality, opts...).ToFunc()
+}
+
+// ByCodec orders the results by the codec field.
+func ByCodec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodec, opts...).ToFunc()
+}
+
+// ByDefaultFormat orders the results by the default_format field.
+func ByDefaultFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultFormat, opts...).ToFunc()
+}
+
+// ByDocument orders the results by the document field.
+func ByDocument(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocument, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package preference
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ernado/tentacle/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldID, id))
+}
+
+// PeerID applies equality check predicate on the "peer_id" field. It's identical to PeerIDEQ.
+func PeerID(v int64) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldPeerID, v))
+}
+
+// Caption applies equality check predicate on the "caption" field. It's identical to CaptionEQ.
+func Caption(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldCaption, v))
+}
+
+// Originals applies equality check predicate on the "originals" field. It's identical to OriginalsEQ.
+func Originals(v bool) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldOriginals, v))
+}
+
+// AutoFetch applies equality check predicate on the "auto_fetch" field. It's identical to AutoFetchEQ.
+func AutoFetch(v bool) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldAutoFetch, v))
+}
+
+// Quality applies equality check predicate on the "quality" field. It's identical to QualityEQ.
+func Quality(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldQuality, v))
+}
+
+// Codec applies equality check predicate on the "codec" field. It's identical to CodecEQ.
+func Codec(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldCodec, v))
+}
+
+// Document applies equality check predicate on the "document" field. It's identical to DocumentEQ.
+func Document(v bool) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldDocument, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldLanguage, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PeerTypeEQ applies the EQ predicate on the "peer_type" field.
+func PeerTypeEQ(v PeerType) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldPeerType, v))
+}
+
+// PeerTypeNEQ applies the NEQ predicate on the "peer_type" field.
+func PeerTypeNEQ(v PeerType) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldPeerType, v))
+}
+
+// PeerTypeIn applies the In predicate on the "peer_type" field.
+func PeerTypeIn(vs ...PeerType) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldPeerType, vs...))
+}
+
+// PeerTypeNotIn applies the NotIn predicate on the "peer_type" field.
+func PeerTypeNotIn(vs ...PeerType) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldPeerType, vs...))
+}
+
+// PeerIDEQ applies the EQ predicate on the "peer_id" field.
+func PeerIDEQ(v int64) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldPeerID, v))
+}
+
+// PeerIDNEQ applies the NEQ predicate on the "peer_id" field.
+func PeerIDNEQ(v int64) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldPeerID, v))
+}
+
+// PeerIDIn applies the In predicate on the "peer_id" field.
+func PeerIDIn(vs ...int64) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldPeerID, vs...))
+}
+
+// PeerIDNotIn applies the NotIn predicate on the "peer_id" field.
+func PeerIDNotIn(vs ...int64) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldPeerID, vs...))
+}
+
+// PeerIDGT applies the GT predicate on the "peer_id" field.
+func PeerIDGT(v int64) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldPeerID, v))
+}
+
+// PeerIDGTE applies the GTE predicate on the "peer_id" field.
+func PeerIDGTE(v int64) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldPeerID, v))
+}
+
+// PeerIDLT applies the LT predicate on the "peer_id" field.
+func PeerIDLT(v int64) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldPeerID, v))
+}
+
+// PeerIDLTE applies the LTE predicate on the "peer_id" field.
+func PeerIDLTE(v int64) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldPeerID, v))
+}
+
+// CaptionEQ applies the EQ predicate on the "caption" field.
+func CaptionEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldCaption, v))
+}
+
+// CaptionNEQ applies the NEQ predicate on the "caption" field.
+func CaptionNEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldCaption, v))
+}
+
+// CaptionIn applies the In predicate on the "caption" field.
+func CaptionIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldCaption, vs...))
+}
+
+// CaptionNotIn applies the NotIn predicate on the "caption" field.
+func CaptionNotIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldCaption, vs...))
+}
+
+// CaptionGT applies the GT predicate on the "caption" field.
+func CaptionGT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldCaption, v))
+}
+
+// CaptionGTE applies the GTE predicate on the "caption" field.
+func CaptionGTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldCaption, v))
+}
+
+// CaptionLT applies the LT predicate on the "caption" field.
+func CaptionLT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldCaption, v))
+}
+
+// CaptionLTE applies the LTE predicate on the "caption" field.
+func CaptionLTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldCaption, v))
+}
+
+// CaptionContains applies the Contains predicate on the "caption" field.
+func CaptionContains(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContains(FieldCaption, v))
+}
+
+// CaptionHasPrefix applies the HasPrefix predicate on the "caption" field.
+func CaptionHasPrefix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasPrefix(FieldCaption, v))
+}
+
+// CaptionHasSuffix applies the HasSuffix predicate on the "caption" field.
+func CaptionHasSuffix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasSuffix(FieldCaption, v))
+}
+
+// CaptionIsNil applies the IsNil predicate on the "caption" field.
+func CaptionIsNil() predicate.Preference {
+	return predicate.Preference(sql.FieldIsNull(FieldCaption))
+}
+
+// CaptionNotNil applies the NotNil predicate on the "caption" field.
+func CaptionNotNil() predicate.Preference {
+	return predicate.Preference(sql.FieldNotNull(FieldCaption))
+}
+
+// CaptionEqualFold applies the EqualFold predicate on the "caption" field.
+func CaptionEqualFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEqualFold(FieldCaption, v))
+}
+
+// CaptionContainsFold applies the ContainsFold predicate on the "caption" field.
+func CaptionContainsFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContainsFold(FieldCaption, v))
+}
+
+// OriginalsEQ applies the EQ predicate on the "originals" field.
+func OriginalsEQ(v bool) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldOriginals, v))
+}
+
+// OriginalsNEQ applies the NEQ predicate on the "originals" field.
+func OriginalsNEQ(v bool) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldOriginals, v))
+}
+
+// AutoFetchEQ applies the EQ predicate on the "auto_fetch" field.
+func AutoFetchEQ(v bool) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldAutoFetch, v))
+}
+
+// AutoFetchNEQ applies the NEQ predicate on the "auto_fetch" field.
+func AutoFetchNEQ(v bool) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldAutoFetch, v))
+}
+
+// QualityEQ applies the EQ predicate on the "quality" field.
+func QualityEQ(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldQuality, v))
+}
+
+// QualityNEQ applies the NEQ predicate on the "quality" field.
+func QualityNEQ(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldQuality, v))
+}
+
+// QualityIn applies the In predicate on the "quality" field.
+func QualityIn(vs ...int) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldQuality, vs...))
+}
+
+// QualityNotIn applies the NotIn predicate on the "quality" field.
+func QualityNotIn(vs ...int) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldQuality, vs...))
+}
+
+// QualityGT applies the GT predicate on the "quality" field.
+func QualityGT(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldQuality, v))
+}
+
+// QualityGTE applies the GTE predicate on the "quality" field.
+func QualityGTE(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldQuality, v))
+}
+
+// QualityLT applies the LT predicate on the "quality" field.
+func QualityLT(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldQuality, v))
+}
+
+// QualityLTE applies the LTE predicate on the "quality" field.
+func QualityLTE(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldQuality, v))
+}
+
+// CodecEQ applies the EQ predicate on the "codec" field.
+func CodecEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldCodec, v))
+}
+
+// CodecNEQ applies the NEQ predicate on the "codec" field.
+func CodecNEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldCodec, v))
+}
+
+// CodecIn applies the In predicate on the "codec" field.
+func CodecIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldCodec, vs...))
+}
+
+// CodecNotIn applies the NotIn predicate on the "codec" field.
+func CodecNotIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldCodec, vs...))
+}
+
+// CodecGT applies the GT predicate on the "codec" field.
+func CodecGT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldCodec, v))
+}
+
+// CodecGTE applies the GTE predicate on the "codec" field.
+func CodecGTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldCodec, v))
+}
+
+// CodecLT applies the LT predicate on the "codec" field.
+func CodecLT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldCodec, v))
+}
+
+// CodecLTE applies the LTE predicate on the "codec" field.
+func CodecLTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldCodec, v))
+}
+
+// CodecContains applies the Contains predicate on the "codec" field.
+func CodecContains(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContains(FieldCodec, v))
+}
+
+// CodecHasPrefix applies the HasPrefix predicate on the "codec" field.
+func CodecHasPrefix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasPrefix(FieldCodec, v))
+}
+
+// CodecHasSuffix applies the HasSuffix predicate on the "codec" field.
+func CodecHasSuffix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasSuffix(FieldCodec, v))
+}
+
+// CodecIsNil applies the IsNil predicate on the "codec" field.
+func CodecIsNil() predicate.Preference {
+	return predicate.Preference(sql.FieldIsNull(FieldCodec))
+}
+
+// CodecNotNil applies the NotNil predicate on the "codec" field.
+func CodecNotNil() predicate.Preference {
+	return predicate.Preference(sql.FieldNotNull(FieldCodec))
+}
+
+// CodecEqualFold applies the EqualFold predicate on the "codec" field.
+func CodecEqualFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEqualFold(FieldCodec, v))
+}
+
+// CodecContainsFold applies the ContainsFold predicate on the "codec" field.
+func CodecContainsFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContainsFold(FieldCodec, v))
+}
+
+// DefaultFormatEQ applies the EQ predicate on the "default_format" field.
+func DefaultFormatEQ(v DefaultFormat) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldDefaultFormat, v))
+}
+
+// DefaultFormatNEQ applies the NEQ predicate on the "default_format" field.
+func DefaultFormatNEQ(v DefaultFormat) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldDefaultFormat, v))
+}
+
+// DefaultFormatIn applies the In predicate on the "default_format" field.
+func DefaultFormatIn(vs ...DefaultFormat) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldDefaultFormat, vs...))
+}
+
+// DefaultFormatNotIn applies the NotIn predicate on the "default_format" field.
+func DefaultFormatNotIn(vs ...DefaultFormat) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldDefaultFormat, vs...))
+}
+
+// SubtitlesIsNil applies the IsNil predicate on the "subtitles" field.
+func SubtitlesIsNil() predicate.Preference {
+	return predicate.Preference(sql.FieldIsNull(FieldSubtitles))
+}
+
+// SubtitlesNotNil applies the NotNil predicate on the "subtitles" field.
+func SubtitlesNotNil() predicate.Preference {
+	return predicate.Preference(sql.FieldNotNull(FieldSubtitles))
+}
+
+// DocumentEQ applies the EQ predicate on the "document" field.
+func DocumentEQ(v bool) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldDocument, v))
+}
+
+// DocumentNEQ applies the NEQ predicate on the "document" field.
+func DocumentNEQ(v bool) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldDocument, v))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.Preference {
+	return predicate.Preference(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.Preference {
+	return predicate.Preference(sql.FieldNotNull(FieldLanguage))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Preference) predicate.Preference {
+	return predicate.Preference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Preference) predicate.Preference {
+	return predicate.Preference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Preference) predicate.Preference {
+	return predicate.Preference(sql.NotPredicates(p))
+}
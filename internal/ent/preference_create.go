@@ -0,0 +1,1263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/preference"
+)
+
+// PreferenceCreate is the builder for creating a Preference entity.
+type PreferenceCreate struct {
+	config
+	mutation *PreferenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_c *PreferenceCreate) SetPeerType(v preference.PeerType) *PreferenceCreate {
+	_c.mutation.SetPeerType(v)
+	return _c
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_c *PreferenceCreate) SetPeerID(v int64) *PreferenceCreate {
+	_c.mutation.SetPeerID(v)
+	return _c
+}
+
+// SetCaption sets the "caption" field.
+func (_c *PreferenceCreate) SetCaption(v string) *PreferenceCreate {
+	_c.mutation.SetCaption(v)
+	return _c
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (_c *PreferenceCreate) SetNillableCaption(v *string) *PreferenceCreate {
+	if v != nil {
+		_c.SetCaption(*v)
+	}
+	return _c
+}
+
+// SetOriginals sets the "originals" field.
+func (_c *PreferenceCreate) SetOriginals(v bool) *PreferenceCreate {
+	_c.mutation.SetOriginals(v)
+	return _c
+}
+
+// SetNillableOriginals sets the "originals" field if the given value is not nil.
+func (_c *PreferenceCreate) SetNillableOriginals(v *bool) *PreferenceCreate {
+	if v != nil {
+		_c.SetOriginals(*v)
+	}
+	return _c
+}
+
+// SetAutoFetch sets the "auto_fetch" field.
+func (_c *PreferenceCreate) SetAutoFetch(v bool) *PreferenceCreate {
+	_c.mutation.SetAutoFetch(v)
+	return _c
+}
+
+// SetNillableAutoFetch sets the "auto_fetch" field if the given value is not nil.
+func (_c *PreferenceCreate) SetNillableAutoFetch(v *bool) *PreferenceCreate {
+	if v != nil {
+		_c.SetAutoFetch(*v)
+	}
+	return _c
+}
+
+// SetQuality sets the "quality" field.
+func (_c *PreferenceCreate) SetQuality(v int) *PreferenceCreate {
+	_c.mutation.SetQuality(v)
+	return _c
+}
+
+// SetNillableQuality sets the "quality" field if the given value is not nil.
+func (_c *PreferenceCreate) SetNillableQuality(v *int) *PreferenceCreate {
+	if v != nil {
+		_c.SetQuality(*v)
+	}
+	return _c
+}
+
+// SetCodec sets the "codec" field.
+func (_c *PreferenceCreate) SetCodec(v string) *PreferenceCreate {
+	_c.mutation.SetCodec(v)
+	return _c
+}
+
+// SetNillableCodec sets the "codec" field if the given value is not nil.
+func (_c *PreferenceCreate) SetNillableCodec(v *string) *PreferenceCreate {
+	if v != nil {
+		_c.SetCodec(*v)
+	}
+	return _c
+}
+
+// SetDefaultFormat sets the "default_format" field.
+func (_c *PreferenceCreate) SetDefaultFormat(v preference.DefaultFormat) *PreferenceCreate {
+	_c.mutation.SetDefaultFormat(v)
+	return _c
+}
+
+// SetNillableDefaultFormat sets the "default_format" field if the given value is not nil.
+func (_c *PreferenceCreate) SetNillableDefaultFormat(v *preference.DefaultFormat) *PreferenceCreate {
+	if v != nil {
+		_c.SetDefaultFormat(*v)
+	}
+	return _c
+}
+
+// SetSubtitles sets the "subtitles" field.
+func (_c *PreferenceCreate) SetSubtitles(v []string) *PreferenceCreate {
+	_c.mutation.SetSubtitles(v)
+	return _c
+}
+
+// SetDocument sets the "document" field.
+func (_c *PreferenceCreate) SetDocument(v bool) *PreferenceCreate {
+	_c.mutation.SetDocument(v)
+	return _c
+}
+
+// SetNillableDocument sets the "document" field if the given value is not nil.
+func (_c *PreferenceCreate) SetNillableDocument(v *bool) *PreferenceCreate {
+	if v != nil {
+		_c.SetDocument(*v)
+	}
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *PreferenceCreate) SetLanguage(v string) *PreferenceCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_c *PreferenceCreate) SetNillableLanguage(v *string) *PreferenceCreate {
+	if v != nil {
+		_c.SetLanguage(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PreferenceCreate) SetUpdatedAt(v time.Time) *PreferenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PreferenceCreate) SetNillableUpdatedAt(v *time.Time) *PreferenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PreferenceMutation object of the builder.
+func (_c *PreferenceCreate) Mutation() *PreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the Preference in the database.
+func (_c *PreferenceCreate) Save(ctx context.Context) (*Preference, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PreferenceCreate) SaveX(ctx context.Context) *Preference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PreferenceCreate) defaults() {
+	if _, ok := _c.mutation.Originals(); !ok {
+		v := preference.DefaultOriginals
+		_c.mutation.SetOriginals(v)
+	}
+	if _, ok := _c.mutation.AutoFetch(); !ok {
+		v := preference.DefaultAutoFetch
+		_c.mutation.SetAutoFetch(v)
+	}
+	if _, ok := _c.mutation.Quality(); !ok {
+		v := preference.DefaultQuality
+		_c.mutation.SetQuality(v)
+	}
+	if _, ok := _c.mutation.DefaultFormat(); !ok {
+		v := preference.DefaultDefaultFormat
+		_c.mutation.SetDefaultFormat(v)
+	}
+	if _, ok := _c.mutation.Document(); !ok {
+		v := preference.DefaultDocument
+		_c.mutation.SetDocument(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := preference.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PreferenceCreate) check() error {
+	if _, ok := _c.mutation.PeerType(); !ok {
+		return &ValidationError{Name: "peer_type", err: errors.New(`ent: missing required field "Preference.peer_type"`)}
+	}
+	if v, ok := _c.mutation.PeerType(); ok {
+		if err := preference.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Preference.peer_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PeerID(); !ok {
+		return &ValidationError{Name: "peer_id", err: errors.New(`ent: missing required field "Preference.peer_id"`)}
+	}
+	if _, ok := _c.mutation.Originals(); !ok {
+		return &ValidationError{Name: "originals", err: errors.New(`ent: missing required field "Preference.originals"`)}
+	}
+	if _, ok := _c.mutation.AutoFetch(); !ok {
+		return &ValidationError{Name: "auto_fetch", err: errors.New(`ent: missing required field "Preference.auto_fetch"`)}
+	}
+	if _, ok := _c.mutation.Quality(); !ok {
+		return &ValidationError{Name: "quality", err: errors.New(`ent: missing required field "Preference.quality"`)}
+	}
+	if v, ok := _c.mutation.Quality(); ok {
+		if err := preference.QualityValidator(v); err != nil {
+			return &ValidationError{Name: "quality", err: fmt.Errorf(`ent: validator failed for field "Preference.quality": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DefaultFormat(); !ok {
+		return &ValidationError{Name: "default_format", err: errors.New(`ent: missing required field "Preference.default_format"`)}
+	}
+	if v, ok := _c.mutation.DefaultFormat(); ok {
+		if err := preference.DefaultFormatValidator(v); err != nil {
+			return &ValidationError{Name: "default_format", err: fmt.Errorf(`ent: validator failed for field "Preference.default_format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Document(); !ok {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required field "Preference.document"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Preference.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *PreferenceCreate) sqlSave(ctx context.Context) (*Preference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PreferenceCreate) createSpec() (*Preference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Preference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(preference.Table, sqlgraph.NewFieldSpec(preference.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.PeerType(); ok {
+		_spec.SetField(preference.FieldPeerType, field.TypeEnum, value)
+		_node.PeerType = value
+	}
+	if value, ok := _c.mutation.PeerID(); ok {
+		_spec.SetField(preference.FieldPeerID, field.TypeInt64, value)
+		_node.PeerID = value
+	}
+	if value, ok := _c.mutation.Caption(); ok {
+		_spec.SetField(preference.FieldCaption, field.TypeString, value)
+		_node.Caption = value
+	}
+	if value, ok := _c.mutation.Originals(); ok {
+		_spec.SetField(preference.FieldOriginals, field.TypeBool, value)
+		_node.Originals = value
+	}
+	if value, ok := _c.mutation.AutoFetch(); ok {
+		_spec.SetField(preference.FieldAutoFetch, field.TypeBool, value)
+		_node.AutoFetch = value
+	}
+	if value, ok := _c.mutation.Quality(); ok {
+		_spec.SetField(preference.FieldQuality, field.TypeInt, value)
+		_node.Quality = value
+	}
+	if value, ok := _c.mutation.Codec(); ok {
+		_spec.SetField(preference.FieldCodec, field.TypeString, value)
+		_node.Codec = value
+	}
+	if value, ok := _c.mutation.DefaultFormat(); ok {
+		_spec.SetField(preference.FieldDefaultFormat, field.TypeEnum, value)
+		_node.DefaultFormat = value
+	}
+	if value, ok := _c.mutation.Subtitles(); ok {
+		_spec.SetField(preference.FieldSubtitles, field.TypeJSON, value)
+		_node.Subtitles = value
+	}
+	if value, ok := _c.mutation.Document(); ok {
+		_spec.SetField(preference.FieldDocument, field.TypeBool, value)
+		_node.Document = value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(preference.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(preference.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Preference.Create().
+//		SetPeerType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PreferenceUpsert) {
+//			SetPeerType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PreferenceCreate) OnConflict(opts ...sql.ConflictOption) *PreferenceUpsertOne {
+	_c.conflict = opts
+	return &PreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Preference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PreferenceCreate) OnConflictColumns(columns ...string) *PreferenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PreferenceUpsertOne is the builder for "upsert"-ing
+	//  one Preference node.
+	PreferenceUpsertOne struct {
+		create *PreferenceCreate
+	}
+
+	// PreferenceUpsert is the "OnConflict" setter.
+	PreferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPeerType sets the "peer_type" field.
+func (u *PreferenceUpsert) SetPeerType(v preference.PeerType) *PreferenceUpsert {
+	u.Set(preference.FieldPeerType, v)
+	return u
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdatePeerType() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldPeerType)
+	return u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *PreferenceUpsert) SetPeerID(v int64) *PreferenceUpsert {
+	u.Set(preference.FieldPeerID, v)
+	return u
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdatePeerID() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldPeerID)
+	return u
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *PreferenceUpsert) AddPeerID(v int64) *PreferenceUpsert {
+	u.Add(preference.FieldPeerID, v)
+	return u
+}
+
+// SetCaption sets the "caption" field.
+func (u *PreferenceUpsert) SetCaption(v string) *PreferenceUpsert {
+	u.Set(preference.FieldCaption, v)
+	return u
+}
+
+// UpdateCaption sets the "caption" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateCaption() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldCaption)
+	return u
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (u *PreferenceUpsert) ClearCaption() *PreferenceUpsert {
+	u.SetNull(preference.FieldCaption)
+	return u
+}
+
+// SetOriginals sets the "originals" field.
+func (u *PreferenceUpsert) SetOriginals(v bool) *PreferenceUpsert {
+	u.Set(preference.FieldOriginals, v)
+	return u
+}
+
+// UpdateOriginals sets the "originals" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateOriginals() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldOriginals)
+	return u
+}
+
+// SetAutoFetch sets the "auto_fetch" field.
+func (u *PreferenceUpsert) SetAutoFetch(v bool) *PreferenceUpsert {
+	u.Set(preference.FieldAutoFetch, v)
+	return u
+}
+
+// UpdateAutoFetch sets the "auto_fetch" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateAutoFetch() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldAutoFetch)
+	return u
+}
+
+// SetQuality sets the "quality" field.
+func (u *PreferenceUpsert) SetQuality(v int) *PreferenceUpsert {
+	u.Set(preference.FieldQuality, v)
+	return u
+}
+
+// UpdateQuality sets the "quality" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateQuality() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldQuality)
+	return u
+}
+
+// AddQuality adds v to the "quality" field.
+func (u *PreferenceUpsert) AddQuality(v int) *PreferenceUpsert {
+	u.Add(preference.FieldQuality, v)
+	return u
+}
+
+// SetCodec sets the "codec" field.
+func (u *PreferenceUpsert) SetCodec(v string) *PreferenceUpsert {
+	u.Set(preference.FieldCodec, v)
+	return u
+}
+
+// UpdateCodec sets the "codec" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateCodec() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldCodec)
+	return u
+}
+
+// ClearCodec clears the value of the "codec" field.
+func (u *PreferenceUpsert) ClearCodec() *PreferenceUpsert {
+	u.SetNull(preference.FieldCodec)
+	return u
+}
+
+// SetDefaultFormat sets the "default_format" field.
+func (u *PreferenceUpsert) SetDefaultFormat(v preference.DefaultFormat) *PreferenceUpsert {
+	u.Set(preference.FieldDefaultFormat, v)
+	return u
+}
+
+// UpdateDefaultFormat sets the "default_format" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateDefaultFormat() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldDefaultFormat)
+	return u
+}
+
+// SetSubtitles sets the "subtitles" field.
+func (u *PreferenceUpsert) SetSubtitles(v []string) *PreferenceUpsert {
+	u.Set(preference.FieldSubtitles, v)
+	return u
+}
+
+// UpdateSubtitles sets the "subtitles" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateSubtitles() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldSubtitles)
+	return u
+}
+
+// ClearSubtitles clears the value of the "subtitles" field.
+func (u *PreferenceUpsert) ClearSubtitles() *PreferenceUpsert {
+	u.SetNull(preference.FieldSubtitles)
+	return u
+}
+
+// SetDocument sets the "document" field.
+func (u *PreferenceUpsert) SetDocument(v bool) *PreferenceUpsert {
+	u.Set(preference.FieldDocument, v)
+	return u
+}
+
+// UpdateDocument sets the "document" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateDocument() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldDocument)
+	return u
+}
+
+// SetLanguage sets the "language" field.
+func (u *PreferenceUpsert) SetLanguage(v string) *PreferenceUpsert {
+	u.Set(preference.FieldLanguage, v)
+	return u
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateLanguage() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldLanguage)
+	return u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (u *PreferenceUpsert) ClearLanguage() *PreferenceUpsert {
+	u.SetNull(preference.FieldLanguage)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PreferenceUpsert) SetUpdatedAt(v time.Time) *PreferenceUpsert {
+	u.Set(preference.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PreferenceUpsert) UpdateUpdatedAt() *PreferenceUpsert {
+	u.SetExcluded(preference.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Preference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PreferenceUpsertOne) UpdateNewValues() *PreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Preference.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PreferenceUpsertOne) Ignore() *PreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PreferenceUpsertOne) DoNothing() *PreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PreferenceCreate.OnConflict
+// documentation for more info.
+func (u *PreferenceUpsertOne) Update(set func(*PreferenceUpsert)) *PreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (u *PreferenceUpsertOne) SetPeerType(v preference.PeerType) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetPeerType(v)
+	})
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdatePeerType() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdatePeerType()
+	})
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *PreferenceUpsertOne) SetPeerID(v int64) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetPeerID(v)
+	})
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *PreferenceUpsertOne) AddPeerID(v int64) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.AddPeerID(v)
+	})
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdatePeerID() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdatePeerID()
+	})
+}
+
+// SetCaption sets the "caption" field.
+func (u *PreferenceUpsertOne) SetCaption(v string) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetCaption(v)
+	})
+}
+
+// UpdateCaption sets the "caption" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateCaption() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateCaption()
+	})
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (u *PreferenceUpsertOne) ClearCaption() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.ClearCaption()
+	})
+}
+
+// SetOriginals sets the "originals" field.
+func (u *PreferenceUpsertOne) SetOriginals(v bool) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetOriginals(v)
+	})
+}
+
+// UpdateOriginals sets the "originals" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateOriginals() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateOriginals()
+	})
+}
+
+// SetAutoFetch sets the "auto_fetch" field.
+func (u *PreferenceUpsertOne) SetAutoFetch(v bool) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetAutoFetch(v)
+	})
+}
+
+// UpdateAutoFetch sets the "auto_fetch" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateAutoFetch() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateAutoFetch()
+	})
+}
+
+// SetQuality sets the "quality" field.
+func (u *PreferenceUpsertOne) SetQuality(v int) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetQuality(v)
+	})
+}
+
+// AddQuality adds v to the "quality" field.
+func (u *PreferenceUpsertOne) AddQuality(v int) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.AddQuality(v)
+	})
+}
+
+// UpdateQuality sets the "quality" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateQuality() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateQuality()
+	})
+}
+
+// SetCodec sets the "codec" field.
+func (u *PreferenceUpsertOne) SetCodec(v string) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetCodec(v)
+	})
+}
+
+// UpdateCodec sets the "codec" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateCodec() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateCodec()
+	})
+}
+
+// ClearCodec clears the value of the "codec" field.
+func (u *PreferenceUpsertOne) ClearCodec() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.ClearCodec()
+	})
+}
+
+// SetDefaultFormat sets the "default_format" field.
+func (u *PreferenceUpsertOne) SetDefaultFormat(v preference.DefaultFormat) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetDefaultFormat(v)
+	})
+}
+
+// UpdateDefaultFormat sets the "default_format" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateDefaultFormat() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateDefaultFormat()
+	})
+}
+
+// SetSubtitles sets the "subtitles" field.
+func (u *PreferenceUpsertOne) SetSubtitles(v []string) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetSubtitles(v)
+	})
+}
+
+// UpdateSubtitles sets the "subtitles" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateSubtitles() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateSubtitles()
+	})
+}
+
+// ClearSubtitles clears the value of the "subtitles" field.
+func (u *PreferenceUpsertOne) ClearSubtitles() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.ClearSubtitles()
+	})
+}
+
+// SetDocument sets the "document" field.
+func (u *PreferenceUpsertOne) SetDocument(v bool) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetDocument(v)
+	})
+}
+
+// UpdateDocument sets the "document" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateDocument() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateDocument()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *PreferenceUpsertOne) SetLanguage(v string) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateLanguage() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (u *PreferenceUpsertOne) ClearLanguage() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.ClearLanguage()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PreferenceUpsertOne) SetUpdatedAt(v time.Time) *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PreferenceUpsertOne) UpdateUpdatedAt() *PreferenceUpsertOne {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PreferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PreferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PreferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PreferenceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PreferenceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PreferenceCreateBulk is the builder for creating many Preference entities in bulk.
+type PreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*PreferenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Preference entities in the database.
+func (_c *PreferenceCreateBulk) Save(ctx context.Context) ([]*Preference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Preference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PreferenceCreateBulk) SaveX(ctx context.Context) []*Preference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Preference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PreferenceUpsert) {
+//			SetPeerType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PreferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *PreferenceUpsertBulk {
+	_c.conflict = opts
+	return &PreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Preference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PreferenceCreateBulk) OnConflictColumns(columns ...string) *PreferenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// PreferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Preference nodes.
+type PreferenceUpsertBulk struct {
+	create *PreferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Preference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PreferenceUpsertBulk) UpdateNewValues() *PreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Preference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PreferenceUpsertBulk) Ignore() *PreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PreferenceUpsertBulk) DoNothing() *PreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PreferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *PreferenceUpsertBulk) Update(set func(*PreferenceUpsert)) *PreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (u *PreferenceUpsertBulk) SetPeerType(v preference.PeerType) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetPeerType(v)
+	})
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdatePeerType() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdatePeerType()
+	})
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *PreferenceUpsertBulk) SetPeerID(v int64) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetPeerID(v)
+	})
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *PreferenceUpsertBulk) AddPeerID(v int64) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.AddPeerID(v)
+	})
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdatePeerID() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdatePeerID()
+	})
+}
+
+// SetCaption sets the "caption" field.
+func (u *PreferenceUpsertBulk) SetCaption(v string) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetCaption(v)
+	})
+}
+
+// UpdateCaption sets the "caption" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateCaption() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateCaption()
+	})
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (u *PreferenceUpsertBulk) ClearCaption() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.ClearCaption()
+	})
+}
+
+// SetOriginals sets the "originals" field.
+func (u *PreferenceUpsertBulk) SetOriginals(v bool) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetOriginals(v)
+	})
+}
+
+// UpdateOriginals sets the "originals" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateOriginals() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateOriginals()
+	})
+}
+
+// SetAutoFetch sets the "auto_fetch" field.
+func (u *PreferenceUpsertBulk) SetAutoFetch(v bool) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetAutoFetch(v)
+	})
+}
+
+// UpdateAutoFetch sets the "auto_fetch" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateAutoFetch() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateAutoFetch()
+	})
+}
+
+// SetQuality sets the "quality" field.
+func (u *PreferenceUpsertBulk) SetQuality(v int) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetQuality(v)
+	})
+}
+
+// AddQuality adds v to the "quality" field.
+func (u *PreferenceUpsertBulk) AddQuality(v int) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.AddQuality(v)
+	})
+}
+
+// UpdateQuality sets the "quality" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateQuality() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateQuality()
+	})
+}
+
+// SetCodec sets the "codec" field.
+func (u *PreferenceUpsertBulk) SetCodec(v string) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetCodec(v)
+	})
+}
+
+// UpdateCodec sets the "codec" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateCodec() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateCodec()
+	})
+}
+
+// ClearCodec clears the value of the "codec" field.
+func (u *PreferenceUpsertBulk) ClearCodec() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.ClearCodec()
+	})
+}
+
+// SetDefaultFormat sets the "default_format" field.
+func (u *PreferenceUpsertBulk) SetDefaultFormat(v preference.DefaultFormat) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetDefaultFormat(v)
+	})
+}
+
+// UpdateDefaultFormat sets the "default_format" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateDefaultFormat() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateDefaultFormat()
+	})
+}
+
+// SetSubtitles sets the "subtitles" field.
+func (u *PreferenceUpsertBulk) SetSubtitles(v []string) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetSubtitles(v)
+	})
+}
+
+// UpdateSubtitles sets the "subtitles" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateSubtitles() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateSubtitles()
+	})
+}
+
+// ClearSubtitles clears the value of the "subtitles" field.
+func (u *PreferenceUpsertBulk) ClearSubtitles() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.ClearSubtitles()
+	})
+}
+
+// SetDocument sets the "document" field.
+func (u *PreferenceUpsertBulk) SetDocument(v bool) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetDocument(v)
+	})
+}
+
+// UpdateDocument sets the "document" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateDocument() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateDocument()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *PreferenceUpsertBulk) SetLanguage(v string) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateLanguage() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (u *PreferenceUpsertBulk) ClearLanguage() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.ClearLanguage()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PreferenceUpsertBulk) SetUpdatedAt(v time.Time) *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PreferenceUpsertBulk) UpdateUpdatedAt() *PreferenceUpsertBulk {
+	return u.Update(func(s *PreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PreferenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PreferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PreferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PreferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,751 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/predicate"
+	"github.com/ernado/tentacle/internal/ent/preference"
+)
+
+// PreferenceUpdate is the builder for updating Preference entities.
+type PreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PreferenceMutation
+}
+
+// Where appends a list predicates to the PreferenceUpdate builder.
+func (_u *PreferenceUpdate) Where(ps ...predicate.Preference) *PreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_u *PreferenceUpdate) SetPeerType(v preference.PeerType) *PreferenceUpdate {
+	_u.mutation.SetPeerType(v)
+	return _u
+}
+
+// SetNillablePeerType sets the "peer_type" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillablePeerType(v *preference.PeerType) *PreferenceUpdate {
+	if v != nil {
+		_u.SetPeerType(*v)
+	}
+	return _u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_u *PreferenceUpdate) SetPeerID(v int64) *PreferenceUpdate {
+	_u.mutation.ResetPeerID()
+	_u.mutation.SetPeerID(v)
+	return _u
+}
+
+// SetNillablePeerID sets the "peer_id" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillablePeerID(v *int64) *PreferenceUpdate {
+	if v != nil {
+		_u.SetPeerID(*v)
+	}
+	return _u
+}
+
+// AddPeerID adds value to the "peer_id" field.
+func (_u *PreferenceUpdate) AddPeerID(v int64) *PreferenceUpdate {
+	_u.mutation.AddPeerID(v)
+	return _u
+}
+
+// SetCaption sets the "caption" field.
+func (_u *PreferenceUpdate) SetCaption(v string) *PreferenceUpdate {
+	_u.mutation.SetCaption(v)
+	return _u
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableCaption(v *string) *PreferenceUpdate {
+	if v != nil {
+		_u.SetCaption(*v)
+	}
+	return _u
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (_u *PreferenceUpdate) ClearCaption() *PreferenceUpdate {
+	_u.mutation.ClearCaption()
+	return _u
+}
+
+// SetOriginals sets the "originals" field.
+func (_u *PreferenceUpdate) SetOriginals(v bool) *PreferenceUpdate {
+	_u.mutation.SetOriginals(v)
+	return _u
+}
+
+// SetNillableOriginals sets the "originals" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableOriginals(v *bool) *PreferenceUpdate {
+	if v != nil {
+		_u.SetOriginals(*v)
+	}
+	return _u
+}
+
+// SetAutoFetch sets the "auto_fetch" field.
+func (_u *PreferenceUpdate) SetAutoFetch(v bool) *PreferenceUpdate {
+	_u.mutation.SetAutoFetch(v)
+	return _u
+}
+
+// SetNillableAutoFetch sets the "auto_fetch" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableAutoFetch(v *bool) *PreferenceUpdate {
+	if v != nil {
+		_u.SetAutoFetch(*v)
+	}
+	return _u
+}
+
+// SetQuality sets the "quality" field.
+func (_u *PreferenceUpdate) SetQuality(v int) *PreferenceUpdate {
+	_u.mutation.ResetQuality()
+	_u.mutation.SetQuality(v)
+	return _u
+}
+
+// SetNillableQuality sets the "quality" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableQuality(v *int) *PreferenceUpdate {
+	if v != nil {
+		_u.SetQuality(*v)
+	}
+	return _u
+}
+
+// AddQuality adds value to the "quality" field.
+func (_u *PreferenceUpdate) AddQuality(v int) *PreferenceUpdate {
+	_u.mutation.AddQuality(v)
+	return _u
+}
+
+// SetCodec sets the "codec" field.
+func (_u *PreferenceUpdate) SetCodec(v string) *PreferenceUpdate {
+	_u.mutation.SetCodec(v)
+	return _u
+}
+
+// SetNillableCodec sets the "codec" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableCodec(v *string) *PreferenceUpdate {
+	if v != nil {
+		_u.SetCodec(*v)
+	}
+	return _u
+}
+
+// ClearCodec clears the value of the "codec" field.
+func (_u *PreferenceUpdate) ClearCodec() *PreferenceUpdate {
+	_u.mutation.ClearCodec()
+	return _u
+}
+
+// SetDefaultFormat sets the "default_format" field.
+func (_u *PreferenceUpdate) SetDefaultFormat(v preference.DefaultFormat) *PreferenceUpdate {
+	_u.mutation.SetDefaultFormat(v)
+	return _u
+}
+
+// SetNillableDefaultFormat sets the "default_format" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableDefaultFormat(v *preference.DefaultFormat) *PreferenceUpdate {
+	if v != nil {
+		_u.SetDefaultFormat(*v)
+	}
+	return _u
+}
+
+// SetSubtitles sets the "subtitles" field.
+func (_u *PreferenceUpdate) SetSubtitles(v []string) *PreferenceUpdate {
+	_u.mutation.SetSubtitles(v)
+	return _u
+}
+
+// AppendSubtitles appends value to the "subtitles" field.
+func (_u *PreferenceUpdate) AppendSubtitles(v []string) *PreferenceUpdate {
+	_u.mutation.AppendSubtitles(v)
+	return _u
+}
+
+// ClearSubtitles clears the value of the "subtitles" field.
+func (_u *PreferenceUpdate) ClearSubtitles() *PreferenceUpdate {
+	_u.mutation.ClearSubtitles()
+	return _u
+}
+
+// SetDocument sets the "document" field.
+func (_u *PreferenceUpdate) SetDocument(v bool) *PreferenceUpdate {
+	_u.mutation.SetDocument(v)
+	return _u
+}
+
+// SetNillableDocument sets the "document" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableDocument(v *bool) *PreferenceUpdate {
+	if v != nil {
+		_u.SetDocument(*v)
+	}
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *PreferenceUpdate) SetLanguage(v string) *PreferenceUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableLanguage(v *string) *PreferenceUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (_u *PreferenceUpdate) ClearLanguage() *PreferenceUpdate {
+	_u.mutation.ClearLanguage()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PreferenceUpdate) SetUpdatedAt(v time.Time) *PreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PreferenceMutation object of the builder.
+func (_u *PreferenceUpdate) Mutation() *PreferenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := preference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PreferenceUpdate) check() error {
+	if v, ok := _u.mutation.PeerType(); ok {
+		if err := preference.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Preference.peer_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quality(); ok {
+		if err := preference.QualityValidator(v); err != nil {
+			return &ValidationError{Name: "quality", err: fmt.Errorf(`ent: validator failed for field "Preference.quality": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DefaultFormat(); ok {
+		if err := preference.DefaultFormatValidator(v); err != nil {
+			return &ValidationError{Name: "default_format", err: fmt.Errorf(`ent: validator failed for field "Preference.default_format": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(preference.Table, preference.Columns, sqlgraph.NewFieldSpec(preference.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeerType(); ok {
+		_spec.SetField(preference.FieldPeerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerID(); ok {
+		_spec.SetField(preference.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPeerID(); ok {
+		_spec.AddField(preference.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Caption(); ok {
+		_spec.SetField(preference.FieldCaption, field.TypeString, value)
+	}
+	if _u.mutation.CaptionCleared() {
+		_spec.ClearField(preference.FieldCaption, field.TypeString)
+	}
+	if value, ok := _u.mutation.Originals(); ok {
+		_spec.SetField(preference.FieldOriginals, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoFetch(); ok {
+		_spec.SetField(preference.FieldAutoFetch, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Quality(); ok {
+		_spec.SetField(preference.FieldQuality, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuality(); ok {
+		_spec.AddField(preference.FieldQuality, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Codec(); ok {
+		_spec.SetField(preference.FieldCodec, field.TypeString, value)
+	}
+	if _u.mutation.CodecCleared() {
+		_spec.ClearField(preference.FieldCodec, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultFormat(); ok {
+		_spec.SetField(preference.FieldDefaultFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Subtitles(); ok {
+		_spec.SetField(preference.FieldSubtitles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSubtitles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, preference.FieldSubtitles, value)
+		})
+	}
+	if _u.mutation.SubtitlesCleared() {
+		_spec.ClearField(preference.FieldSubtitles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Document(); ok {
+		_spec.SetField(preference.FieldDocument, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(preference.FieldLanguage, field.TypeString, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(preference.FieldLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(preference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PreferenceUpdateOne is the builder for updating a single Preference entity.
+type PreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PreferenceMutation
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_u *PreferenceUpdateOne) SetPeerType(v preference.PeerType) *PreferenceUpdateOne {
+	_u.mutation.SetPeerType(v)
+	return _u
+}
+
+// SetNillablePeerType sets the "peer_type" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillablePeerType(v *preference.PeerType) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetPeerType(*v)
+	}
+	return _u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_u *PreferenceUpdateOne) SetPeerID(v int64) *PreferenceUpdateOne {
+	_u.mutation.ResetPeerID()
+	_u.mutation.SetPeerID(v)
+	return _u
+}
+
+// SetNillablePeerID sets the "peer_id" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillablePeerID(v *int64) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetPeerID(*v)
+	}
+	return _u
+}
+
+// AddPeerID adds value to the "peer_id" field.
+func (_u *PreferenceUpdateOne) AddPeerID(v int64) *PreferenceUpdateOne {
+	_u.mutation.AddPeerID(v)
+	return _u
+}
+
+// SetCaption sets the "caption" field.
+func (_u *PreferenceUpdateOne) SetCaption(v string) *PreferenceUpdateOne {
+	_u.mutation.SetCaption(v)
+	return _u
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableCaption(v *string) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetCaption(*v)
+	}
+	return _u
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (_u *PreferenceUpdateOne) ClearCaption() *PreferenceUpdateOne {
+	_u.mutation.ClearCaption()
+	return _u
+}
+
+// SetOriginals sets the "originals" field.
+func (_u *PreferenceUpdateOne) SetOriginals(v bool) *PreferenceUpdateOne {
+	_u.mutation.SetOriginals(v)
+	return _u
+}
+
+// SetNillableOriginals sets the "originals" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableOriginals(v *bool) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetOriginals(*v)
+	}
+	return _u
+}
+
+// SetAutoFetch sets the "auto_fetch" field.
+func (_u *PreferenceUpdateOne) SetAutoFetch(v bool) *PreferenceUpdateOne {
+	_u.mutation.SetAutoFetch(v)
+	return _u
+}
+
+// SetNillableAutoFetch sets the "auto_fetch" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableAutoFetch(v *bool) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetAutoFetch(*v)
+	}
+	return _u
+}
+
+// SetQuality sets the "quality" field.
+func (_u *PreferenceUpdateOne) SetQuality(v int) *PreferenceUpdateOne {
+	_u.mutation.ResetQuality()
+	_u.mutation.SetQuality(v)
+	return _u
+}
+
+// SetNillableQuality sets the "quality" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableQuality(v *int) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetQuality(*v)
+	}
+	return _u
+}
+
+// AddQuality adds value to the "quality" field.
+func (_u *PreferenceUpdateOne) AddQuality(v int) *PreferenceUpdateOne {
+	_u.mutation.AddQuality(v)
+	return _u
+}
+
+// SetCodec sets the "codec" field.
+func (_u *PreferenceUpdateOne) SetCodec(v string) *PreferenceUpdateOne {
+	_u.mutation.SetCodec(v)
+	return _u
+}
+
+// SetNillableCodec sets the "codec" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableCodec(v *string) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetCodec(*v)
+	}
+	return _u
+}
+
+// ClearCodec clears the value of the "codec" field.
+func (_u *PreferenceUpdateOne) ClearCodec() *PreferenceUpdateOne {
+	_u.mutation.ClearCodec()
+	return _u
+}
+
+// SetDefaultFormat sets the "default_format" field.
+func (_u *PreferenceUpdateOne) SetDefaultFormat(v preference.DefaultFormat) *PreferenceUpdateOne {
+	_u.mutation.SetDefaultFormat(v)
+	return _u
+}
+
+// SetNillableDefaultFormat sets the "default_format" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableDefaultFormat(v *preference.DefaultFormat) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetDefaultFormat(*v)
+	}
+	return _u
+}
+
+// SetSubtitles sets the "subtitles" field.
+func (_u *PreferenceUpdateOne) SetSubtitles(v []string) *PreferenceUpdateOne {
+	_u.mutation.SetSubtitles(v)
+	return _u
+}
+
+// AppendSubtitles appends value to the "subtitles" field.
+func (_u *PreferenceUpdateOne) AppendSubtitles(v []string) *PreferenceUpdateOne {
+	_u.mutation.AppendSubtitles(v)
+	return _u
+}
+
+// ClearSubtitles clears the value of the "subtitles" field.
+func (_u *PreferenceUpdateOne) ClearSubtitles() *PreferenceUpdateOne {
+	_u.mutation.ClearSubtitles()
+	return _u
+}
+
+// SetDocument sets the "document" field.
+func (_u *PreferenceUpdateOne) SetDocument(v bool) *PreferenceUpdateOne {
+	_u.mutation.SetDocument(v)
+	return _u
+}
+
+// SetNillableDocument sets the "document" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableDocument(v *bool) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetDocument(*v)
+	}
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *PreferenceUpdateOne) SetLanguage(v string) *PreferenceUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableLanguage(v *string) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (_u *PreferenceUpdateOne) ClearLanguage() *PreferenceUpdateOne {
+	_u.mutation.ClearLanguage()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PreferenceUpdateOne) SetUpdatedAt(v time.Time) *PreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PreferenceMutation object of the builder.
+func (_u *PreferenceUpdateOne) Mutation() *PreferenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PreferenceUpdate builder.
+func (_u *PreferenceUpdateOne) Where(ps ...predicate.Preference) *PreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PreferenceUpdateOne) Select(field string, fields ...string) *PreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Preference entity.
+func (_u *PreferenceUpdateOne) Save(ctx context.Context) (*Preference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PreferenceUpdateOne) SaveX(ctx context.Context) *Preference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := preference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.PeerType(); ok {
+		if err := preference.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Preference.peer_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quality(); ok {
+		if err := preference.QualityValidator(v); err != nil {
+			return &ValidationError{Name: "quality", err: fmt.Errorf(`ent: validator failed for field "Preference.quality": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DefaultFormat(); ok {
+		if err := preference.DefaultFormatValidator(v); err != nil {
+			return &ValidationError{Name: "default_format", err: fmt.Errorf(`ent: validator failed for field "Preference.default_format": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PreferenceUpdateOne) sqlSave(ctx context.Context) (_node *Preference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(preference.Table, preference.Columns, sqlgraph.NewFieldSpec(preference.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Preference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, preference.FieldID)
+		for _, f := range fields {
+			if !preference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != preference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeerType(); ok {
+		_spec.SetField(preference.FieldPeerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerID(); ok {
+		_spec.SetField(preference.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPeerID(); ok {
+		_spec.AddField(preference.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Caption(); ok {
+		_spec.SetField(preference.FieldCaption, field.TypeString, value)
+	}
+	if _u.mutation.CaptionCleared() {
+		_spec.ClearField(preference.FieldCaption, field.TypeString)
+	}
+	if value, ok := _u.mutation.Originals(); ok {
+		_spec.SetField(preference.FieldOriginals, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoFetch(); ok {
+		_spec.SetField(preference.FieldAutoFetch, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Quality(); ok {
+		_spec.SetField(preference.FieldQuality, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuality(); ok {
+		_spec.AddField(preference.FieldQuality, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Codec(); ok {
+		_spec.SetField(preference.FieldCodec, field.TypeString, value)
+	}
+	if _u.mutation.CodecCleared() {
+		_spec.ClearField(preference.FieldCodec, field.TypeString)
+	}
+	if value, ok := _u.mutation.DefaultFormat(); ok {
+		_spec.SetField(preference.FieldDefaultFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Subtitles(); ok {
+		_spec.SetField(preference.FieldSubtitles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSubtitles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, preference.FieldSubtitles, value)
+		})
+	}
+	if _u.mutation.SubtitlesCleared() {
+		_spec.ClearField(preference.FieldSubtitles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Document(); ok {
+		_spec.SetField(preference.FieldDocument, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(preference.FieldLanguage, field.TypeString, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(preference.FieldLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(preference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Preference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
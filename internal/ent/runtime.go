@@ -3,8 +3,17 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/ernado/tentacle/internal/access"
+	"github.com/ernado/tentacle/internal/ent/grant"
+	"github.com/ernado/tentacle/internal/ent/invite"
+	"github.com/ernado/tentacle/internal/ent/job"
+	"github.com/ernado/tentacle/internal/ent/preference"
+	"github.com/ernado/tentacle/internal/ent/role"
 	"github.com/ernado/tentacle/internal/ent/schema"
 	"github.com/ernado/tentacle/internal/ent/telegramblob"
+	"github.com/ernado/tentacle/internal/ent/usage"
 	"github.com/google/uuid"
 )
 
@@ -12,10 +21,114 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	grantFields := schema.Grant{}.Fields()
+	_ = grantFields
+	// grantDescCreatedAt is the schema descriptor for created_at field.
+	grantDescCreatedAt := grantFields[5].Descriptor()
+	// grant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	grant.DefaultCreatedAt = grantDescCreatedAt.Default.(func() time.Time)
+	inviteFields := schema.Invite{}.Fields()
+	_ = inviteFields
+	// inviteDescCode is the schema descriptor for code field.
+	inviteDescCode := inviteFields[0].Descriptor()
+	// invite.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	invite.CodeValidator = inviteDescCode.Validators[0].(func(string) error)
+	// inviteDescMaxUses is the schema descriptor for max_uses field.
+	inviteDescMaxUses := inviteFields[2].Descriptor()
+	// invite.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invite.DefaultMaxUses = inviteDescMaxUses.Default.(int)
+	// invite.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	invite.MaxUsesValidator = inviteDescMaxUses.Validators[0].(func(int) error)
+	// inviteDescUses is the schema descriptor for uses field.
+	inviteDescUses := inviteFields[3].Descriptor()
+	// invite.DefaultUses holds the default value on creation for the uses field.
+	invite.DefaultUses = inviteDescUses.Default.(int)
+	// invite.UsesValidator is a validator for the "uses" field. It is called by the builders before save.
+	invite.UsesValidator = inviteDescUses.Validators[0].(func(int) error)
+	// inviteDescCreatedAt is the schema descriptor for created_at field.
+	inviteDescCreatedAt := inviteFields[5].Descriptor()
+	// invite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invite.DefaultCreatedAt = inviteDescCreatedAt.Default.(func() time.Time)
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescAccessHash is the schema descriptor for access_hash field.
+	jobDescAccessHash := jobFields[4].Descriptor()
+	// job.DefaultAccessHash holds the default value on creation for the access_hash field.
+	job.DefaultAccessHash = jobDescAccessHash.Default.(int64)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[9].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[12].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobFields[13].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	preferenceFields := schema.Preference{}.Fields()
+	_ = preferenceFields
+	// preferenceDescOriginals is the schema descriptor for originals field.
+	preferenceDescOriginals := preferenceFields[3].Descriptor()
+	// preference.DefaultOriginals holds the default value on creation for the originals field.
+	preference.DefaultOriginals = preferenceDescOriginals.Default.(bool)
+	// preferenceDescAutoFetch is the schema descriptor for auto_fetch field.
+	preferenceDescAutoFetch := preferenceFields[4].Descriptor()
+	// preference.DefaultAutoFetch holds the default value on creation for the auto_fetch field.
+	preference.DefaultAutoFetch = preferenceDescAutoFetch.Default.(bool)
+	// preferenceDescQuality is the schema descriptor for quality field.
+	preferenceDescQuality := preferenceFields[5].Descriptor()
+	// preference.DefaultQuality holds the default value on creation for the quality field.
+	preference.DefaultQuality = preferenceDescQuality.Default.(int)
+	// preference.QualityValidator is a validator for the "quality" field. It is called by the builders before save.
+	preference.QualityValidator = preferenceDescQuality.Validators[0].(func(int) error)
+	// preferenceDescDocument is the schema descriptor for document field.
+	preferenceDescDocument := preferenceFields[9].Descriptor()
+	// preference.DefaultDocument holds the default value on creation for the document field.
+	preference.DefaultDocument = preferenceDescDocument.Default.(bool)
+	// preferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	preferenceDescUpdatedAt := preferenceFields[11].Descriptor()
+	// preference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	preference.DefaultUpdatedAt = preferenceDescUpdatedAt.Default.(func() time.Time)
+	// preference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	preference.UpdateDefaultUpdatedAt = preferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[0].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescAdmin is the schema descriptor for admin field.
+	roleDescAdmin := roleFields[1].Descriptor()
+	// role.DefaultAdmin holds the default value on creation for the admin field.
+	role.DefaultAdmin = roleDescAdmin.Default.(bool)
+	// roleDescLimits is the schema descriptor for limits field.
+	roleDescLimits := roleFields[2].Descriptor()
+	// role.DefaultLimits holds the default value on creation for the limits field.
+	role.DefaultLimits = roleDescLimits.Default.(access.Limits)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleFields[3].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
 	telegramblobFields := schema.TelegramBlob{}.Fields()
 	_ = telegramblobFields
 	// telegramblobDescID is the schema descriptor for id field.
 	telegramblobDescID := telegramblobFields[0].Descriptor()
 	// telegramblob.DefaultID holds the default value on creation for the id field.
 	telegramblob.DefaultID = telegramblobDescID.Default.(func() uuid.UUID)
+	usageFields := schema.Usage{}.Fields()
+	_ = usageFields
+	// usageDescBytes is the schema descriptor for bytes field.
+	usageDescBytes := usageFields[3].Descriptor()
+	// usage.DefaultBytes holds the default value on creation for the bytes field.
+	usage.DefaultBytes = usageDescBytes.Default.(int64)
+	// usageDescCreatedAt is the schema descriptor for created_at field.
+	usageDescCreatedAt := usageFields[4].Descriptor()
+	// usage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usage.DefaultCreatedAt = usageDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Grant allows user or chat to use bot with role.
+type Grant struct {
+	ent.Schema
+}
+
+// Fields of the Grant.
+func (Grant) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("peer_type").Values("user", "chat", "channel"),
+		field.Int64("peer_id"),
+		field.String("role"),
+		field.Int64("granted_by").Comment("user id of admin, zero if redeemed invite"),
+		field.String("invite").
+			Optional().
+			Comment("code of redeemed invite"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Grant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("peer_type", "peer_id").Unique(),
+	}
+}
@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Invite is code that grants role to user or chat that redeems it.
+type Invite struct {
+	ent.Schema
+}
+
+// Fields of the Invite.
+func (Invite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").Unique().NotEmpty(),
+		field.String("role"),
+		field.Int("max_uses").Default(1).Positive(),
+		field.Int("uses").Default(0).NonNegative(),
+		field.Int64("created_by").Comment("user id of admin"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JobOptions are options chosen by requester.
+type JobOptions struct {
+	// Format selector.
+	Format string `json:"format"`
+	// Audio codec to convert audio only format to, empty to keep original.
+	Audio string `json:"audio,omitempty"`
+	// Oversize is how to deliver video that exceeds upload limit,
+	// "split" (default) or "compress".
+	Oversize string `json:"oversize,omitempty"`
+	// Start and End of clip, whole video if both are zero and the end of
+	// video if only End is zero.
+	Start time.Duration `json:"start,omitempty"`
+	End   time.Duration `json:"end,omitempty"`
+	// Mode is kind of produced media, "animation", "note" or "sticker",
+	// empty for video.
+	Mode string `json:"mode,omitempty"`
+	// Subtitles are languages of subtitles that are sent with video.
+	Subtitles []string `json:"subtitles,omitempty"`
+	// Document sends video as file instead of streamable video.
+	Document bool `json:"document,omitempty"`
+	// Codec is video codec family chosen by requester, like "vp9", that
+	// is not converted even if some clients can not play it.
+	Codec string `json:"codec,omitempty"`
+}
+
+// Clip reports whether only part of video is requested.
+func (o JobOptions) Clip() bool {
+	return o.Start > 0 || o.End > 0
+}
+
+type Job struct {
+	ent.Schema
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id").Comment("requester"),
+		field.Enum("user_type").
+			Values("user", "channel").
+			Default("user").
+			Comment("channel for anonymous admins and users that send as channel"),
+		field.Enum("peer_type").Values("user", "chat", "channel"),
+		field.Int64("peer_id"),
+		field.Int64("access_hash").Default(0),
+		field.Int("message_id").Comment("request message"),
+		field.String("url").Comment("as sent by requester"),
+		field.JSON("options", JobOptions{}),
+		field.Enum("state").
+			Values("queued", "running", "done", "failed", "canceled").
+			Default("queued"),
+		field.Int("attempts").Default(0),
+		field.String("error").Optional(),
+		field.Enum("conversion").
+			Values("copy", "audio", "video").
+			Optional().
+			Comment("how video was converted to be playable by every client"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("started_at").Optional().Nillable(),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
+
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("state"),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Preference holds settings of chat or user.
+type Preference struct {
+	ent.Schema
+}
+
+// Fields of the Preference.
+func (Preference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("peer_type").Values("user", "chat", "channel"),
+		field.Int64("peer_id"),
+		field.String("caption").
+			Optional().
+			Comment("caption template, default if empty"),
+		field.Bool("originals").
+			Default(false).
+			Comment("attach original files of photos as documents"),
+		field.Bool("auto_fetch").
+			Default(false).
+			Comment("fetch every link in group, not only ones addressed to bot"),
+		field.Int("quality").
+			Default(0).
+			NonNegative().
+			Comment("maximum short side of video, unlimited if zero"),
+		field.String("codec").
+			Optional().
+			Comment("preferred video codec family, playable everywhere if empty"),
+		field.Enum("default_format").
+			Values("ask", "video", "audio").
+			Default("ask").
+			Comment("what links without command are downloaded as"),
+		field.Strings("subtitles").
+			Optional().
+			Comment("languages of subtitles that are sent with video"),
+		field.Bool("document").
+			Default(false).
+			Comment("send video as file instead of streamable video"),
+		field.String("language").
+			Optional().
+			Comment("language of bot messages, from telegram if empty"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Preference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("peer_type", "peer_id").Unique(),
+	}
+}
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/ernado/tentacle/internal/access"
+)
+
+// Role defines what granted users and chats can request.
+type Role struct {
+	ent.Schema
+}
+
+// Fields of the Role.
+func (Role) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().NotEmpty(),
+		field.Bool("admin").
+			Default(false).
+			Comment("manages access with bot commands"),
+		field.JSON("limits", access.Limits{}).
+			Default(access.Limits{}).
+			Comment("limits of requests, none if empty"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
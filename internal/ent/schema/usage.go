@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Usage is job of user or chat, counted by quotas.
+type Usage struct {
+	ent.Schema
+}
+
+// Fields of the Usage.
+func (Usage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("peer_type").Values("user", "chat", "channel"),
+		field.Int64("peer_id"),
+		field.Int("job_id"),
+		field.Int64("bytes").Default(0).Comment("downloaded by job, including failed attempts"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Usage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("peer_type", "peer_id", "created_at"),
+		index.Fields("job_id"),
+	}
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,12 +14,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Grant is the client for interacting with the Grant builders.
+	Grant *GrantClient
+	// Invite is the client for interacting with the Invite builders.
+	Invite *InviteClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// Preference is the client for interacting with the Preference builders.
+	Preference *PreferenceClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
 	// TelegramBlob is the client for interacting with the TelegramBlob builders.
 	TelegramBlob *TelegramBlobClient
 	// TelegramChannel is the client for interacting with the TelegramChannel builders.
 	TelegramChannel *TelegramChannelClient
 	// TelegramSession is the client for interacting with the TelegramSession builders.
 	TelegramSession *TelegramSessionClient
+	// Usage is the client for interacting with the Usage builders.
+	Usage *UsageClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +163,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Grant = NewGrantClient(tx.config)
+	tx.Invite = NewInviteClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
+	tx.Preference = NewPreferenceClient(tx.config)
+	tx.Role = NewRoleClient(tx.config)
 	tx.TelegramBlob = NewTelegramBlobClient(tx.config)
 	tx.TelegramChannel = NewTelegramChannelClient(tx.config)
 	tx.TelegramSession = NewTelegramSessionClient(tx.config)
+	tx.Usage = NewUsageClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +181,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: TelegramBlob.QueryXXX(), the query will be executed
+// applies a query, for example: Grant.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -214,3 +234,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ernado/tentacle/internal/ent/usage"
+)
+
+// Usage is the model entity for the Usage schema.
+type Usage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PeerType holds the value of the "peer_type" field.
+	PeerType usage.PeerType `json:"peer_type,omitempty"`
+	// PeerID holds the value of the "peer_id" field.
+	PeerID int64 `json:"peer_id,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID int `json:"job_id,omitempty"`
+	// downloaded by job, including failed attempts
+	Bytes int64 `json:"bytes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Usage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usage.FieldID, usage.FieldPeerID, usage.FieldJobID, usage.FieldBytes:
+			values[i] = new(sql.NullInt64)
+		case usage.FieldPeerType:
+			values[i] = new(sql.NullString)
+		case usage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Usage fields.
+func (_m *Usage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case usage.FieldPeerType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field peer_type", values[i])
+			} else if value.Valid {
+				_m.PeerType = usage.PeerType(value.String)
+			}
+		case usage.FieldPeerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field peer_id", values[i])
+			} else if value.Valid {
+				_m.PeerID = value.Int64
+			}
+		case usage.FieldJobID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				_m.JobID = int(value.Int64)
+			}
+		case usage.FieldBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bytes", values[i])
+			} else if value.Valid {
+				_m.Bytes = value.Int64
+			}
+		case usage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Usage.
+// This includes values selected through modifiers, order, etc.
+func (_m *Usage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Usage.
+// Note that you need to call Usage.Unwrap() before calling this method if this Usage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Usage) Update() *UsageUpdateOne {
+	return NewUsageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Usage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Usage) Unwrap() *Usage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Usage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Usage) String() string {
+	var builder strings.Builder
+	builder.WriteString("Usage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("peer_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeerType))
+	builder.WriteString(", ")
+	builder.WriteString("peer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeerID))
+	builder.WriteString(", ")
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JobID))
+	builder.WriteString(", ")
+	builder.WriteString("bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Bytes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Usages is a parsable slice of Usage.
+type Usages []*Usage
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usage
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the usage type in the database.
+	Label = "usage"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPeerType holds the string denoting the peer_type field in the database.
+	FieldPeerType = "peer_type"
+	// FieldPeerID holds the string denoting the peer_id field in the database.
+	FieldPeerID = "peer_id"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
+	// FieldBytes holds the string denoting the bytes field in the database.
+	FieldBytes = "bytes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the usage in the database.
+	Table = "usages"
+)
+
+// Columns holds all SQL columns for usage fields.
+var Columns = []string{
+	FieldID,
+	FieldPeerType,
+	FieldPeerID,
+	FieldJobID,
+	FieldBytes,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultBytes holds the default value on creation for the "bytes" field.
+	DefaultBytes int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// PeerType defines the type for the "peer_type" enum field.
+type PeerType string
+
+// PeerType values.
+const (
+	PeerTypeUser    PeerType = "user"
+	PeerTypeChat    PeerType = "chat"
+	PeerTypeChannel PeerType = "channel"
+)
+
+func (pt PeerType) String() string {
+	return string(pt)
+}
+
+// PeerTypeValidator is a validator for the "peer_type" field enum values. It is called by the builders before save.
+func PeerTypeValidator(pt PeerType) error {
+	switch pt {
+	case PeerTypeUser, PeerTypeChat, PeerTypeChannel:
+		return nil
+	default:
+		return fmt.Errorf("usage: invalid enum value for peer_type field: %q", pt)
+	}
+}
+
+// OrderOption defines the ordering options for the Usage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPeerType orders the results by the peer_type field.
+func ByPeerType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeerType, opts...).ToFunc()
+}
+
+// ByPeerID orders the results by the peer_id field.
+func ByPeerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeerID, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
+// ByBytes orders the results by the bytes field.
+func ByBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBytes, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
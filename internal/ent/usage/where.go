@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/ernado/tentacle/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldID, id))
+}
+
+// PeerID applies equality check predicate on the "peer_id" field. It's identical to PeerIDEQ.
+func PeerID(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldPeerID, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldJobID, v))
+}
+
+// Bytes applies equality check predicate on the "bytes" field. It's identical to BytesEQ.
+func Bytes(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldBytes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PeerTypeEQ applies the EQ predicate on the "peer_type" field.
+func PeerTypeEQ(v PeerType) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldPeerType, v))
+}
+
+// PeerTypeNEQ applies the NEQ predicate on the "peer_type" field.
+func PeerTypeNEQ(v PeerType) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldPeerType, v))
+}
+
+// PeerTypeIn applies the In predicate on the "peer_type" field.
+func PeerTypeIn(vs ...PeerType) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldPeerType, vs...))
+}
+
+// PeerTypeNotIn applies the NotIn predicate on the "peer_type" field.
+func PeerTypeNotIn(vs ...PeerType) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldPeerType, vs...))
+}
+
+// PeerIDEQ applies the EQ predicate on the "peer_id" field.
+func PeerIDEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldPeerID, v))
+}
+
+// PeerIDNEQ applies the NEQ predicate on the "peer_id" field.
+func PeerIDNEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldPeerID, v))
+}
+
+// PeerIDIn applies the In predicate on the "peer_id" field.
+func PeerIDIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldPeerID, vs...))
+}
+
+// PeerIDNotIn applies the NotIn predicate on the "peer_id" field.
+func PeerIDNotIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldPeerID, vs...))
+}
+
+// PeerIDGT applies the GT predicate on the "peer_id" field.
+func PeerIDGT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldPeerID, v))
+}
+
+// PeerIDGTE applies the GTE predicate on the "peer_id" field.
+func PeerIDGTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldPeerID, v))
+}
+
+// PeerIDLT applies the LT predicate on the "peer_id" field.
+func PeerIDLT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldPeerID, v))
+}
+
+// PeerIDLTE applies the LTE predicate on the "peer_id" field.
+func PeerIDLTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldPeerID, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...int) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v int) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldJobID, v))
+}
+
+// BytesEQ applies the EQ predicate on the "bytes" field.
+func BytesEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldBytes, v))
+}
+
+// BytesNEQ applies the NEQ predicate on the "bytes" field.
+func BytesNEQ(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldBytes, v))
+}
+
+// BytesIn applies the In predicate on the "bytes" field.
+func BytesIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldBytes, vs...))
+}
+
+// BytesNotIn applies the NotIn predicate on the "bytes" field.
+func BytesNotIn(vs ...int64) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldBytes, vs...))
+}
+
+// BytesGT applies the GT predicate on the "bytes" field.
+func BytesGT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldBytes, v))
+}
+
+// BytesGTE applies the GTE predicate on the "bytes" field.
+func BytesGTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldBytes, v))
+}
+
+// BytesLT applies the LT predicate on the "bytes" field.
+func BytesLT(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldBytes, v))
+}
+
+// BytesLTE applies the LTE predicate on the "bytes" field.
+func BytesLTE(v int64) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldBytes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Usage {
+	return predicate.Usage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Usage) predicate.Usage {
+	return predicate.Usage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Usage) predicate.Usage {
+	return predicate.Usage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Usage) predicate.Usage {
+	return predicate.Usage(sql.NotPredicates(p))
+}
@@ -0,0 +1,734 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/usage"
+)
+
+// UsageCreate is the builder for creating a Usage entity.
+type UsageCreate struct {
+	config
+	mutation *UsageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_c *UsageCreate) SetPeerType(v usage.PeerType) *UsageCreate {
+	_c.mutation.SetPeerType(v)
+	return _c
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_c *UsageCreate) SetPeerID(v int64) *UsageCreate {
+	_c.mutation.SetPeerID(v)
+	return _c
+}
+
+// SetJobID sets the "job_id" field.
+func (_c *UsageCreate) SetJobID(v int) *UsageCreate {
+	_c.mutation.SetJobID(v)
+	return _c
+}
+
+// SetBytes sets the "bytes" field.
+func (_c *UsageCreate) SetBytes(v int64) *UsageCreate {
+	_c.mutation.SetBytes(v)
+	return _c
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_c *UsageCreate) SetNillableBytes(v *int64) *UsageCreate {
+	if v != nil {
+		_c.SetBytes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UsageCreate) SetCreatedAt(v time.Time) *UsageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UsageCreate) SetNillableCreatedAt(v *time.Time) *UsageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (_c *UsageCreate) Mutation() *UsageMutation {
+	return _c.mutation
+}
+
+// Save creates the Usage in the database.
+func (_c *UsageCreate) Save(ctx context.Context) (*Usage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UsageCreate) SaveX(ctx context.Context) *Usage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UsageCreate) defaults() {
+	if _, ok := _c.mutation.Bytes(); !ok {
+		v := usage.DefaultBytes
+		_c.mutation.SetBytes(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := usage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UsageCreate) check() error {
+	if _, ok := _c.mutation.PeerType(); !ok {
+		return &ValidationError{Name: "peer_type", err: errors.New(`ent: missing required field "Usage.peer_type"`)}
+	}
+	if v, ok := _c.mutation.PeerType(); ok {
+		if err := usage.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Usage.peer_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PeerID(); !ok {
+		return &ValidationError{Name: "peer_id", err: errors.New(`ent: missing required field "Usage.peer_id"`)}
+	}
+	if _, ok := _c.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "Usage.job_id"`)}
+	}
+	if _, ok := _c.mutation.Bytes(); !ok {
+		return &ValidationError{Name: "bytes", err: errors.New(`ent: missing required field "Usage.bytes"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Usage.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *UsageCreate) sqlSave(ctx context.Context) (*Usage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UsageCreate) createSpec() (*Usage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Usage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usage.Table, sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.PeerType(); ok {
+		_spec.SetField(usage.FieldPeerType, field.TypeEnum, value)
+		_node.PeerType = value
+	}
+	if value, ok := _c.mutation.PeerID(); ok {
+		_spec.SetField(usage.FieldPeerID, field.TypeInt64, value)
+		_node.PeerID = value
+	}
+	if value, ok := _c.mutation.JobID(); ok {
+		_spec.SetField(usage.FieldJobID, field.TypeInt, value)
+		_node.JobID = value
+	}
+	if value, ok := _c.mutation.Bytes(); ok {
+		_spec.SetField(usage.FieldBytes, field.TypeInt64, value)
+		_node.Bytes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(usage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Usage.Create().
+//		SetPeerType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageUpsert) {
+//			SetPeerType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UsageCreate) OnConflict(opts ...sql.ConflictOption) *UsageUpsertOne {
+	_c.conflict = opts
+	return &UsageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UsageCreate) OnConflictColumns(columns ...string) *UsageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UsageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UsageUpsertOne is the builder for "upsert"-ing
+	//  one Usage node.
+	UsageUpsertOne struct {
+		create *UsageCreate
+	}
+
+	// UsageUpsert is the "OnConflict" setter.
+	UsageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPeerType sets the "peer_type" field.
+func (u *UsageUpsert) SetPeerType(v usage.PeerType) *UsageUpsert {
+	u.Set(usage.FieldPeerType, v)
+	return u
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *UsageUpsert) UpdatePeerType() *UsageUpsert {
+	u.SetExcluded(usage.FieldPeerType)
+	return u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *UsageUpsert) SetPeerID(v int64) *UsageUpsert {
+	u.Set(usage.FieldPeerID, v)
+	return u
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *UsageUpsert) UpdatePeerID() *UsageUpsert {
+	u.SetExcluded(usage.FieldPeerID)
+	return u
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *UsageUpsert) AddPeerID(v int64) *UsageUpsert {
+	u.Add(usage.FieldPeerID, v)
+	return u
+}
+
+// SetJobID sets the "job_id" field.
+func (u *UsageUpsert) SetJobID(v int) *UsageUpsert {
+	u.Set(usage.FieldJobID, v)
+	return u
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *UsageUpsert) UpdateJobID() *UsageUpsert {
+	u.SetExcluded(usage.FieldJobID)
+	return u
+}
+
+// AddJobID adds v to the "job_id" field.
+func (u *UsageUpsert) AddJobID(v int) *UsageUpsert {
+	u.Add(usage.FieldJobID, v)
+	return u
+}
+
+// SetBytes sets the "bytes" field.
+func (u *UsageUpsert) SetBytes(v int64) *UsageUpsert {
+	u.Set(usage.FieldBytes, v)
+	return u
+}
+
+// UpdateBytes sets the "bytes" field to the value that was provided on create.
+func (u *UsageUpsert) UpdateBytes() *UsageUpsert {
+	u.SetExcluded(usage.FieldBytes)
+	return u
+}
+
+// AddBytes adds v to the "bytes" field.
+func (u *UsageUpsert) AddBytes(v int64) *UsageUpsert {
+	u.Add(usage.FieldBytes, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageUpsertOne) UpdateNewValues() *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UsageUpsertOne) Ignore() *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageUpsertOne) DoNothing() *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageCreate.OnConflict
+// documentation for more info.
+func (u *UsageUpsertOne) Update(set func(*UsageUpsert)) *UsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (u *UsageUpsertOne) SetPeerType(v usage.PeerType) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetPeerType(v)
+	})
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdatePeerType() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdatePeerType()
+	})
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *UsageUpsertOne) SetPeerID(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetPeerID(v)
+	})
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *UsageUpsertOne) AddPeerID(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddPeerID(v)
+	})
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdatePeerID() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdatePeerID()
+	})
+}
+
+// SetJobID sets the "job_id" field.
+func (u *UsageUpsertOne) SetJobID(v int) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// AddJobID adds v to the "job_id" field.
+func (u *UsageUpsertOne) AddJobID(v int) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdateJobID() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// SetBytes sets the "bytes" field.
+func (u *UsageUpsertOne) SetBytes(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetBytes(v)
+	})
+}
+
+// AddBytes adds v to the "bytes" field.
+func (u *UsageUpsertOne) AddBytes(v int64) *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddBytes(v)
+	})
+}
+
+// UpdateBytes sets the "bytes" field to the value that was provided on create.
+func (u *UsageUpsertOne) UpdateBytes() *UsageUpsertOne {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateBytes()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UsageUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UsageUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UsageCreateBulk is the builder for creating many Usage entities in bulk.
+type UsageCreateBulk struct {
+	config
+	err      error
+	builders []*UsageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Usage entities in the database.
+func (_c *UsageCreateBulk) Save(ctx context.Context) ([]*Usage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Usage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UsageCreateBulk) SaveX(ctx context.Context) []*Usage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Usage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageUpsert) {
+//			SetPeerType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UsageCreateBulk) OnConflict(opts ...sql.ConflictOption) *UsageUpsertBulk {
+	_c.conflict = opts
+	return &UsageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UsageCreateBulk) OnConflictColumns(columns ...string) *UsageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UsageUpsertBulk{
+		create: _c,
+	}
+}
+
+// UsageUpsertBulk is the builder for "upsert"-ing
+// a bulk of Usage nodes.
+type UsageUpsertBulk struct {
+	create *UsageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageUpsertBulk) UpdateNewValues() *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Usage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UsageUpsertBulk) Ignore() *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageUpsertBulk) DoNothing() *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageCreateBulk.OnConflict
+// documentation for more info.
+func (u *UsageUpsertBulk) Update(set func(*UsageUpsert)) *UsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (u *UsageUpsertBulk) SetPeerType(v usage.PeerType) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetPeerType(v)
+	})
+}
+
+// UpdatePeerType sets the "peer_type" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdatePeerType() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdatePeerType()
+	})
+}
+
+// SetPeerID sets the "peer_id" field.
+func (u *UsageUpsertBulk) SetPeerID(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetPeerID(v)
+	})
+}
+
+// AddPeerID adds v to the "peer_id" field.
+func (u *UsageUpsertBulk) AddPeerID(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddPeerID(v)
+	})
+}
+
+// UpdatePeerID sets the "peer_id" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdatePeerID() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdatePeerID()
+	})
+}
+
+// SetJobID sets the "job_id" field.
+func (u *UsageUpsertBulk) SetJobID(v int) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// AddJobID adds v to the "job_id" field.
+func (u *UsageUpsertBulk) AddJobID(v int) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdateJobID() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// SetBytes sets the "bytes" field.
+func (u *UsageUpsertBulk) SetBytes(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.SetBytes(v)
+	})
+}
+
+// AddBytes adds v to the "bytes" field.
+func (u *UsageUpsertBulk) AddBytes(v int64) *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.AddBytes(v)
+	})
+}
+
+// UpdateBytes sets the "bytes" field to the value that was provided on create.
+func (u *UsageUpsertBulk) UpdateBytes() *UsageUpsertBulk {
+	return u.Update(func(s *UsageUpsert) {
+		s.UpdateBytes()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UsageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ernado/tentacle/internal/ent/predicate"
+	"github.com/ernado/tentacle/internal/ent/usage"
+)
+
+// UsageUpdate is the builder for updating Usage entities.
+type UsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageMutation
+}
+
+// Where appends a list predicates to the UsageUpdate builder.
+func (_u *UsageUpdate) Where(ps ...predicate.Usage) *UsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_u *UsageUpdate) SetPeerType(v usage.PeerType) *UsageUpdate {
+	_u.mutation.SetPeerType(v)
+	return _u
+}
+
+// SetNillablePeerType sets the "peer_type" field if the given value is not nil.
+func (_u *UsageUpdate) SetNillablePeerType(v *usage.PeerType) *UsageUpdate {
+	if v != nil {
+		_u.SetPeerType(*v)
+	}
+	return _u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_u *UsageUpdate) SetPeerID(v int64) *UsageUpdate {
+	_u.mutation.ResetPeerID()
+	_u.mutation.SetPeerID(v)
+	return _u
+}
+
+// SetNillablePeerID sets the "peer_id" field if the given value is not nil.
+func (_u *UsageUpdate) SetNillablePeerID(v *int64) *UsageUpdate {
+	if v != nil {
+		_u.SetPeerID(*v)
+	}
+	return _u
+}
+
+// AddPeerID adds value to the "peer_id" field.
+func (_u *UsageUpdate) AddPeerID(v int64) *UsageUpdate {
+	_u.mutation.AddPeerID(v)
+	return _u
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *UsageUpdate) SetJobID(v int) *UsageUpdate {
+	_u.mutation.ResetJobID()
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *UsageUpdate) SetNillableJobID(v *int) *UsageUpdate {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// AddJobID adds value to the "job_id" field.
+func (_u *UsageUpdate) AddJobID(v int) *UsageUpdate {
+	_u.mutation.AddJobID(v)
+	return _u
+}
+
+// SetBytes sets the "bytes" field.
+func (_u *UsageUpdate) SetBytes(v int64) *UsageUpdate {
+	_u.mutation.ResetBytes()
+	_u.mutation.SetBytes(v)
+	return _u
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_u *UsageUpdate) SetNillableBytes(v *int64) *UsageUpdate {
+	if v != nil {
+		_u.SetBytes(*v)
+	}
+	return _u
+}
+
+// AddBytes adds value to the "bytes" field.
+func (_u *UsageUpdate) AddBytes(v int64) *UsageUpdate {
+	_u.mutation.AddBytes(v)
+	return _u
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (_u *UsageUpdate) Mutation() *UsageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageUpdate) check() error {
+	if v, ok := _u.mutation.PeerType(); ok {
+		if err := usage.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Usage.peer_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usage.Table, usage.Columns, sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeerType(); ok {
+		_spec.SetField(usage.FieldPeerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerID(); ok {
+		_spec.SetField(usage.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPeerID(); ok {
+		_spec.AddField(usage.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.JobID(); ok {
+		_spec.SetField(usage.FieldJobID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedJobID(); ok {
+		_spec.AddField(usage.FieldJobID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Bytes(); ok {
+		_spec.SetField(usage.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytes(); ok {
+		_spec.AddField(usage.FieldBytes, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageUpdateOne is the builder for updating a single Usage entity.
+type UsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageMutation
+}
+
+// SetPeerType sets the "peer_type" field.
+func (_u *UsageUpdateOne) SetPeerType(v usage.PeerType) *UsageUpdateOne {
+	_u.mutation.SetPeerType(v)
+	return _u
+}
+
+// SetNillablePeerType sets the "peer_type" field if the given value is not nil.
+func (_u *UsageUpdateOne) SetNillablePeerType(v *usage.PeerType) *UsageUpdateOne {
+	if v != nil {
+		_u.SetPeerType(*v)
+	}
+	return _u
+}
+
+// SetPeerID sets the "peer_id" field.
+func (_u *UsageUpdateOne) SetPeerID(v int64) *UsageUpdateOne {
+	_u.mutation.ResetPeerID()
+	_u.mutation.SetPeerID(v)
+	return _u
+}
+
+// SetNillablePeerID sets the "peer_id" field if the given value is not nil.
+func (_u *UsageUpdateOne) SetNillablePeerID(v *int64) *UsageUpdateOne {
+	if v != nil {
+		_u.SetPeerID(*v)
+	}
+	return _u
+}
+
+// AddPeerID adds value to the "peer_id" field.
+func (_u *UsageUpdateOne) AddPeerID(v int64) *UsageUpdateOne {
+	_u.mutation.AddPeerID(v)
+	return _u
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *UsageUpdateOne) SetJobID(v int) *UsageUpdateOne {
+	_u.mutation.ResetJobID()
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *UsageUpdateOne) SetNillableJobID(v *int) *UsageUpdateOne {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// AddJobID adds value to the "job_id" field.
+func (_u *UsageUpdateOne) AddJobID(v int) *UsageUpdateOne {
+	_u.mutation.AddJobID(v)
+	return _u
+}
+
+// SetBytes sets the "bytes" field.
+func (_u *UsageUpdateOne) SetBytes(v int64) *UsageUpdateOne {
+	_u.mutation.ResetBytes()
+	_u.mutation.SetBytes(v)
+	return _u
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_u *UsageUpdateOne) SetNillableBytes(v *int64) *UsageUpdateOne {
+	if v != nil {
+		_u.SetBytes(*v)
+	}
+	return _u
+}
+
+// AddBytes adds value to the "bytes" field.
+func (_u *UsageUpdateOne) AddBytes(v int64) *UsageUpdateOne {
+	_u.mutation.AddBytes(v)
+	return _u
+}
+
+// Mutation returns the UsageMutation object of the builder.
+func (_u *UsageUpdateOne) Mutation() *UsageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UsageUpdate builder.
+func (_u *UsageUpdateOne) Where(ps ...predicate.Usage) *UsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageUpdateOne) Select(field string, fields ...string) *UsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Usage entity.
+func (_u *UsageUpdateOne) Save(ctx context.Context) (*Usage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageUpdateOne) SaveX(ctx context.Context) *Usage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageUpdateOne) check() error {
+	if v, ok := _u.mutation.PeerType(); ok {
+		if err := usage.PeerTypeValidator(v); err != nil {
+			return &ValidationError{Name: "peer_type", err: fmt.Errorf(`ent: validator failed for field "Usage.peer_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UsageUpdateOne) sqlSave(ctx context.Context) (_node *Usage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usage.Table, usage.Columns, sqlgraph.NewFieldSpec(usage.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Usage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usage.FieldID)
+		for _, f := range fields {
+			if !usage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeerType(); ok {
+		_spec.SetField(usage.FieldPeerType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PeerID(); ok {
+		_spec.SetField(usage.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPeerID(); ok {
+		_spec.AddField(usage.FieldPeerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.JobID(); ok {
+		_spec.SetField(usage.FieldJobID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedJobID(); ok {
+		_spec.AddField(usage.FieldJobID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Bytes(); ok {
+		_spec.SetField(usage.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytes(); ok {
+		_spec.AddField(usage.FieldBytes, field.TypeInt64, value)
+	}
+	_node = &Usage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
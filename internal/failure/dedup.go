@@ -0,0 +1,55 @@
+package failure
+
+import (
+	"sync"
+	"time"
+)
+
+type seen struct {
+	reported time.Time
+	repeated int
+}
+
+// Deduper suppresses repeats of the same failure within window.
+type Deduper struct {
+	window time.Duration
+
+	mux  sync.Mutex
+	seen map[string]*seen
+}
+
+// NewDeduper creates new Deduper that reports failure with the same key at
+// most once per window.
+func NewDeduper(window time.Duration) *Deduper {
+	return &Deduper{
+		window: window,
+		seen:   make(map[string]*seen),
+	}
+}
+
+// Add registers failure with key at now, reporting whether it should be
+// reported and how many repeats were suppressed since last report.
+func (d *Deduper) Add(key string, now time.Time) (report bool, repeated int) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	for k, s := range d.seen {
+		// Suppressed repeats are kept to be reported with the next one.
+		if s.repeated == 0 && now.Sub(s.reported) >= d.window {
+			delete(d.seen, k)
+		}
+	}
+
+	s, ok := d.seen[key]
+	if !ok {
+		d.seen[key] = &seen{reported: now}
+		return true, 0
+	}
+	if now.Sub(s.reported) < d.window {
+		s.repeated++
+		return false, s.repeated
+	}
+	repeated = s.repeated
+	s.reported, s.repeated = now, 0
+	return true, repeated
+}
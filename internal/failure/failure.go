@@ -0,0 +1,185 @@
+// Package failure classifies errors of jobs for users and reports them to
+// admins without repeats.
+package failure
+
+import (
+	"context"
+	"io"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/ernado/tentacle/internal/ytdlp"
+
+	"github.com/ernado/ff/ffrun"
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tgerr"
+)
+
+// Kind of failure, that defines message for user.
+type Kind string
+
+const (
+	KindUnknown     Kind = "unknown"
+	KindUnavailable Kind = "unavailable"
+	KindLogin       Kind = "login"
+	KindGeo         Kind = "geo"
+	KindUnsupported Kind = "unsupported"
+	KindFormat      Kind = "format"
+	KindTimeout     Kind = "timeout"
+	KindNetwork     Kind = "network"
+	KindProcess     Kind = "process"
+	KindTelegram    Kind = "telegram"
+)
+
+// Error is error of known kind.
+type Error struct {
+	Kind Kind
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap marks err as failure of kind. Nil error is returned as is.
+func Wrap(kind Kind, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Kind: kind, Err: err}
+}
+
+// stderrKinds are kinds of yt-dlp failures by lowercase parts of its
+// output, checked in order.
+var stderrKinds = []struct {
+	Kind     Kind
+	Patterns []string
+}{
+	{Kind: KindUnsupported, Patterns: []string{"unsupported url"}},
+	{Kind: KindFormat, Patterns: []string{"requested format is not available"}},
+	{Kind: KindGeo, Patterns: []string{
+		"available in your country",
+		"geo restriction",
+		"geo-restricted",
+	}},
+	{Kind: KindLogin, Patterns: []string{
+		"sign in to confirm",
+		"login required",
+		"log in",
+		"use --cookies",
+		"registered users",
+	}},
+	{Kind: KindUnavailable, Patterns: []string{
+		"video unavailable",
+		"private video",
+		"video is private",
+		"has been removed",
+		"does not exist",
+		"http error 404",
+		"is not available",
+	}},
+	{Kind: KindNetwork, Patterns: []string{
+		"unable to download webpage",
+		"timed out",
+		"connection reset",
+		"http error 5",
+	}},
+}
+
+// Classify returns kind of err.
+func Classify(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	var ytErr *ytdlp.Error
+	if errors.As(err, &ytErr) {
+		text := strings.ToLower(ytErr.Stderr)
+		for _, k := range stderrKinds {
+			for _, p := range k.Patterns {
+				if strings.Contains(text, p) {
+					return k.Kind
+				}
+			}
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return KindTimeout
+	}
+	var ffErr *ffrun.Error
+	if errors.As(err, &ffErr) {
+		return KindProcess
+	}
+	if _, ok := tgerr.As(err); ok {
+		return KindTelegram
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return KindNetwork
+	}
+	return KindUnknown
+}
+
+// Stderr returns output of failed yt-dlp or ffmpeg run of err, up to last
+// n lines.
+func Stderr(err error, n int) []string {
+	var lines []string
+	var (
+		ytErr *ytdlp.Error
+		ffErr *ffrun.Error
+	)
+	switch {
+	case errors.As(err, &ytErr):
+		lines = strings.Split(strings.TrimSpace(ytErr.Stderr), "\n")
+	case errors.As(err, &ffErr):
+		lines = ffErr.Lines()
+	}
+	if len(lines) == 1 && lines[0] == "" {
+		return nil
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines
+}
+
+// withoutStderr returns text of err without output of yt-dlp or ffmpeg,
+// which is returned by Stderr.
+func withoutStderr(err error) string {
+	var (
+		text  = err.Error()
+		ytErr *ytdlp.Error
+		ffErr *ffrun.Error
+	)
+	switch {
+	case errors.As(err, &ytErr) && ytErr.Err != nil:
+		return strings.Replace(text, ytErr.Error(), "yt-dlp: "+ytErr.Err.Error(), 1)
+	case errors.As(err, &ffErr) && ffErr.Unwrap() != nil:
+		return strings.Replace(text, ffErr.Error(), ffErr.Unwrap().Error(), 1)
+	}
+	return text
+}
+
+var (
+	urlRe   = regexp.MustCompile(`https?://\S+`)
+	digitRe = regexp.MustCompile(`[^\s:"'()\[\]]*\d[^\s:"'()\[\]]*`)
+)
+
+// maxFingerprint limits length of error text in fingerprint.
+const maxFingerprint = 256
+
+// Fingerprint returns key of failure at stage, that is the same for errors
+// that differ only by URLs, identifiers or numbers.
+func Fingerprint(stage string, err error) string {
+	text := urlRe.ReplaceAllString(err.Error(), "<url>")
+	text = digitRe.ReplaceAllString(text, "#")
+	if len(text) > maxFingerprint {
+		text = text[:maxFingerprint]
+	}
+	return stage + "|" + string(Classify(err)) + "|" + text
+}
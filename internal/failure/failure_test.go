@@ -0,0 +1,129 @@
+package failure
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ernado/tentacle/internal/ytdlp"
+
+	"github.com/ernado/ff/ffrun"
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/tgerr"
+	"github.com/stretchr/testify/require"
+)
+
+func ytError(stderr string) error {
+	return errors.Wrap(&ytdlp.Error{Err: errors.New("exit status 1"), Stderr: stderr}, "entries")
+}
+
+func TestClassify(t *testing.T) {
+	for _, tt := range []struct {
+		Err  error
+		Kind Kind
+	}{
+		{Err: errors.New("boom"), Kind: KindUnknown},
+		{Err: ytError("ERROR: [youtube] abc: Video unavailable"), Kind: KindUnavailable},
+		{Err: ytError("ERROR: [youtube] abc: Private video. Sign in if you've been granted access"), Kind: KindUnavailable},
+		{Err: ytError("ERROR: [youtube] abc: Sign in to confirm you're not a bot"), Kind: KindLogin},
+		{Err: ytError("ERROR: The uploader has not made this video available in your country"), Kind: KindGeo},
+		{Err: ytError("ERROR: [youtube] abc: This video is not available in your country"), Kind: KindGeo},
+		{Err: ytError("ERROR: Unsupported URL: https://example.com"), Kind: KindUnsupported},
+		{Err: ytError("ERROR: [youtube] abc: Requested format is not available"), Kind: KindFormat},
+		{Err: ytError("ERROR: Unable to download webpage: HTTP Error 503"), Kind: KindNetwork},
+		{Err: ytError("WARNING: something"), Kind: KindUnknown},
+		{Err: errors.Wrap(context.DeadlineExceeded, "download"), Kind: KindTimeout},
+		{Err: errors.Wrap(&ffrun.Error{}, "convert"), Kind: KindProcess},
+		{Err: errors.Wrap(tgerr.New(400, "FILE_PARTS_INVALID"), "upload"), Kind: KindTelegram},
+		{Err: errors.Wrap(&net.OpError{Op: "dial", Err: errors.New("refused")}, "get"), Kind: KindNetwork},
+		{Err: Wrap(KindFormat, errors.New("format \"1\" not found")), Kind: KindFormat},
+	} {
+		require.Equal(t, tt.Kind, Classify(tt.Err), tt.Err.Error())
+	}
+	require.NoError(t, Wrap(KindFormat, nil))
+}
+
+func TestStderr(t *testing.T) {
+	require.Empty(t, Stderr(errors.New("boom"), 10))
+	require.Empty(t, Stderr(ytError(""), 10))
+	require.Equal(t, []string{"b", "c"}, Stderr(ytError("a\nb\nc\n"), 2))
+}
+
+func TestWithoutStderr(t *testing.T) {
+	require.Equal(t, "boom", withoutStderr(errors.New("boom")))
+	require.Equal(t, "entries: yt-dlp: exit status 1", withoutStderr(ytError("ERROR: Video unavailable")))
+}
+
+func TestFingerprint(t *testing.T) {
+	a := Fingerprint("download", ytError("ERROR: [youtube] dQw4w9WgXcQ: Video unavailable"))
+	b := Fingerprint("download", ytError("ERROR: [youtube] 9bZkp7q19f0: Video unavailable"))
+	require.Equal(t, a, b)
+	require.NotEqual(t, a, Fingerprint("info", ytError("ERROR: [youtube] dQw4w9WgXcQ: Video unavailable")))
+	require.Equal(t,
+		Fingerprint("info", errors.New("bad status: 404 Not Found: https://example.com/1.jpg")),
+		Fingerprint("info", errors.New("bad status: 404 Not Found: https://example.com/2.jpg")),
+	)
+	require.NotEqual(t,
+		Fingerprint("info", errors.New("bad status: 404 Not Found")),
+		Fingerprint("info", errors.New("unexpected EOF")),
+	)
+}
+
+func TestDeduper(t *testing.T) {
+	var (
+		d   = NewDeduper(time.Hour)
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	add := func(key string, after time.Duration) (bool, int) {
+		t.Helper()
+		return d.Add(key, now.Add(after))
+	}
+	report, repeated := add("a", 0)
+	require.True(t, report)
+	require.Zero(t, repeated)
+
+	report, repeated = add("a", time.Minute)
+	require.False(t, report)
+	require.Equal(t, 1, repeated)
+	report, _ = add("b", time.Minute)
+	require.True(t, report)
+	report, repeated = add("a", 2*time.Minute)
+	require.False(t, report)
+	require.Equal(t, 2, repeated)
+
+	// Suppressed repeats are reported with the first failure after window.
+	report, repeated = add("a", 2*time.Hour)
+	require.True(t, report)
+	require.Equal(t, 2, repeated)
+
+	// Entries without repeats are purged after window.
+	require.Len(t, d.seen, 1)
+}
+
+func TestReport(t *testing.T) {
+	r := Report{
+		JobID:     42,
+		URL:       "https://example.com/v",
+		Stage:     "Downloading",
+		Requester: "user 1 in chat 2",
+		Err:       ytError("line 1\nERROR: Video unavailable"),
+		TraceID:   "0af7651916cd43dd8448eb211c80319c",
+		Repeated:  3,
+	}
+	require.Equal(t, `Job 42 failed at Downloading: unavailable
+URL: https://example.com/v
+Requester: user 1 in chat 2
+Trace: 0af7651916cd43dd8448eb211c80319c
+Repeated 3 times since last report
+
+Error: entries: yt-dlp: exit status 1
+
+Stderr:
+line 1
+ERROR: Video unavailable`, r.Text())
+
+	r = Report{Err: errors.New(string(make([]byte, 10000)))}
+	require.LessOrEqual(t, len([]rune(r.Text())), maxText)
+	require.Contains(t, r.Text(), "Request failed: unknown")
+}
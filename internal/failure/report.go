@@ -0,0 +1,75 @@
+package failure
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// maxText is limit of message length of Telegram.
+	maxText = 4096
+	// maxError limits length of error text in report.
+	maxError = 1024
+	// stderrLines is count of last lines of output in report.
+	stderrLines = 10
+)
+
+// Report is description of failure for admins.
+type Report struct {
+	// JobID is zero for failures before job is created.
+	JobID int
+	URL   string
+	Stage string
+	// Requester is description of user or chat that requested job.
+	Requester string
+	Err       error
+	// TraceID is empty if request is not traced.
+	TraceID string
+	// Repeated is count of the same failures suppressed since last report.
+	Repeated int
+}
+
+// Text returns plain text of report that fits message.
+func (r Report) Text() string {
+	var b strings.Builder
+	if r.JobID != 0 {
+		fmt.Fprintf(&b, "Job %d failed", r.JobID)
+	} else {
+		b.WriteString("Request failed")
+	}
+	if r.Stage != "" {
+		fmt.Fprintf(&b, " at %s", r.Stage)
+	}
+	fmt.Fprintf(&b, ": %s\n", Classify(r.Err))
+	if r.URL != "" {
+		fmt.Fprintf(&b, "URL: %s\n", r.URL)
+	}
+	if r.Requester != "" {
+		fmt.Fprintf(&b, "Requester: %s\n", r.Requester)
+	}
+	if r.TraceID != "" {
+		fmt.Fprintf(&b, "Trace: %s\n", r.TraceID)
+	}
+	if r.Repeated > 0 {
+		fmt.Fprintf(&b, "Repeated %d times since last report\n", r.Repeated)
+	}
+	text, lines := r.Err.Error(), Stderr(r.Err, stderrLines)
+	if len(lines) > 0 {
+		// Output is shown below.
+		text = withoutStderr(r.Err)
+	}
+	fmt.Fprintf(&b, "\nError: %s\n", truncate(text, maxError))
+	if len(lines) > 0 {
+		fmt.Fprintf(&b, "\nStderr:\n%s\n", strings.Join(lines, "\n"))
+	}
+	return truncate(strings.TrimSuffix(b.String(), "\n"), maxText)
+}
+
+// truncate s to n runes, marking cut with ellipsis.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,159 @@
+// Package i18n implements catalogs of bot messages with plural forms.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// Default is language of messages that are missing in catalog of other
+// language.
+const Default = "en"
+
+//go:embed locales/*.json
+var files embed.FS
+
+// message is text of catalog, or plural forms of text.
+type message struct {
+	Text   string
+	Plural map[string]string
+}
+
+func (m *message) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &m.Text); err == nil {
+		return nil
+	}
+	return json.Unmarshal(data, &m.Plural)
+}
+
+// Locale is catalog of messages of language.
+type Locale struct {
+	lang     string
+	messages map[string]message
+	fallback *Locale
+}
+
+// locales are catalogs by language.
+var locales = mustLoad()
+
+func load() (map[string]*Locale, error) {
+	entries, err := files.ReadDir("locales")
+	if err != nil {
+		return nil, errors.Wrap(err, "read locales")
+	}
+	result := make(map[string]*Locale, len(entries))
+	for _, e := range entries {
+		data, err := files.ReadFile(path.Join("locales", e.Name()))
+		if err != nil {
+			return nil, errors.Wrap(err, "read catalog")
+		}
+		l := &Locale{lang: strings.TrimSuffix(e.Name(), ".json")}
+		if err := json.Unmarshal(data, &l.messages); err != nil {
+			return nil, errors.Wrapf(err, "decode %s", e.Name())
+		}
+		result[l.lang] = l
+	}
+	def, ok := result[Default]
+	if !ok {
+		return nil, errors.Errorf("no catalog of %s", Default)
+	}
+	for _, l := range result {
+		if l != def {
+			l.fallback = def
+		}
+	}
+	return result, nil
+}
+
+func mustLoad() map[string]*Locale {
+	l, err := load()
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// Lookup returns locale of language code, like "ru" or "pt-br", falling
+// back to Default if there is no catalog of language.
+func Lookup(lang string) *Locale {
+	lang = strings.ToLower(lang)
+	if l, ok := locales[lang]; ok {
+		return l
+	}
+	base, _, _ := strings.Cut(lang, "-")
+	if l, ok := locales[base]; ok {
+		return l
+	}
+	return locales[Default]
+}
+
+// Languages returns codes of languages that have catalogs, Default first.
+func Languages() []string {
+	langs := make([]string, 0, len(locales))
+	for lang := range locales {
+		langs = append(langs, lang)
+	}
+	slices.SortFunc(langs, func(a, b string) int {
+		switch {
+		case a == Default:
+			return -1
+		case b == Default:
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+	return langs
+}
+
+// Lang returns language code of locale.
+func (l *Locale) Lang() string {
+	return l.lang
+}
+
+// message returns message of key, falling back to Default language.
+func (l *Locale) message(key string) (message, bool) {
+	for c := l; c != nil; c = c.fallback {
+		if m, ok := c.messages[key]; ok {
+			return m, true
+		}
+	}
+	return message{}, false
+}
+
+// T returns message of key formatted with args, or key if there is no such
+// message.
+func (l *Locale) T(key string, args ...any) string {
+	m, ok := l.message(key)
+	if !ok {
+		return key
+	}
+	text := m.Text
+	if m.Plural != nil {
+		text = m.Plural[pluralOther]
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// N returns plural form of message of key for count n, formatted with n
+// and args.
+func (l *Locale) N(key string, n int, args ...any) string {
+	m, ok := l.message(key)
+	if !ok {
+		return key
+	}
+	text := m.Text
+	if m.Plural != nil {
+		text = m.Plural[pluralForm(l.lang, n)]
+	}
+	return fmt.Sprintf(text, append([]any{n}, args...)...)
+}
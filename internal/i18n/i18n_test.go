@@ -0,0 +1,144 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	for lang, expected := range map[string]string{
+		"":      "en",
+		"en":    "en",
+		"ru":    "ru",
+		"ru-RU": "ru",
+		"pt-br": "en",
+	} {
+		require.Equal(t, expected, Lookup(lang).Lang(), lang)
+	}
+	require.Equal(t, []string{"en", "ru"}, Languages())
+}
+
+func TestPlural(t *testing.T) {
+	for n, expected := range map[int]string{
+		0:   pluralMany,
+		1:   pluralOne,
+		2:   pluralFew,
+		4:   pluralFew,
+		5:   pluralMany,
+		11:  pluralMany,
+		12:  pluralMany,
+		21:  pluralOne,
+		22:  pluralFew,
+		111: pluralMany,
+		-1:  pluralOne,
+	} {
+		require.Equal(t, expected, pluralForm("ru", n), n)
+	}
+	require.Equal(t, pluralOne, pluralForm("en", 1))
+	require.Equal(t, pluralOther, pluralForm("en", 0))
+	require.Equal(t, pluralOther, pluralForm("en", 21))
+}
+
+func TestLocale(t *testing.T) {
+	en, ru := Lookup("en"), Lookup("ru")
+	require.Equal(t, "Canceled 1 job.", en.N("cancel.jobs", 1))
+	require.Equal(t, "Canceled 3 jobs.", en.N("cancel.jobs", 3))
+	require.Equal(t, "Отменены 3 задачи.", ru.N("cancel.jobs", 3))
+	require.Equal(t, "Отменено 5 задач.", ru.N("cancel.jobs", 5))
+	require.Equal(t, "Invite for user, 2 uses:\n/join code", en.N("invite.created", 2, "user", "code"))
+	require.Equal(t, "Часть 1/2", ru.T("caption.part", 1, 2))
+	require.Equal(t, "missing.key", ru.T("missing.key"))
+
+	// Missing message of language falls back to default one.
+	l := &Locale{lang: "ru", messages: map[string]message{}, fallback: en}
+	require.Equal(t, "Part 1/2", l.T("caption.part", 1, 2))
+}
+
+// verbRe matches formatting verbs with optional explicit argument index.
+var verbRe = regexp.MustCompile(`%(?:\[(\d+)\])?[-+# 0]*\d*(?:\.\d+)?([a-zA-Z%])`)
+
+// verbs returns verbs of format by argument index, like "1:s".
+func verbs(format string) []string {
+	var (
+		list []string
+		next = 1
+	)
+	for _, m := range verbRe.FindAllStringSubmatch(format, -1) {
+		if m[2] == "%" {
+			continue
+		}
+		if m[1] != "" {
+			next, _ = strconv.Atoi(m[1])
+		}
+		list = append(list, strconv.Itoa(next)+":"+m[2])
+		next++
+	}
+	slices.Sort(list)
+	return slices.Compact(list)
+}
+
+func TestCatalogs(t *testing.T) {
+	def := locales[Default]
+	for lang, l := range locales {
+		for key := range def.messages {
+			_, ok := l.messages[key]
+			require.True(t, ok, "%s: missing %q", lang, key)
+		}
+		for key, m := range l.messages {
+			expected, ok := def.messages[key]
+			require.True(t, ok, "%s: %q is not in %s", lang, key, Default)
+
+			// Every form has the same arguments as message of default
+			// language.
+			args := verbs(expected.Text)
+			if expected.Plural != nil {
+				args = verbs(expected.Plural[pluralOther])
+			}
+			forms := map[string]string{"": m.Text}
+			if m.Plural != nil {
+				forms = m.Plural
+				for _, form := range pluralForms(lang) {
+					require.Contains(t, m.Plural, form, "%s: %q", lang, key)
+				}
+			}
+			for form, text := range forms {
+				require.NotEmpty(t, text, "%s: %q %s", lang, key, form)
+				require.Equal(t, args, verbs(text), "%s: %q %s", lang, key, form)
+			}
+		}
+	}
+}
+
+// keyRe matches catalog keys in calls and fields of command and settings
+// definitions.
+var keyRe = regexp.MustCompile(`(?:\b|\.)(?:T|N|replyT)\((?:[^"()]*, )?"([^"]+)"|` +
+	`(?:Description|Help|Title|Label|Key):\s+"([^"]+)"`)
+
+func TestKeys(t *testing.T) {
+	var found int
+	for _, dir := range []string{"access", "bot", "command", "settings"} {
+		files, err := filepath.Glob(filepath.Join("..", dir, "*.go"))
+		require.NoError(t, err)
+		for _, name := range files {
+			if strings.HasSuffix(name, "_test.go") {
+				continue
+			}
+			data, err := os.ReadFile(name)
+			require.NoError(t, err)
+			for _, m := range keyRe.FindAllStringSubmatch(string(data), -1) {
+				key := m[1] + m[2]
+				_, ok := locales[Default].messages[key]
+				require.True(t, ok, "%s: %q is not in catalog", name, key)
+				found++
+			}
+		}
+	}
+	require.NotZero(t, found)
+}
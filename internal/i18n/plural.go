@@ -0,0 +1,44 @@
+package i18n
+
+// Plural forms, named as in CLDR.
+const (
+	pluralOne  = "one"
+	pluralFew  = "few"
+	pluralMany = "many"
+	// pluralOther is form of T, that is used without count.
+	pluralOther = "other"
+)
+
+// pluralForms returns plural forms that catalog of language must have.
+func pluralForms(lang string) []string {
+	switch lang {
+	case "ru", "uk":
+		return []string{pluralOne, pluralFew, pluralMany, pluralOther}
+	default:
+		return []string{pluralOne, pluralOther}
+	}
+}
+
+// pluralForm returns plural form of count n in language.
+func pluralForm(lang string, n int) string {
+	if n < 0 {
+		n = -n
+	}
+	switch lang {
+	case "ru", "uk":
+		mod10, mod100 := n%10, n%100
+		switch {
+		case mod10 == 1 && mod100 != 11:
+			return pluralOne
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return pluralFew
+		default:
+			return pluralMany
+		}
+	default:
+		if n == 1 {
+			return pluralOne
+		}
+		return pluralOther
+	}
+}
@@ -0,0 +1,149 @@
+// Package inflight deduplicates concurrent jobs with the same key.
+package inflight
+
+import (
+	"context"
+	"sync"
+)
+
+// Func is a job function. It can report progress events to every caller
+// attached to the job.
+type Func[E, R any] func(ctx context.Context, report func(E)) (R, error)
+
+type call[E, R any] struct {
+	done   chan struct{}
+	cancel context.CancelCauseFunc
+
+	mux     sync.Mutex
+	last    E
+	hasLast bool
+	subs    map[int]func(E)
+	nextSub int
+	refs    int
+
+	res R
+	err error
+}
+
+func (c *call[E, R]) report(e E) {
+	c.mux.Lock()
+	c.last = e
+	c.hasLast = true
+	subs := make([]func(E), 0, len(c.subs))
+	for _, fn := range c.subs {
+		subs = append(subs, fn)
+	}
+	c.mux.Unlock()
+
+	for _, fn := range subs {
+		fn(e)
+	}
+}
+
+// subscribe attaches caller to the call, returning last reported event
+// so it can be replayed to the caller.
+func (c *call[E, R]) subscribe(fn func(E)) (id int, last E, ok bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	id = c.nextSub
+	c.nextSub++
+	c.refs++
+	if fn != nil {
+		c.subs[id] = fn
+	}
+
+	return id, c.last, c.hasLast
+}
+
+// unsubscribe detaches caller and reports whether it was the last one.
+func (c *call[E, R]) unsubscribe(id int) bool {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	delete(c.subs, id)
+	c.refs--
+
+	return c.refs == 0
+}
+
+// Group of in-flight jobs.
+//
+// The job runs in a context detached from callers and is canceled only when
+// every attached caller is gone, with the cause of the last caller context.
+type Group[E, R any] struct {
+	mux   sync.Mutex
+	calls map[string]*call[E, R]
+}
+
+// Do executes and returns the results of fn, making sure that only one
+// execution is in-flight for a given key at a time. If a duplicate comes in,
+// the duplicate caller attaches to the original job, receives its progress
+// events via onEvent and gets the same result.
+//
+// The shared value reports whether the caller attached to an existing job.
+func (g *Group[E, R]) Do(ctx context.Context, key string, fn Func[E, R], onEvent func(E)) (res R, shared bool, err error) {
+	g.mux.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*call[E, R])
+	}
+	c, shared := g.calls[key]
+	if !shared {
+		jobCtx, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+		c = &call[E, R]{
+			done:   make(chan struct{}),
+			cancel: cancel,
+			subs:   make(map[int]func(E)),
+		}
+		g.calls[key] = c
+		go g.run(jobCtx, key, c, fn)
+	}
+	id, last, ok := c.subscribe(onEvent)
+	g.mux.Unlock()
+
+	if ok && onEvent != nil {
+		onEvent(last)
+	}
+
+	select {
+	case <-c.done:
+		c.unsubscribe(id)
+		return c.res, shared, c.err
+	case <-ctx.Done():
+		g.mux.Lock()
+		if c.unsubscribe(id) {
+			// Nobody is waiting for the result, so new callers should
+			// start a fresh job instead of attaching to the canceled one.
+			g.forget(key, c)
+			c.cancel(context.Cause(ctx))
+		}
+		g.mux.Unlock()
+		return res, shared, ctx.Err()
+	}
+}
+
+func (g *Group[E, R]) run(ctx context.Context, key string, c *call[E, R], fn Func[E, R]) {
+	defer c.cancel(nil)
+
+	c.res, c.err = fn(ctx, c.report)
+
+	g.mux.Lock()
+	g.forget(key, c)
+	g.mux.Unlock()
+
+	close(c.done)
+}
+
+func (g *Group[E, R]) forget(key string, c *call[E, R]) {
+	if g.calls[key] == c {
+		delete(g.calls, key)
+	}
+}
+
+// Len returns count of in-flight jobs.
+func (g *Group[E, R]) Len() int {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+
+	return len(g.calls)
+}
@@ -0,0 +1,95 @@
+package inflight
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestGroup(t *testing.T) {
+	var (
+		g       Group[string, int]
+		calls   atomic.Int32
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+	fn := func(ctx context.Context, report func(string)) (int, error) {
+		calls.Add(1)
+		report("started")
+		close(started)
+		<-release
+		report("finished")
+		return 42, nil
+	}
+
+	var (
+		mux    sync.Mutex
+		events [2][]string
+		shared [2]bool
+	)
+	eg, ctx := errgroup.WithContext(t.Context())
+	for i := range 2 {
+		if i == 1 {
+			<-started
+		}
+		eg.Go(func() error {
+			res, s, err := g.Do(ctx, "key", fn, func(e string) {
+				mux.Lock()
+				defer mux.Unlock()
+				events[i] = append(events[i], e)
+			})
+			if err != nil {
+				return err
+			}
+			require.Equal(t, 42, res)
+			shared[i] = s
+			return nil
+		})
+	}
+	require.Eventually(t, func() bool {
+		mux.Lock()
+		defer mux.Unlock()
+		return len(events[1]) == 1
+	}, time.Second, time.Millisecond)
+	close(release)
+	require.NoError(t, eg.Wait())
+
+	require.Equal(t, int32(1), calls.Load())
+	require.Equal(t, [2]bool{false, true}, shared)
+	require.Equal(t, []string{"started", "finished"}, events[0])
+	require.Equal(t, []string{"started", "finished"}, events[1], "last event should be replayed")
+	require.Zero(t, g.Len())
+}
+
+func TestGroupCancel(t *testing.T) {
+	var (
+		g        Group[string, int]
+		canceled = make(chan struct{})
+	)
+	cause := errors.New("canceled by user")
+	ctx, cancel := context.WithCancelCause(t.Context())
+	go func() {
+		time.Sleep(time.Millisecond * 10)
+		cancel(cause)
+	}()
+	_, _, err := g.Do(ctx, "key", func(ctx context.Context, _ func(string)) (int, error) {
+		<-ctx.Done()
+		require.ErrorIs(t, context.Cause(ctx), cause)
+		close(canceled)
+		return 0, ctx.Err()
+	}, nil)
+	require.ErrorIs(t, err, context.Canceled)
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("job was not canceled after last caller left")
+	}
+	require.Zero(t, g.Len())
+}
@@ -0,0 +1,78 @@
+// Package links extracts links to media from telegram messages.
+package links
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/gotd/td/tg"
+
+	"github.com/ernado/tentacle/internal/ytdlp"
+)
+
+// bareURL matches links in text without entities.
+var bareURL = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// Extract returns distinct links of message text with entities, in order
+// of appearance. Links are taken from URL and text URL entities, or from
+// text itself if it has no entities. Links without scheme, like domains that
+// telegram links automatically, are ignored.
+//
+// Caption of media and text of forwarded message are message text too.
+func Extract(text string, entities []tg.MessageEntityClass) []string {
+	var candidates []string
+	if len(entities) == 0 {
+		candidates = bareURL.FindAllString(text, -1)
+	}
+	// Entity offsets are in UTF-16 code units.
+	units := utf16.Encode([]rune(text))
+	for _, e := range entities {
+		switch e := e.(type) {
+		case *tg.MessageEntityURL:
+			if e.Offset < 0 || e.Length <= 0 || e.Offset+e.Length > len(units) {
+				continue
+			}
+			candidates = append(candidates, string(utf16.Decode(units[e.Offset:e.Offset+e.Length])))
+		case *tg.MessageEntityTextURL:
+			candidates = append(candidates, e.URL)
+		}
+	}
+
+	var (
+		out  []string
+		seen = make(map[string]struct{})
+	)
+	for _, c := range candidates {
+		link, ok := parse(c)
+		if !ok {
+			continue
+		}
+		key, err := ytdlp.CanonicalURL(link)
+		if err != nil {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, link)
+	}
+	return out
+}
+
+// parse returns link without trailing punctuation, reporting whether it is
+// web link.
+func parse(link string) (string, bool) {
+	// Punctuation after link in text is not part of it.
+	link = strings.TrimRight(strings.TrimSpace(link), ".,;:!?)")
+	u, err := url.Parse(link)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", false
+	}
+	return link, true
+}
@@ -0,0 +1,71 @@
+package links
+
+import (
+	"testing"
+
+	"github.com/gotd/td/tg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtract(t *testing.T) {
+	for _, tt := range []struct {
+		Name     string
+		Text     string
+		Entities []tg.MessageEntityClass
+		Links    []string
+	}{
+		{
+			Name: "Empty",
+			Text: "hello",
+		},
+		{
+			Name: "Bare",
+			Text: "look at https://youtu.be/id, and https://example.com/v.",
+			Links: []string{
+				"https://youtu.be/id",
+				"https://example.com/v",
+			},
+		},
+		{
+			Name: "Entities",
+			// Emoji is two UTF-16 code units.
+			Text: "🎬 https://youtube.com/watch?v=id and this",
+			Entities: []tg.MessageEntityClass{
+				&tg.MessageEntityBold{Offset: 0, Length: 2},
+				&tg.MessageEntityURL{Offset: 3, Length: 30},
+				&tg.MessageEntityTextURL{Offset: 38, Length: 4, URL: "https://example.com/v"},
+			},
+			Links: []string{
+				"https://youtube.com/watch?v=id",
+				"https://example.com/v",
+			},
+		},
+		{
+			Name: "WithoutScheme",
+			Text: "see example.com or youtube.com/watch?v=id",
+			Entities: []tg.MessageEntityClass{
+				&tg.MessageEntityURL{Offset: 4, Length: 11},
+				&tg.MessageEntityURL{Offset: 19, Length: 22},
+			},
+		},
+		{
+			Name: "Distinct",
+			Text: "https://youtu.be/id https://www.youtube.com/watch?v=id&si=x",
+			Links: []string{
+				"https://youtu.be/id",
+			},
+		},
+		{
+			Name: "Unsupported",
+			Text: "tg://resolve mailto:a@b.c",
+			Entities: []tg.MessageEntityClass{
+				&tg.MessageEntityTextURL{Offset: 0, Length: 12, URL: "tg://resolve"},
+				&tg.MessageEntityURL{Offset: 10, Length: 100},
+			},
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Links, Extract(tt.Text, tt.Entities))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package media
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Chapter of media.
+type Chapter struct {
+	Start time.Duration
+	// End of chapter, start of the next chapter if zero.
+	End   time.Duration
+	Title string
+}
+
+// clipChapters returns chapters of fragment from start to end, where zero
+// end is the end of media, with positions relative to start.
+func clipChapters(chapters []Chapter, start, end time.Duration) []Chapter {
+	var out []Chapter
+	for _, c := range fillChapterEnds(chapters) {
+		if c.End <= start || (end > 0 && c.Start >= end) {
+			continue
+		}
+		c.Start = max(c.Start, start) - start
+		if end > 0 {
+			c.End = min(c.End, end)
+		}
+		c.End -= start
+		out = append(out, c)
+	}
+	return out
+}
+
+// fillChapterEnds sets missing ends of chapters to start of the next one.
+func fillChapterEnds(chapters []Chapter) []Chapter {
+	out := make([]Chapter, 0, len(chapters))
+	for i, c := range chapters {
+		if c.End <= c.Start && i+1 < len(chapters) {
+			c.End = chapters[i+1].Start
+		}
+		if c.End <= c.Start {
+			// Last chapter without end can not be written.
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// escapeMetadata escapes special characters of ffmetadata format.
+var escapeMetadata = strings.NewReplacer(
+	`\`, `\\`,
+	"=", `\=`,
+	";", `\;`,
+	"#", `\#`,
+	"\n", "\\\n",
+)
+
+// formatChapters returns chapters in ffmetadata format.
+func formatChapters(chapters []Chapter) string {
+	var b strings.Builder
+	b.WriteString(";FFMETADATA1\n")
+	for _, c := range fillChapterEnds(chapters) {
+		b.WriteString("[CHAPTER]\nTIMEBASE=1/1000\n")
+		fmt.Fprintf(&b, "START=%d\nEND=%d\n", c.Start.Milliseconds(), c.End.Milliseconds())
+		fmt.Fprintf(&b, "title=%s\n", escapeMetadata.Replace(c.Title))
+	}
+	return b.String()
+}
+
+// chaptersInput writes chapters to ffmetadata file next to output and
+// returns it as input that is removed by cleanup.
+func chaptersInput(output string, chapters []Chapter) (in input, cleanup func(), err error) {
+	path := output + ".chapters"
+	if err := os.WriteFile(path, []byte(formatChapters(chapters)), 0o600); err != nil {
+		return input{}, nil, errors.Wrap(err, "write chapters")
+	}
+	return input{Path: path}, func() { _ = os.Remove(path) }, nil
+}
+
+// metadataArgs returns output arguments that set metadata tags and copy
+// chapters from input with index, if there are any.
+func metadataArgs(metadata map[string]string, chapters []Chapter, index int) []string {
+	var args []string
+	for _, k := range sortedKeys(metadata) {
+		args = append(args, "-metadata", k+"="+metadata[k])
+	}
+	if len(chapters) > 0 {
+		args = append(args, "-map_chapters", strconv.Itoa(index))
+	}
+	return args
+}
@@ -0,0 +1,34 @@
+package media
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClipChapters(t *testing.T) {
+	chapters := []Chapter{
+		{Start: 0, Title: "Intro"},
+		{Start: time.Second * 60, End: time.Second * 120, Title: "Main"},
+		{Start: time.Second * 120, End: time.Second * 180, Title: "Outro"},
+	}
+	require.Equal(t, []Chapter{
+		{Start: 0, End: time.Second * 10, Title: "Intro"},
+		{Start: time.Second * 10, End: time.Second * 40, Title: "Main"},
+	}, clipChapters(chapters, time.Second*50, time.Second*90))
+	require.Equal(t, []Chapter{
+		{Start: 0, End: time.Second * 30, Title: "Outro"},
+	}, clipChapters(chapters, time.Second*150, 0))
+}
+
+func TestFormatChapters(t *testing.T) {
+	require.Equal(t, ";FFMETADATA1\n"+
+		"[CHAPTER]\nTIMEBASE=1/1000\nSTART=0\nEND=1500\ntitle=Intro\\; \\=1\n"+
+		"[CHAPTER]\nTIMEBASE=1/1000\nSTART=1500\nEND=2000\ntitle=Main\n",
+		formatChapters([]Chapter{
+			{Title: "Intro; =1"},
+			{Start: time.Millisecond * 1500, End: time.Second * 2, Title: "Main"},
+		}),
+	)
+}
@@ -0,0 +1,157 @@
+package media
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-faster/errors"
+)
+
+// Client is platform of telegram client.
+type Client string
+
+// Telegram clients.
+const (
+	Android Client = "android"
+	IOS     Client = "ios"
+	Desktop Client = "desktop"
+	Web     Client = "web"
+)
+
+// Clients are every telegram client platform.
+var Clients = []Client{Android, IOS, Desktop, Web}
+
+// compat is compatibility matrix of codecs in mp4 container with telegram
+// clients, keyed by ffprobe codec name. Codecs that are not listed are
+// not played by any client.
+var compat = map[string][]Client{
+	// Video.
+	"h264": {Android, IOS, Desktop, Web},
+	"hevc": {Android, IOS, Desktop},
+	"vp9":  {Android, Desktop, Web},
+	"av1":  {Android, Desktop, Web},
+
+	// Audio.
+	"aac":    {Android, IOS, Desktop, Web},
+	"mp3":    {Android, IOS, Desktop, Web},
+	"opus":   {Android, Desktop, Web},
+	"vorbis": {Android, Desktop},
+}
+
+// Plays reports whether client plays codec in mp4 container.
+func Plays(client Client, codec string) bool {
+	for _, c := range compat[codec] {
+		if c == client {
+			return true
+		}
+	}
+	return false
+}
+
+// Compatible reports whether every client plays codec in mp4 container.
+func Compatible(codec string) bool {
+	for _, c := range Clients {
+		if !Plays(c, codec) {
+			return false
+		}
+	}
+	return true
+}
+
+// Playable reports whether any client plays codec in mp4 container.
+func Playable(codec string) bool {
+	return len(compat[codec]) > 0
+}
+
+// Conversion is a way to make media playable by every client.
+type Conversion string
+
+const (
+	// ConvertCopy copies streams to mp4 as is.
+	ConvertCopy Conversion = "copy"
+	// ConvertAudio transcodes audio to AAC, copying video.
+	ConvertAudio Conversion = "audio"
+	// ConvertVideo transcodes video to H.264 and audio to AAC if needed.
+	ConvertVideo Conversion = "video"
+)
+
+// Decide returns conversion of video and audio codecs, where empty codec
+// means that there is no such stream.
+func Decide(videoCodec, audioCodec string) Conversion {
+	switch {
+	case videoCodec != "" && !Compatible(videoCodec):
+		return ConvertVideo
+	case audioCodec != "" && !Compatible(audioCodec):
+		return ConvertAudio
+	default:
+		return ConvertCopy
+	}
+}
+
+// ConvertOptions are options of ConvertMP4.
+type ConvertOptions struct {
+	Inputs []string
+	Output string
+	// Metadata tags of output, like title.
+	Metadata map[string]string
+	// Chapters of output, chapters of first input are kept if empty.
+	Chapters []Chapter
+	// KeepVideo copies video that is played only by some clients, like
+	// codec chosen by user, instead of converting it to H.264.
+	KeepVideo bool
+}
+
+// ConvertMP4 writes first video and first audio stream of inputs to mp4
+// output that is playable by every client, re-encoding only streams that
+// are not compatible.
+func (p *Pipeline) ConvertMP4(ctx context.Context, opt ConvertOptions) (Conversion, error) {
+	var (
+		maps                   []string
+		videoCodec, audioCodec string
+	)
+	for i, in := range opt.Inputs {
+		info, err := p.Probe(ctx, in)
+		if err != nil {
+			return "", errors.Wrapf(err, "probe %s", in)
+		}
+		if videoCodec == "" && info.HasVideo() {
+			videoCodec = info.VideoCodec
+			maps = append(maps, strconv.Itoa(i)+":v:0")
+		}
+		if audioCodec == "" && info.HasAudio() {
+			audioCodec = info.AudioCodec
+			maps = append(maps, strconv.Itoa(i)+":a:0")
+		}
+	}
+
+	transcode := TranscodeOptions{
+		Inputs:     opt.Inputs,
+		Output:     opt.Output,
+		Maps:       maps,
+		VideoCodec: Copy,
+		AudioCodec: Copy,
+		Metadata:   opt.Metadata,
+		Chapters:   opt.Chapters,
+		Format:     "mp4",
+		FastStart:  true,
+	}
+	if audioCodec != "" && !Compatible(audioCodec) {
+		transcode.AudioCodec = AAC
+		transcode.AudioBitrate = 192_000
+	}
+	conversion := Decide(videoCodec, audioCodec)
+	if conversion == ConvertVideo && opt.KeepVideo && Playable(videoCodec) {
+		conversion = Decide("", audioCodec)
+	}
+	if conversion == ConvertVideo {
+		transcode.VideoCodec = H264
+		transcode.Preset = "veryfast"
+		// 10-bit sources can not be played as H.264.
+		transcode.Args = []string{"-crf", "23", "-pix_fmt", "yuv420p"}
+	}
+	if err := p.Transcode(ctx, transcode); err != nil {
+		return "", errors.Wrap(err, "convert")
+	}
+
+	return conversion, nil
+}
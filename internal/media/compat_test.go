@@ -0,0 +1,41 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecide(t *testing.T) {
+	for _, tt := range []struct {
+		Video, Audio string
+		Conversion   Conversion
+	}{
+		{"h264", "aac", ConvertCopy},
+		{"h264", "mp3", ConvertCopy},
+		{"h264", "", ConvertCopy},
+		{"", "aac", ConvertCopy},
+		{"h264", "opus", ConvertAudio},
+		{"", "opus", ConvertAudio},
+		{"vp9", "aac", ConvertVideo},
+		{"vp9", "opus", ConvertVideo},
+		{"av1", "opus", ConvertVideo},
+		{"hevc", "aac", ConvertVideo},
+		{"unknown", "aac", ConvertVideo},
+	} {
+		require.Equal(t, tt.Conversion, Decide(tt.Video, tt.Audio), "%s+%s", tt.Video, tt.Audio)
+	}
+}
+
+func TestPlays(t *testing.T) {
+	require.True(t, Plays(IOS, "h264"))
+	require.False(t, Plays(IOS, "vp9"))
+	require.True(t, Plays(Web, "vp9"))
+	require.False(t, Plays(Web, "unknown"))
+}
+
+func TestPlayable(t *testing.T) {
+	require.True(t, Playable("vp9"))
+	require.True(t, Playable("av1"))
+	require.False(t, Playable("unknown"))
+}
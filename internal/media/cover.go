@@ -0,0 +1,48 @@
+package media
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"image/jpeg"
+
+	"github.com/go-faster/errors"
+)
+
+// PictureTag is vorbis comment that holds cover art in ogg, like opus,
+// which has no attached picture streams.
+const PictureTag = "METADATA_BLOCK_PICTURE"
+
+// pictureMaxSize limits cover in PictureTag, because tag is passed to ffmpeg
+// as argument.
+const pictureMaxSize = 64 << 10
+
+// PictureComment returns value of PictureTag with JPEG cover, which is
+// FLAC picture block in base64.
+func PictureComment(cover []byte) (string, error) {
+	if len(cover) > pictureMaxSize {
+		return "", errors.Errorf("cover is %d bytes, more than %d", len(cover), pictureMaxSize)
+	}
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(cover))
+	if err != nil {
+		return "", errors.Wrap(err, "decode jpeg")
+	}
+
+	const (
+		frontCover = 3
+		mime       = "image/jpeg"
+		depth      = 24
+	)
+	b := binary.BigEndian.AppendUint32(nil, frontCover)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(mime)))
+	b = append(b, mime...)
+	b = binary.BigEndian.AppendUint32(b, 0) // Description.
+	b = binary.BigEndian.AppendUint32(b, uint32(cfg.Width))
+	b = binary.BigEndian.AppendUint32(b, uint32(cfg.Height))
+	b = binary.BigEndian.AppendUint32(b, depth)
+	b = binary.BigEndian.AppendUint32(b, 0) // Colors of indexed image.
+	b = binary.BigEndian.AppendUint32(b, uint32(len(cover)))
+	b = append(b, cover...)
+
+	return base64.StdEncoding.EncodeToString(b), nil
+}
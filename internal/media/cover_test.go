@@ -0,0 +1,44 @@
+package media
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPictureComment(t *testing.T) {
+	var cover bytes.Buffer
+	require.NoError(t, jpeg.Encode(&cover, image.NewRGBA(image.Rect(0, 0, 32, 24)), nil))
+
+	tag, err := PictureComment(cover.Bytes())
+	require.NoError(t, err)
+	b, err := base64.StdEncoding.DecodeString(tag)
+	require.NoError(t, err)
+
+	u32 := func() uint32 {
+		v := binary.BigEndian.Uint32(b)
+		b = b[4:]
+		return v
+	}
+	require.Equal(t, uint32(3), u32())
+	n := u32()
+	require.Equal(t, "image/jpeg", string(b[:n]))
+	b = b[n:]
+	require.Zero(t, u32())
+	require.Equal(t, uint32(32), u32())
+	require.Equal(t, uint32(24), u32())
+	require.Equal(t, uint32(24), u32())
+	require.Zero(t, u32())
+	require.Equal(t, uint32(cover.Len()), u32())
+	require.Equal(t, cover.Bytes(), b)
+
+	_, err = PictureComment([]byte("not jpeg"))
+	require.Error(t, err)
+	_, err = PictureComment(make([]byte, pictureMaxSize+1))
+	require.Error(t, err)
+}
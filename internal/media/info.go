@@ -0,0 +1,89 @@
+package media
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/ernado/ff/ffmpeg"
+	"github.com/ernado/ff/ffprobe"
+	"github.com/go-faster/errors"
+)
+
+// Info about media file.
+type Info struct {
+	Duration time.Duration
+	// Size of file in bytes, zero if unknown.
+	Size int64
+
+	// Codecs of first video and audio streams, empty if there is no
+	// such stream. Attached pictures like cover art are not video.
+	VideoCodec string
+	AudioCodec string
+
+	Width  int
+	Height int
+
+	// Tags are metadata tags of container, like title.
+	Tags map[string]string
+
+	Probe *ffmpeg.Probe
+}
+
+// HasVideo reports whether media has video stream.
+func (i *Info) HasVideo() bool {
+	return i.VideoCodec != ""
+}
+
+// HasAudio reports whether media has audio stream.
+func (i *Info) HasAudio() bool {
+	return i.AudioCodec != ""
+}
+
+// NewInfo parses Info from probe.
+func NewInfo(probe *ffmpeg.Probe) (*Info, error) {
+	summary, err := ffprobe.ParseSummary(probe)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse summary")
+	}
+
+	info := &Info{
+		Duration: summary.Duration,
+		Probe:    probe,
+	}
+	if len(probe.Raw) > 0 {
+		// Typed probe has only some of tags.
+		var raw struct {
+			Format struct {
+				Tags map[string]string `json:"tags"`
+			} `json:"format"`
+		}
+		if err := json.Unmarshal(probe.Raw, &raw); err != nil {
+			return nil, errors.Wrap(err, "parse tags")
+		}
+		info.Tags = raw.Format.Tags
+	}
+	if probe.Format.Size != "" {
+		if info.Size, err = strconv.ParseInt(probe.Format.Size, 10, 64); err != nil {
+			return nil, errors.Wrap(err, "parse size")
+		}
+	}
+	for _, s := range probe.Streams {
+		switch s.CodecType {
+		case "video":
+			if info.VideoCodec != "" || s.Disposition.AttachedPic != 0 {
+				continue
+			}
+			info.VideoCodec = s.CodecName
+			info.Width = s.Width
+			info.Height = s.Height
+		case "audio":
+			if info.AudioCodec != "" {
+				continue
+			}
+			info.AudioCodec = s.CodecName
+		}
+	}
+
+	return info, nil
+}
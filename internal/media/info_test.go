@@ -0,0 +1,45 @@
+package media
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ernado/ff/ffmpeg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInfo(t *testing.T) {
+	info, err := NewInfo(&ffmpeg.Probe{
+		Streams: []ffmpeg.ProbeStream{
+			{
+				CodecType:   "video",
+				CodecName:   "mjpeg",
+				Width:       320,
+				Height:      320,
+				Disposition: ffmpeg.ProbeDisposition{AttachedPic: 1},
+			},
+			{CodecType: "audio", CodecName: "aac", Duration: "12.5"},
+			{CodecType: "video", CodecName: "h264", Width: 1280, Height: 720, Duration: "12.4"},
+			{CodecType: "audio", CodecName: "opus"},
+		},
+		Format: ffmpeg.ProbeFormat{Size: "1024", Duration: "12.5"},
+		Raw:    []byte(`{"format":{"tags":{"title":"Title","artist":"Artist"}}}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Millisecond*12500, info.Duration)
+	require.Equal(t, int64(1024), info.Size)
+	require.Equal(t, "h264", info.VideoCodec)
+	require.Equal(t, "aac", info.AudioCodec)
+	require.Equal(t, 1280, info.Width)
+	require.Equal(t, 720, info.Height)
+	require.True(t, info.HasVideo())
+	require.True(t, info.HasAudio())
+	require.Equal(t, map[string]string{"title": "Title", "artist": "Artist"}, info.Tags)
+
+	info, err = NewInfo(&ffmpeg.Probe{
+		Streams: []ffmpeg.ProbeStream{{CodecType: "audio", CodecName: "mp3"}},
+	})
+	require.NoError(t, err)
+	require.False(t, info.HasVideo())
+	require.True(t, info.HasAudio())
+}
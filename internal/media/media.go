@@ -0,0 +1,158 @@
+// Package media implements typed ffmpeg operations on top of ffrun.
+package media
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/ernado/ff/ffmpeg"
+	"github.com/ernado/ff/ffrun"
+	"github.com/go-faster/errors"
+)
+
+// Options of Pipeline.
+type Options struct {
+	FF *ffrun.Instance
+	// FFprobe is binary of ffprobe used to list keyframes, "ffprobe" by
+	// default.
+	FFprobe string
+	// Concurrency limits count of concurrent ffmpeg processes, runtime.NumCPU()
+	// by default.
+	Concurrency int
+}
+
+func (o *Options) setDefaults() {
+	if o.FF == nil {
+		o.FF = ffrun.New(ffrun.Options{})
+	}
+	if o.FFprobe == "" {
+		o.FFprobe = "ffprobe"
+	}
+	if o.Concurrency == 0 {
+		o.Concurrency = runtime.NumCPU()
+	}
+}
+
+// Pipeline runs media operations, limiting concurrent ffmpeg processes.
+type Pipeline struct {
+	ff      *ffrun.Instance
+	ffprobe string
+	sem     chan struct{}
+}
+
+// New creates new Pipeline.
+func New(opt Options) *Pipeline {
+	opt.setDefaults()
+
+	return &Pipeline{
+		ff:      opt.FF,
+		ffprobe: opt.FFprobe,
+		sem:     make(chan struct{}, opt.Concurrency),
+	}
+}
+
+func (p *Pipeline) acquire(ctx context.Context) error {
+	select {
+	case p.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *Pipeline) release() {
+	<-p.sem
+}
+
+// Probe returns info about media file.
+func (p *Pipeline) Probe(ctx context.Context, path string) (*Info, error) {
+	probe, err := p.probe(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return NewInfo(probe)
+}
+
+func (p *Pipeline) probe(ctx context.Context, path string) (*ffmpeg.Probe, error) {
+	if err := p.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer p.release()
+
+	probe, err := p.ff.Probe(ctx, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "probe")
+	}
+	return probe, nil
+}
+
+// input of ffmpeg with arguments like seek that apply to it.
+type input struct {
+	Path string
+	Args []string
+}
+
+// inputsOf returns inputs of paths without arguments.
+func inputsOf(paths ...string) []input {
+	inputs := make([]input, 0, len(paths))
+	for _, path := range paths {
+		inputs = append(inputs, input{Path: path})
+	}
+	return inputs
+}
+
+// run runs ffmpeg with inputs, where first input is probed for progress
+// unless probe is set.
+func (p *Pipeline) run(
+	ctx context.Context,
+	inputs []input,
+	args []string,
+	output string,
+	probe *ffmpeg.Probe,
+	progress func(Progress),
+) error {
+	if len(inputs) == 0 {
+		return errors.New("no inputs")
+	}
+	if probe == nil {
+		var err error
+		if probe, err = p.probe(ctx, inputs[0].Path); err != nil {
+			return errors.Wrap(err, "probe input")
+		}
+	}
+	info, err := NewInfo(probe)
+	if err != nil {
+		return errors.Wrap(err, "input info")
+	}
+
+	last := inputs[len(inputs)-1]
+	opt := ffrun.RunOptions{
+		// ffrun puts input arguments before the main input, so every
+		// other input goes there too, keeping order of inputs.
+		Input:     last.Path,
+		InputArgs: append(joinInputs(inputs[:len(inputs)-1]), last.Args...),
+		Args:      args,
+		Output:    output,
+		Probe:     probe,
+	}
+	if progress != nil {
+		t := newProgressTracker(info.Duration, progress)
+		opt.Progress = t.Handle
+	}
+
+	if err := p.acquire(ctx); err != nil {
+		return err
+	}
+	defer p.release()
+
+	return p.ff.Run(ctx, opt)
+}
+
+func joinInputs(inputs []input) []string {
+	var args []string
+	for _, in := range inputs {
+		args = append(args, in.Args...)
+		args = append(args, "-i", in.Path)
+	}
+	return args
+}
@@ -0,0 +1,454 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ernado/ff/ffmpeg"
+	"github.com/go-faster/errors"
+)
+
+// Codecs of ffmpeg.
+const (
+	Copy = "copy"
+	H264 = "libx264"
+	AAC  = "aac"
+	Opus = "libopus"
+	MP3  = "libmp3lame"
+)
+
+// TranscodeOptions are options of Transcode.
+type TranscodeOptions struct {
+	Inputs []string
+	Output string
+	// Maps select streams, like "0:v" or "1:a". Every input is mapped
+	// if empty and there are multiple inputs.
+	Maps []string
+
+	// Codecs, ffmpeg default for container if empty.
+	VideoCodec string
+	AudioCodec string
+	// Bitrates in bits per second, codec default if zero.
+	VideoBitrate int64
+	AudioBitrate int64
+	// Preset of video encoder, like "medium".
+	Preset string
+
+	// Pass of two-pass encoding, zero for single pass. First pass
+	// only writes PassLogFile and drops audio.
+	Pass        int
+	PassLogFile string
+
+	// Metadata tags of output, like title.
+	Metadata map[string]string
+	// Chapters of output, chapters of first input are kept if empty.
+	Chapters []Chapter
+	// Format of output, guessed by extension if empty.
+	Format string
+	// FastStart moves index to the beginning of mp4, so playback can
+	// start before whole file is downloaded.
+	FastStart bool
+	// Args are additional output arguments.
+	Args []string
+
+	Progress func(Progress)
+}
+
+func (o TranscodeOptions) output() string {
+	if o.Pass == 1 {
+		return os.DevNull
+	}
+	return o.Output
+}
+
+func (o TranscodeOptions) args() []string {
+	var args []string
+	maps := o.Maps
+	if len(maps) == 0 && len(o.Inputs) > 1 {
+		for i := range o.Inputs {
+			maps = append(maps, strconv.Itoa(i))
+		}
+	}
+	for _, m := range maps {
+		args = append(args, "-map", m)
+	}
+	if o.VideoCodec != "" {
+		args = append(args, "-c:v", o.VideoCodec)
+	}
+	if o.Preset != "" {
+		args = append(args, "-preset", o.Preset)
+	}
+	if o.VideoBitrate > 0 {
+		args = append(args, "-b:v", strconv.FormatInt(o.VideoBitrate, 10))
+	}
+	if o.Pass > 0 {
+		args = append(args, "-pass", strconv.Itoa(o.Pass))
+		if o.PassLogFile != "" {
+			args = append(args, "-passlogfile", o.PassLogFile)
+		}
+	}
+	if o.Pass == 1 {
+		return append(args, "-an", "-f", "null")
+	}
+
+	if o.AudioCodec != "" {
+		args = append(args, "-c:a", o.AudioCodec)
+	}
+	if o.AudioBitrate > 0 {
+		args = append(args, "-b:a", strconv.FormatInt(o.AudioBitrate, 10))
+	}
+
+	// Chapters are read from additional input after inputs.
+	args = append(args, metadataArgs(o.Metadata, o.Chapters, len(o.Inputs))...)
+	args = append(args, o.Args...)
+	if o.FastStart {
+		args = append(args, "-movflags", "+faststart")
+	}
+	if o.Format != "" {
+		args = append(args, "-f", o.Format)
+	}
+
+	return args
+}
+
+// Transcode converts inputs to output.
+func (p *Pipeline) Transcode(ctx context.Context, opt TranscodeOptions) error {
+	inputs := inputsOf(opt.Inputs...)
+	if len(opt.Chapters) > 0 && opt.Pass != 1 {
+		in, cleanup, err := chaptersInput(opt.Output, opt.Chapters)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		inputs = append(inputs, in)
+	}
+	if err := p.run(ctx, inputs, opt.args(), opt.output(), nil, opt.Progress); err != nil {
+		return errors.Wrap(err, "transcode")
+	}
+	return nil
+}
+
+// Remux copies streams of inputs to mp4 output without re-encoding.
+func (p *Pipeline) Remux(ctx context.Context, output string, inputs ...string) error {
+	if err := p.Transcode(ctx, TranscodeOptions{
+		Inputs:     inputs,
+		Output:     output,
+		VideoCodec: Copy,
+		AudioCodec: Copy,
+		Format:     "mp4",
+		FastStart:  true,
+	}); err != nil {
+		return errors.Wrap(err, "remux")
+	}
+	return nil
+}
+
+// ExtractFrame saves frame of video at position as image, scaling it down
+// to fit square of maxSide if it is positive.
+func (p *Pipeline) ExtractFrame(ctx context.Context, path, output string, at time.Duration, maxSide int) error {
+	args := []string{"-frames:v", "1"}
+	if maxSide > 0 {
+		args = append(args, "-vf", fmt.Sprintf(
+			"scale='min(iw,%[1]d)':'min(ih,%[1]d)':force_original_aspect_ratio=decrease", maxSide,
+		))
+	}
+	in := input{Path: path}
+	if at > 0 {
+		in.Args = []string{"-ss", formatDuration(at)}
+	}
+	if err := p.run(ctx, []input{in}, args, output, nil, nil); err != nil {
+		return errors.Wrap(err, "extract frame")
+	}
+	return nil
+}
+
+// CutOptions are options of Cut.
+type CutOptions struct {
+	Input  string
+	Output string
+	Start  time.Duration
+	// End of fragment, end of input if zero.
+	End time.Duration
+	// Copy streams instead of re-encoding. Fragment then starts at
+	// keyframe before Start, but cutting is fast and lossless.
+	Copy bool
+
+	Progress func(Progress)
+}
+
+// Cut extracts fragment of input.
+func (p *Pipeline) Cut(ctx context.Context, opt CutOptions) error {
+	in := input{Path: opt.Input}
+	if opt.Start > 0 {
+		in.Args = []string{"-ss", formatDuration(opt.Start)}
+	}
+	var args []string
+	if opt.End > 0 {
+		if opt.End <= opt.Start {
+			return errors.Errorf("end %s is not after start %s", opt.End, opt.Start)
+		}
+		args = append(args, "-t", formatDuration(opt.End-opt.Start))
+	}
+	if opt.Copy {
+		args = append(args, "-c", Copy, "-avoid_negative_ts", "make_zero")
+	} else {
+		args = append(args, "-c:v", H264, "-c:a", AAC)
+	}
+	args = append(args, "-movflags", "+faststart")
+
+	if err := p.run(ctx, []input{in}, args, opt.Output, nil, opt.Progress); err != nil {
+		return errors.Wrap(err, "cut")
+	}
+	return nil
+}
+
+// ClipInput is input of Clip that is part of original media.
+type ClipInput struct {
+	Path string
+	// Offset is position in original media where input starts.
+	Offset time.Duration
+}
+
+// ClipOptions are options of Clip.
+type ClipOptions struct {
+	// Inputs are streams of the same media, like video and audio, that can
+	// start at different positions.
+	Inputs []ClipInput
+	Output string
+	// Start and End are positions in original media, End is the end of
+	// media if zero. Fragment is also limited by maximum duration of
+	// target.
+	Start time.Duration
+	End   time.Duration
+	// Target is kind of output, video by default.
+	Target Target
+	// Metadata tags of output, like title.
+	Metadata map[string]string
+	// Chapters of original media, only ones in fragment are kept.
+	Chapters []Chapter
+
+	Progress func(Progress)
+}
+
+// duration returns duration of fragment, zero if it lasts to the end of
+// media.
+func (o ClipOptions) duration() time.Duration {
+	var d time.Duration
+	if o.End > 0 {
+		d = o.End - o.Start
+	}
+	if limit := o.Target.MaxDuration(); limit > 0 && (d == 0 || d > limit) {
+		d = limit
+	}
+	return d
+}
+
+func (o ClipOptions) args(videoBitrate int64) []string {
+	var args []string
+	for i := range o.Inputs {
+		args = append(args, "-map", strconv.Itoa(i))
+	}
+	if d := o.duration(); d > 0 {
+		args = append(args, "-t", formatDuration(d))
+	}
+	args = append(args, metadataArgs(o.Metadata, o.chapters(), len(o.Inputs))...)
+	return append(args, o.Target.args(videoBitrate)...)
+}
+
+// chapters returns chapters of fragment.
+func (o ClipOptions) chapters() []Chapter {
+	if o.Target == TargetSticker {
+		// Webm sticker is too short for chapters.
+		return nil
+	}
+	var end time.Duration
+	if d := o.duration(); d > 0 {
+		end = o.Start + d
+	}
+	return clipChapters(o.Chapters, o.Start, end)
+}
+
+// Clip extracts fragment of media from inputs, re-encoding it to target,
+// so fragment starts exactly at Start and not at keyframe.
+//
+// H.264 video is re-encoded only before the first and after the last
+// keyframe of fragment, the rest is copied. Sticker is re-encoded with lower
+// bitrate until it fits StickerMaxSize.
+func (p *Pipeline) Clip(ctx context.Context, opt ClipOptions) error {
+	if opt.End > 0 && opt.End <= opt.Start {
+		return errors.Errorf("end %s is not after start %s", opt.End, opt.Start)
+	}
+	if opt.Target == TargetVideo {
+		ok, err := p.smartClip(ctx, opt)
+		if err != nil {
+			return errors.Wrap(err, "clip")
+		}
+		if ok {
+			return nil
+		}
+	}
+	inputs := make([]input, 0, len(opt.Inputs))
+	for _, in := range opt.Inputs {
+		var args []string
+		// Seek is relative to the start of input.
+		if seek := opt.Start - in.Offset; seek > 0 {
+			args = []string{"-ss", formatDuration(seek)}
+		}
+		inputs = append(inputs, input{Path: in.Path, Args: args})
+	}
+	if len(inputs) == 0 {
+		return errors.New("no inputs")
+	}
+	if chapters := opt.chapters(); len(chapters) > 0 {
+		in, cleanup, err := chaptersInput(opt.Output, chapters)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		inputs = append(inputs, in)
+	}
+
+	var probe *ffmpeg.Probe
+	if d := opt.duration(); d > 0 {
+		// Progress is tracked against duration of fragment, not input.
+		probe = &ffmpeg.Probe{
+			Format: ffmpeg.ProbeFormat{Duration: formatDuration(d)},
+		}
+	}
+
+	var bitrate int64
+	for attempt := 0; ; attempt++ {
+		if err := p.run(ctx, inputs, opt.args(bitrate), opt.Output, probe, opt.Progress); err != nil {
+			return errors.Wrap(err, "clip")
+		}
+		if opt.Target != TargetSticker {
+			return nil
+		}
+		stat, err := os.Stat(opt.Output)
+		if err != nil {
+			return errors.Wrap(err, "stat")
+		}
+		if stat.Size() <= StickerMaxSize {
+			return nil
+		}
+		if attempt == 2 {
+			return errors.Errorf("sticker is %d bytes, more than limit", stat.Size())
+		}
+		if bitrate == 0 {
+			bitrate = stickerBitrate
+		}
+		// Encoder overshoots bitrate, so it is lowered proportionally
+		// with margin.
+		bitrate = bitrate * StickerMaxSize / stat.Size() * 8 / 10
+	}
+}
+
+// Concat joins inputs with the same codecs into output without re-encoding.
+func (p *Pipeline) Concat(ctx context.Context, output string, inputs ...string) error {
+	if len(inputs) == 0 {
+		return errors.New("no inputs")
+	}
+
+	var (
+		list     strings.Builder
+		duration time.Duration
+	)
+	for _, in := range inputs {
+		info, err := p.Probe(ctx, in)
+		if err != nil {
+			return errors.Wrapf(err, "probe %s", in)
+		}
+		duration += info.Duration
+
+		abs, err := filepath.Abs(in)
+		if err != nil {
+			return errors.Wrap(err, "abs")
+		}
+		// Quote as concat demuxer expects.
+		fmt.Fprintf(&list, "file '%s'\n", strings.ReplaceAll(abs, "'", `'\''`))
+	}
+
+	listPath := output + ".txt"
+	if err := os.WriteFile(listPath, []byte(list.String()), 0o600); err != nil {
+		return errors.Wrap(err, "write list")
+	}
+	defer func() { _ = os.Remove(listPath) }()
+
+	// List itself can not be probed, so probe is made up from total
+	// duration of inputs.
+	probe := &ffmpeg.Probe{
+		Format: ffmpeg.ProbeFormat{Duration: strconv.FormatFloat(duration.Seconds(), 'f', 3, 64)},
+	}
+	args := []string{"-c", Copy, "-movflags", "+faststart"}
+	in := input{Path: listPath, Args: []string{"-f", "concat", "-safe", "0"}}
+	if err := p.run(ctx, []input{in}, args, output, probe, nil); err != nil {
+		return errors.Wrap(err, "concat")
+	}
+	return nil
+}
+
+// Segment splits input into independently playable parts of about segment
+// duration, cut on keyframes without re-encoding. Parts are written to dir
+// as part-000.mp4, part-001.mp4 and so on, with extension of input. Cover
+// art is not kept.
+//
+// Returns paths of parts in order.
+func (p *Pipeline) Segment(ctx context.Context, path, dir string, segment time.Duration) ([]string, error) {
+	ext := filepath.Ext(path)
+	pattern := filepath.Join(dir, "part-*"+ext)
+
+	// Remove parts left by previous run.
+	stale, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, errors.Wrap(err, "glob")
+	}
+	for _, path := range stale {
+		if err := os.Remove(path); err != nil {
+			return nil, errors.Wrap(err, "remove stale part")
+		}
+	}
+
+	// Cover art is attached picture, which is not video for "V".
+	args := []string{
+		"-map", "0:V?",
+		"-map", "0:a?",
+		"-c", Copy,
+		"-f", "segment",
+		"-segment_time", formatDuration(segment),
+		"-reset_timestamps", "1",
+	}
+	if ext == ".mp4" || ext == ".m4a" {
+		args = append(args, "-segment_format_options", "movflags=+faststart")
+	}
+	if err := p.run(ctx, inputsOf(path), args, filepath.Join(dir, "part-%03d"+ext), nil, nil); err != nil {
+		return nil, errors.Wrap(err, "segment")
+	}
+
+	parts, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, errors.Wrap(err, "glob")
+	}
+	sort.Strings(parts)
+
+	return parts, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// formatDuration formats d as seconds for ffmpeg.
+func formatDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
@@ -0,0 +1,474 @@
+package media
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranscodeOptionsArgs(t *testing.T) {
+	for _, tt := range []struct {
+		Name    string
+		Options TranscodeOptions
+		Args    []string
+		Output  string
+	}{
+		{
+			Name: "Remux",
+			Options: TranscodeOptions{
+				Inputs:     []string{"video", "audio"},
+				Output:     "out.mp4",
+				VideoCodec: Copy,
+				AudioCodec: Copy,
+				Format:     "mp4",
+				FastStart:  true,
+			},
+			Args: []string{
+				"-map", "0", "-map", "1",
+				"-c:v", "copy", "-c:a", "copy",
+				"-movflags", "+faststart",
+				"-f", "mp4",
+			},
+			Output: "out.mp4",
+		},
+		{
+			Name: "FirstPass",
+			Options: TranscodeOptions{
+				Inputs:       []string{"in.mp4"},
+				Output:       "out.mp4",
+				VideoCodec:   H264,
+				VideoBitrate: 1000,
+				AudioCodec:   AAC,
+				Pass:         1,
+				PassLogFile:  "pass",
+			},
+			Args: []string{
+				"-c:v", "libx264", "-b:v", "1000",
+				"-pass", "1", "-passlogfile", "pass",
+				"-an", "-f", "null",
+			},
+			Output: os.DevNull,
+		},
+		{
+			Name: "Audio",
+			Options: TranscodeOptions{
+				Inputs:     []string{"audio", "cover.jpg"},
+				Output:     "out.mp3",
+				Maps:       []string{"0:a", "1:v"},
+				AudioCodec: MP3,
+				Metadata:   map[string]string{"title": "Title", "artist": "Artist"},
+				Args:       []string{"-disposition:v", "attached_pic"},
+			},
+			Args: []string{
+				"-map", "0:a", "-map", "1:v",
+				"-c:a", "libmp3lame",
+				"-metadata", "artist=Artist",
+				"-metadata", "title=Title",
+				"-disposition:v", "attached_pic",
+			},
+			Output: "out.mp3",
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Args, tt.Options.args())
+			require.Equal(t, tt.Output, tt.Options.output())
+		})
+	}
+}
+
+func TestClipOptionsArgs(t *testing.T) {
+	opt := ClipOptions{
+		Inputs: []ClipInput{{Path: "video", Offset: time.Second * 75}, {Path: "audio"}},
+		Output: "out.mp4",
+		Start:  time.Second * 80,
+		End:    time.Second * 185,
+	}
+	require.Equal(t, []string{
+		"-map", "0", "-map", "1",
+		"-t", "105.000",
+		"-c:v", "libx264", "-preset", "veryfast", "-crf", "23", "-pix_fmt", "yuv420p",
+		"-c:a", "aac", "-b:a", "192k",
+		"-movflags", "+faststart",
+	}, opt.args(0))
+
+	opt.Target = TargetSticker
+	require.Equal(t, []string{
+		"-map", "0", "-map", "1",
+		"-t", "3.000",
+		"-an",
+		"-vf", "scale='if(gte(iw,ih),512,-1)':'if(gte(iw,ih),-1,512)',fps=30",
+		"-c:v", "libvpx-vp9", "-pix_fmt", "yuva420p", "-b:v", "629145",
+		"-f", "webm",
+	}, opt.args(0))
+}
+
+// EnvFFmpeg requires ffmpeg for tests if set, like in CI, so tests that
+// use it fail instead of being skipped.
+const EnvFFmpeg = "TEST_FFMPEG"
+
+// requireFFmpeg skips test if ffmpeg is not available.
+func requireFFmpeg(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{"ffmpeg", "ffprobe"} {
+		if _, err := exec.LookPath(name); err != nil {
+			if os.Getenv(EnvFFmpeg) != "" {
+				t.Fatalf("%s not found", name)
+			}
+			t.Skipf("%s not found", name)
+		}
+	}
+}
+
+// generate writes sample file of duration generated by ffmpeg lavfi
+// sources, skipping test if ffmpeg is not available.
+func generate(t *testing.T, name string, args ...string) string {
+	t.Helper()
+	requireFFmpeg(t)
+
+	path := filepath.Join(t.TempDir(), name)
+	args = append([]string{"-hide_banner", "-v", "error", "-y"}, args...)
+	out, err := exec.Command("ffmpeg", append(args, path)...).CombinedOutput()
+	require.NoError(t, err, "%s", out)
+
+	return path
+}
+
+func generateVideo(t *testing.T) string {
+	return generate(t, "video.mp4",
+		"-f", "lavfi", "-i", "testsrc=duration=2:size=160x120:rate=25",
+		"-c:v", "libx264", "-g", "25", "-pix_fmt", "yuv420p",
+	)
+}
+
+func generateAudio(t *testing.T) string {
+	return generate(t, "audio.m4a",
+		"-f", "lavfi", "-i", "sine=frequency=440:duration=2",
+		"-c:a", "aac",
+	)
+}
+
+func requireDuration(t *testing.T, expected, actual time.Duration) {
+	t.Helper()
+	require.InDelta(t, expected.Seconds(), actual.Seconds(), 0.2)
+}
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+	video := generateVideo(t)
+	audio := generateAudio(t)
+	p := New(Options{Concurrency: 2})
+	dir := t.TempDir()
+
+	muxed := filepath.Join(dir, "muxed.mp4")
+	require.NoError(t, p.Remux(ctx, muxed, video, audio))
+
+	info, err := p.Probe(ctx, muxed)
+	require.NoError(t, err)
+	require.Equal(t, "h264", info.VideoCodec)
+	require.Equal(t, "aac", info.AudioCodec)
+	require.Equal(t, 160, info.Width)
+	require.Equal(t, 120, info.Height)
+	requireDuration(t, time.Second*2, info.Duration)
+
+	t.Run("Transcode", func(t *testing.T) {
+		out := filepath.Join(dir, "audio.opus")
+		var last Progress
+		require.NoError(t, p.Transcode(ctx, TranscodeOptions{
+			Inputs:     []string{muxed},
+			Output:     out,
+			Maps:       []string{"0:a"},
+			AudioCodec: Opus,
+			Metadata:   map[string]string{"title": "Sine"},
+			Progress:   func(p Progress) { last = p },
+		}))
+		require.Equal(t, time.Second*2, last.Duration.Round(time.Second))
+
+		info, err := p.Probe(ctx, out)
+		require.NoError(t, err)
+		require.False(t, info.HasVideo())
+		require.Equal(t, "opus", info.AudioCodec)
+	})
+	t.Run("ExtractFrame", func(t *testing.T) {
+		out := filepath.Join(dir, "frame.jpg")
+		require.NoError(t, p.ExtractFrame(ctx, muxed, out, time.Second, 80))
+
+		info, err := p.Probe(ctx, out)
+		require.NoError(t, err)
+		require.Equal(t, 80, info.Width)
+		require.Equal(t, 60, info.Height)
+	})
+	t.Run("ConvertPhoto", func(t *testing.T) {
+		webp := filepath.Join(dir, "frame.webp")
+		require.NoError(t, p.ExtractFrame(ctx, muxed, webp, 0, 0))
+		info, err := p.Probe(ctx, webp)
+		require.NoError(t, err)
+		require.False(t, PhotoCompatible(info))
+
+		out := filepath.Join(dir, "photo.jpg")
+		require.NoError(t, p.ConvertPhoto(ctx, webp, out))
+		info, err = p.Probe(ctx, out)
+		require.NoError(t, err)
+		require.True(t, PhotoCompatible(info))
+		require.Equal(t, 160, info.Width)
+	})
+	t.Run("CutConcat", func(t *testing.T) {
+		first := filepath.Join(dir, "first.mp4")
+		second := filepath.Join(dir, "second.mp4")
+		require.NoError(t, p.Cut(ctx, CutOptions{
+			Input: muxed, Output: first, End: time.Second,
+		}))
+		require.NoError(t, p.Cut(ctx, CutOptions{
+			Input: muxed, Output: second, Start: time.Second,
+		}))
+		for _, path := range []string{first, second} {
+			info, err := p.Probe(ctx, path)
+			require.NoError(t, err)
+			requireDuration(t, time.Second, info.Duration)
+		}
+
+		joined := filepath.Join(dir, "joined.mp4")
+		require.NoError(t, p.Concat(ctx, joined, first, second))
+		info, err := p.Probe(ctx, joined)
+		require.NoError(t, err)
+		requireDuration(t, time.Second*2, info.Duration)
+	})
+	t.Run("Clip", func(t *testing.T) {
+		out := filepath.Join(dir, "clip.mp4")
+		require.NoError(t, p.Clip(ctx, ClipOptions{
+			Inputs: []ClipInput{{Path: video}, {Path: audio}},
+			Output: out,
+			Start:  time.Millisecond * 500,
+			End:    time.Millisecond * 1500,
+		}))
+		info, err := p.Probe(ctx, out)
+		require.NoError(t, err)
+		require.True(t, info.HasVideo())
+		require.True(t, info.HasAudio())
+		requireDuration(t, time.Second, info.Duration)
+	})
+	t.Run("ClipKeyframes", func(t *testing.T) {
+		// Keyframe at 1s splits fragment into encoded head and copied
+		// rest.
+		out := filepath.Join(dir, "clip-keyframes.mp4")
+		require.NoError(t, p.Clip(ctx, ClipOptions{
+			Inputs: []ClipInput{{Path: muxed}},
+			Output: out,
+			Start:  time.Millisecond * 500,
+		}))
+		info, err := p.Probe(ctx, out)
+		require.NoError(t, err)
+		require.Equal(t, "h264", info.VideoCodec)
+		require.True(t, info.HasAudio())
+		requireDuration(t, time.Millisecond*1500, info.Duration)
+	})
+	t.Run("ClipKeyframesEnd", func(t *testing.T) {
+		// Keyframes at 1s and 2s split fragment into encoded head and tail
+		// and copied middle, which is encoded differently than x264 does.
+		video := generate(t, "keyframes.mp4",
+			"-f", "lavfi", "-i", "testsrc=duration=3:size=160x120:rate=25",
+			"-c:v", "libx264", "-g", "25", "-pix_fmt", "yuv420p",
+			"-profile:v", "main", "-preset", "slow", "-x264-params", "cabac=0",
+		)
+		out := filepath.Join(dir, "clip-keyframes-end.mp4")
+		require.NoError(t, p.Clip(ctx, ClipOptions{
+			Inputs: []ClipInput{{Path: video}},
+			Output: out,
+			Start:  time.Millisecond * 500,
+			End:    time.Millisecond * 2500,
+		}))
+		info, err := p.Probe(ctx, out)
+		require.NoError(t, err)
+		require.Equal(t, "h264", info.VideoCodec)
+		requireDuration(t, time.Second*2, info.Duration)
+
+		// Every frame is decoded without errors.
+		decoded, err := exec.Command("ffmpeg", "-v", "error", "-i", out, "-f", "null", "-").CombinedOutput()
+		require.NoError(t, err, "%s", decoded)
+		require.Empty(t, decoded)
+	})
+	t.Run("Targets", func(t *testing.T) {
+		for _, tt := range []struct {
+			Target Target
+			Video  string
+			Audio  bool
+			Width  int
+			Height int
+		}{
+			{TargetAnimation, "h264", false, 160, 120},
+			{TargetVideoNote, "h264", true, VideoNoteSide, VideoNoteSide},
+			{TargetSticker, "vp9", false, StickerSide, 384},
+		} {
+			out := filepath.Join(dir, fmt.Sprintf("target-%d%s", tt.Target, tt.Target.Ext()))
+			require.NoError(t, p.Clip(ctx, ClipOptions{
+				Inputs: []ClipInput{{Path: muxed}},
+				Output: out,
+				Target: tt.Target,
+			}))
+			info, err := p.Probe(ctx, out)
+			require.NoError(t, err)
+			require.Equal(t, tt.Video, info.VideoCodec)
+			require.Equal(t, tt.Audio, info.HasAudio())
+			require.Equal(t, tt.Width, info.Width)
+			require.Equal(t, tt.Height, info.Height)
+			if tt.Target == TargetSticker {
+				require.LessOrEqual(t, info.Size, int64(StickerMaxSize))
+			}
+		}
+	})
+	t.Run("Segment", func(t *testing.T) {
+		parts, err := p.Segment(ctx, muxed, t.TempDir(), time.Second)
+		require.NoError(t, err)
+		require.Len(t, parts, 2)
+		for _, path := range parts {
+			info, err := p.Probe(ctx, path)
+			require.NoError(t, err)
+			require.True(t, info.HasVideo())
+			requireDuration(t, time.Second, info.Duration)
+		}
+	})
+	t.Run("SegmentAudio", func(t *testing.T) {
+		parts, err := p.Segment(ctx, audio, t.TempDir(), time.Second)
+		require.NoError(t, err)
+		require.Len(t, parts, 2)
+		for _, path := range parts {
+			require.Equal(t, ".m4a", filepath.Ext(path))
+			info, err := p.Probe(ctx, path)
+			require.NoError(t, err)
+			require.True(t, info.HasAudio())
+		}
+	})
+}
+
+func TestConvertMP4(t *testing.T) {
+	ctx := context.Background()
+	video := generate(t, "video.webm",
+		"-f", "lavfi", "-i", "testsrc=duration=1:size=160x120:rate=25",
+		"-c:v", "libvpx-vp9",
+	)
+	audio := generate(t, "audio.webm",
+		"-f", "lavfi", "-i", "sine=frequency=440:duration=1",
+		"-c:a", "libopus",
+	)
+	p := New(Options{})
+	dir := t.TempDir()
+
+	for _, tt := range []struct {
+		Name       string
+		Inputs     []string
+		Conversion Conversion
+		Video      string
+	}{
+		{"Audio", []string{generateVideo(t), audio}, ConvertAudio, "h264"},
+		{"Video", []string{video, audio}, ConvertVideo, "h264"},
+		{"Copy", []string{generateVideo(t), generateAudio(t)}, ConvertCopy, "h264"},
+		{"AudioOnly", []string{audio}, ConvertAudio, ""},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			out := filepath.Join(dir, tt.Name+".mp4")
+			conversion, err := p.ConvertMP4(ctx, ConvertOptions{Inputs: tt.Inputs, Output: out})
+			require.NoError(t, err)
+			require.Equal(t, tt.Conversion, conversion)
+
+			info, err := p.Probe(ctx, out)
+			require.NoError(t, err)
+			require.Equal(t, tt.Video, info.VideoCodec)
+			require.Equal(t, "aac", info.AudioCodec)
+		})
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	ctx := context.Background()
+	video := generateVideo(t)
+	audio := generateAudio(t)
+	p := New(Options{})
+	dir := t.TempDir()
+
+	var (
+		metadata = map[string]string{
+			"title":       "Title",
+			"artist":      "Artist",
+			"date":        "2024-01-02",
+			"description": "Description",
+		}
+		chapters = []Chapter{
+			{Title: "Intro"},
+			{Start: time.Second, End: time.Second * 2, Title: "Main"},
+		}
+	)
+	for _, tt := range []struct {
+		Name     string
+		Run      func(output string) error
+		Chapters []string
+	}{
+		{
+			Name: "ConvertMP4",
+			Run: func(output string) error {
+				_, err := p.ConvertMP4(ctx, ConvertOptions{
+					Inputs:   []string{video, audio},
+					Output:   output,
+					Metadata: metadata,
+					Chapters: chapters,
+				})
+				return err
+			},
+			Chapters: []string{"Intro", "Main"},
+		},
+		{
+			Name: "Clip",
+			Run: func(output string) error {
+				return p.Clip(ctx, ClipOptions{
+					Inputs:   []ClipInput{{Path: video}, {Path: audio}},
+					Output:   output,
+					Start:    time.Millisecond * 1500,
+					Metadata: metadata,
+					Chapters: chapters,
+				})
+			},
+			Chapters: []string{"Main"},
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			out := filepath.Join(dir, tt.Name+".mp4")
+			require.NoError(t, tt.Run(out))
+
+			info, err := p.Probe(ctx, out)
+			require.NoError(t, err)
+			for k, v := range metadata {
+				require.Equal(t, v, info.Tags[k], k)
+			}
+			require.Equal(t, tt.Chapters, probeChapters(t, out))
+		})
+	}
+}
+
+// probeChapters returns titles of chapters of file.
+func probeChapters(t *testing.T, path string) []string {
+	t.Helper()
+	out, err := exec.Command("ffprobe",
+		"-v", "error", "-print_format", "json", "-show_chapters", path,
+	).Output()
+	require.NoError(t, err)
+
+	var probe struct {
+		Chapters []struct {
+			Tags struct {
+				Title string `json:"title"`
+			} `json:"tags"`
+		} `json:"chapters"`
+	}
+	require.NoError(t, json.Unmarshal(out, &probe))
+
+	var titles []string
+	for _, c := range probe.Chapters {
+		titles = append(titles, c.Tags.Title)
+	}
+	return titles
+}
@@ -0,0 +1,49 @@
+package media
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-faster/errors"
+)
+
+// Limits of photos sent to telegram.
+const (
+	// PhotoMaxSize is maximum size of photo file.
+	PhotoMaxSize = 10 << 20
+	// PhotoMaxSide is the largest side of photo that telegram keeps,
+	// larger photos are scaled down by telegram anyway.
+	PhotoMaxSide = 2560
+)
+
+// photoCodecs are ffprobe codec names of images that every client renders
+// as photo.
+var photoCodecs = map[string]bool{
+	"mjpeg": true,
+	"png":   true,
+}
+
+// PhotoCompatible reports whether image can be sent as photo as is.
+func PhotoCompatible(info *Info) bool {
+	return photoCodecs[info.VideoCodec] &&
+		info.Size > 0 && info.Size <= PhotoMaxSize &&
+		// Telegram rejects photos with sum of sides over 10000.
+		info.Width+info.Height <= 10000
+}
+
+// ConvertPhoto writes image at path to JPEG output that can be sent as
+// photo, scaling it down to fit square of PhotoMaxSide.
+func (p *Pipeline) ConvertPhoto(ctx context.Context, path, output string) error {
+	args := []string{
+		"-frames:v", "1",
+		"-vf", fmt.Sprintf(
+			"scale='min(iw,%[1]d)':'min(ih,%[1]d)':force_original_aspect_ratio=decrease", PhotoMaxSide,
+		),
+		"-q:v", "2",
+		"-f", "image2",
+	}
+	if err := p.run(ctx, inputsOf(path), args, output, nil, nil); err != nil {
+		return errors.Wrap(err, "convert photo")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package media
+
+import (
+	"time"
+
+	"github.com/ernado/ff/ffrun"
+)
+
+// Progress of ffmpeg operation.
+type Progress struct {
+	// Processed is duration of processed media.
+	Processed time.Duration
+	// Duration is total duration of media, zero if unknown.
+	Duration time.Duration
+	// Speed relative to playback, like 2.5 for 2.5x.
+	Speed float64
+}
+
+// Complete returns fraction of completed work, from 0 to 1.
+func (p Progress) Complete() float64 {
+	if p.Duration <= 0 {
+		return 0
+	}
+	c := p.Processed.Seconds() / p.Duration.Seconds()
+	switch {
+	case c < 0:
+		return 0
+	case c > 1:
+		return 1
+	default:
+		return c
+	}
+}
+
+// progressTracker converts reports of ffmpeg -progress parsed by ffrun to
+// Progress, computing speed.
+type progressTracker struct {
+	duration time.Duration
+	start    time.Time
+	now      func() time.Time
+	report   func(Progress)
+}
+
+func newProgressTracker(duration time.Duration, report func(Progress)) *progressTracker {
+	return &progressTracker{
+		duration: duration,
+		start:    time.Now(),
+		now:      time.Now,
+		report:   report,
+	}
+}
+
+func (t *progressTracker) Handle(p ffrun.Progress) {
+	processed := time.Duration(p.Complete * float64(t.duration))
+	out := Progress{
+		Processed: processed,
+		Duration:  t.duration,
+	}
+	if elapsed := t.now().Sub(t.start); elapsed > 0 {
+		out.Speed = processed.Seconds() / elapsed.Seconds()
+	}
+	t.report(out)
+}
@@ -0,0 +1,47 @@
+package media
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ernado/ff/ffrun"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressTracker(t *testing.T) {
+	var (
+		got   []Progress
+		start = time.Unix(100, 0)
+		now   = start
+	)
+	tr := newProgressTracker(time.Second*10, func(p Progress) {
+		got = append(got, p)
+	})
+	tr.start = start
+	tr.now = func() time.Time { return now }
+
+	now = start.Add(time.Second)
+	tr.Handle(ffrun.Progress{Complete: 0.2})
+	now = start.Add(time.Second * 2)
+	tr.Handle(ffrun.Progress{Complete: 1})
+
+	require.Equal(t, []Progress{
+		{Processed: time.Second * 2, Duration: time.Second * 10, Speed: 2},
+		{Processed: time.Second * 10, Duration: time.Second * 10, Speed: 5},
+	}, got)
+	require.Equal(t, 0.2, got[0].Complete())
+}
+
+func TestProgressComplete(t *testing.T) {
+	for _, tt := range []struct {
+		Progress Progress
+		Complete float64
+	}{
+		{Progress{}, 0},
+		{Progress{Processed: time.Second}, 0},
+		{Progress{Processed: time.Second, Duration: time.Second * 4}, 0.25},
+		{Progress{Processed: time.Second * 5, Duration: time.Second * 4}, 1},
+	} {
+		require.Equal(t, tt.Complete, tt.Progress.Complete())
+	}
+}
@@ -0,0 +1,309 @@
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ernado/ff/ffmpeg"
+	"github.com/go-faster/errors"
+)
+
+// span of media from Start to End, where zero End is the end of media.
+type span struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// short reports whether span is too short to be encoded, like empty tail.
+func (s span) short() bool {
+	return s.End-s.Start < time.Millisecond
+}
+
+// splitKeyframes splits fragment from start to end, where zero end is the
+// end of media, by keyframes: middle is between first and last keyframes
+// inside fragment and can be copied, while head and tail before and after it
+// are re-encoded. Tail is empty if fragment lasts to the end of media.
+//
+// Reports false if there are no two keyframes in fragment.
+func splitKeyframes(keyframes []time.Duration, start, end time.Duration) (head, middle, tail span, ok bool) {
+	first, last := time.Duration(-1), time.Duration(-1)
+	for _, k := range keyframes {
+		if k < start || (end > 0 && k > end) {
+			continue
+		}
+		if first < 0 || k < first {
+			first = k
+		}
+		if k > last {
+			last = k
+		}
+	}
+	if first < 0 {
+		return span{}, span{}, span{}, false
+	}
+	if end == 0 {
+		return span{Start: start, End: first}, span{Start: first}, span{}, true
+	}
+	if last <= first {
+		return span{}, span{}, span{}, false
+	}
+	return span{Start: start, End: first}, span{Start: first, End: last}, span{Start: last, End: end}, true
+}
+
+// parseKeyframes parses positions of keyframes from ffprobe csv output of
+// packet pts_time and flags.
+func parseKeyframes(out []byte, startTime time.Duration) ([]time.Duration, error) {
+	var keyframes []time.Duration
+	s := bufio.NewScanner(bytes.NewReader(out))
+	for s.Scan() {
+		pts, flags, ok := strings.Cut(strings.TrimSpace(s.Text()), ",")
+		if !ok || pts == "N/A" || !strings.HasPrefix(flags, "K") {
+			continue
+		}
+		v, err := parseSeconds(pts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse pts %q", pts)
+		}
+		// Seek of ffmpeg is relative to start of input.
+		keyframes = append(keyframes, v-startTime)
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.Wrap(err, "scan")
+	}
+	slices.Sort(keyframes)
+	return keyframes, nil
+}
+
+// parseSeconds parses seconds as formatted by ffprobe.
+func parseSeconds(s string) (time.Duration, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(v * float64(time.Second)).Round(time.Microsecond), nil
+}
+
+// keyframes returns positions of keyframes of video stream of input at
+// path from start to end, where zero end is the end of input.
+func (p *Pipeline) keyframes(ctx context.Context, path string, stream int, startTime, start, end time.Duration) ([]time.Duration, error) {
+	interval := formatDuration(start+startTime) + "%"
+	if end > 0 {
+		interval += formatDuration(end + startTime)
+	}
+	if err := p.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer p.release()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.ffprobe, // #nosec: G204
+		"-v", "error",
+		"-select_streams", strconv.Itoa(stream),
+		"-read_intervals", interval,
+		"-show_entries", "packet=pts_time,flags",
+		"-of", "csv=print_section=0",
+		path,
+	)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "ffprobe: %s", strings.TrimSpace(stderr.String()))
+	}
+	return parseKeyframes(out, startTime)
+}
+
+// copyableVideo returns H.264 video stream of probe that can be joined with
+// stream encoded by TargetVideo, false if there is none.
+func copyableVideo(probe *ffmpeg.Probe) (ffmpeg.ProbeStream, bool) {
+	for _, s := range probe.Streams {
+		if s.CodecType != "video" || s.Disposition.AttachedPic != 0 {
+			continue
+		}
+		return s, s.CodecName == "h264" && s.PixFmt == "yuv420p"
+	}
+	return ffmpeg.ProbeStream{}, false
+}
+
+// matchArgs returns H.264 encoder arguments that match profile, level and
+// reference frames of stream, so parts encoded by x264 are decoded with
+// parameters of the copied ones.
+func matchArgs(s ffmpeg.ProbeStream) []string {
+	var args []string
+	switch s.Profile {
+	case "Baseline", "Constrained Baseline":
+		args = append(args, "-profile:v", "baseline")
+	case "Main":
+		args = append(args, "-profile:v", "main")
+	case "High":
+		args = append(args, "-profile:v", "high")
+	}
+	if s.Level > 0 {
+		args = append(args, "-level:v", fmt.Sprintf("%d.%d", s.Level/10, s.Level%10))
+	}
+	if s.Refs > 0 {
+		args = append(args, "-refs", strconv.Itoa(s.Refs))
+	}
+	return args
+}
+
+// seekArgs returns input arguments that seek to position, none if it is
+// not positive.
+func seekArgs(position time.Duration) []string {
+	if position <= 0 {
+		return nil
+	}
+	return []string{"-ss", formatDuration(position)}
+}
+
+// smartClip is Clip to TargetVideo that re-encodes only partial groups of
+// pictures at the edges of fragment and copies the ones between keyframes,
+// while audio is re-encoded whole.
+//
+// Reports false without doing anything if video can not be copied, like if
+// it is not H.264 or there are no keyframes in fragment.
+func (p *Pipeline) smartClip(ctx context.Context, opt ClipOptions) (bool, error) {
+	var (
+		video  ClipInput
+		stream ffmpeg.ProbeStream
+		probe  *ffmpeg.Probe
+		found  bool
+	)
+	for _, in := range opt.Inputs {
+		v, err := p.probe(ctx, in.Path)
+		if err != nil {
+			return false, errors.Wrap(err, "probe input")
+		}
+		s, ok := copyableVideo(v)
+		if s.CodecType == "" {
+			// No video.
+			continue
+		}
+		if !ok {
+			return false, nil
+		}
+		video, stream, probe, found = in, s, v, true
+		break
+	}
+	if !found {
+		return false, nil
+	}
+	var startTime time.Duration
+	if probe.Format.StartTime != "" {
+		v, err := parseSeconds(probe.Format.StartTime)
+		if err != nil {
+			return false, errors.Wrap(err, "parse start time")
+		}
+		startTime = v
+	}
+
+	// Positions are relative to the start of video input.
+	var (
+		start = opt.Start - video.Offset
+		end   time.Duration
+	)
+	if d := opt.duration(); d > 0 {
+		end = start + d
+	}
+	if start < 0 {
+		return false, nil
+	}
+	keyframes, err := p.keyframes(ctx, video.Path, stream.Index, startTime, start, end)
+	if err != nil {
+		return false, errors.Wrap(err, "keyframes")
+	}
+	head, middle, tail, ok := splitKeyframes(keyframes, start, end)
+	if !ok {
+		return false, nil
+	}
+
+	var (
+		parts []string
+		index = strconv.Itoa(stream.Index)
+	)
+	defer func() {
+		for _, part := range parts {
+			_ = os.Remove(part)
+		}
+	}()
+	encode := func(name string, s span) error {
+		if s.short() {
+			return nil
+		}
+		args := []string{"-map", "0:" + index, "-t", formatDuration(s.End - s.Start)}
+		// MP4 keeps parameter sets of the first part only, so parts are
+		// encoded to match the source.
+		args = append(args, h264...)
+		args = append(args, matchArgs(stream)...)
+		args = append(args, "-an", "-f", "mpegts")
+		part := opt.Output + "." + name + ".ts"
+		parts = append(parts, part)
+		in := input{Path: video.Path, Args: seekArgs(s.Start)}
+		return p.run(ctx, []input{in}, args, part, probe, nil)
+	}
+	if err := encode("head", head); err != nil {
+		return false, errors.Wrap(err, "encode head")
+	}
+	{
+		// Parameter sets are repeated before keyframes, so decoders that
+		// read them in band switch to the ones of source.
+		args := []string{"-map", "0:" + index, "-c:v", Copy, "-bsf:v", "h264_mp4toannexb,dump_extra=freq=keyframe"}
+		if middle.End > 0 {
+			args = append(args, "-t", formatDuration(middle.End-middle.Start))
+		}
+		args = append(args, "-an", "-f", "mpegts")
+		part := opt.Output + ".middle.ts"
+		parts = append(parts, part)
+		// Seek with copy starts at keyframe before position, so
+		// position is moved past rounding of keyframe.
+		in := input{Path: video.Path, Args: seekArgs(middle.Start + time.Millisecond/2)}
+		if err := p.run(ctx, []input{in}, args, part, probe, nil); err != nil {
+			return false, errors.Wrap(err, "copy middle")
+		}
+	}
+	if err := encode("tail", tail); err != nil {
+		return false, errors.Wrap(err, "encode tail")
+	}
+
+	joined := opt.Output + ".video.mp4"
+	defer func() { _ = os.Remove(joined) }()
+	if err := p.Concat(ctx, joined, parts...); err != nil {
+		return false, errors.Wrap(err, "join video")
+	}
+
+	inputs := []input{{Path: joined}}
+	// Tags are kept from the first input like by full re-encode.
+	args := []string{"-map", "0:v", "-map_metadata", "1"}
+	for i, in := range opt.Inputs {
+		inputs = append(inputs, input{Path: in.Path, Args: seekArgs(opt.Start - in.Offset)})
+		args = append(args, "-map", strconv.Itoa(i+1)+":a?")
+	}
+	if d := opt.duration(); d > 0 {
+		args = append(args, "-t", formatDuration(d))
+	}
+	if chapters := opt.chapters(); len(chapters) > 0 {
+		in, cleanup, err := chaptersInput(opt.Output, chapters)
+		if err != nil {
+			return false, err
+		}
+		defer cleanup()
+		inputs = append(inputs, in)
+	}
+	args = append(args, metadataArgs(opt.Metadata, opt.chapters(), len(inputs)-1)...)
+	args = append(args,
+		"-c:v", Copy,
+		"-c:a", AAC, "-b:a", "192k",
+		"-movflags", "+faststart",
+	)
+	if err := p.run(ctx, inputs, args, opt.Output, nil, opt.Progress); err != nil {
+		return false, errors.Wrap(err, "mux")
+	}
+	return true, nil
+}
@@ -0,0 +1,51 @@
+package media
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ernado/ff/ffmpeg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitKeyframes(t *testing.T) {
+	keyframes := []time.Duration{0, time.Second * 2, time.Second * 4, time.Second * 6}
+
+	head, middle, tail, ok := splitKeyframes(keyframes, time.Second, time.Second*5)
+	require.True(t, ok)
+	require.Equal(t, span{Start: time.Second, End: time.Second * 2}, head)
+	require.Equal(t, span{Start: time.Second * 2, End: time.Second * 4}, middle)
+	require.Equal(t, span{Start: time.Second * 4, End: time.Second * 5}, tail)
+
+	head, middle, tail, ok = splitKeyframes(keyframes, time.Second*2, 0)
+	require.True(t, ok)
+	require.True(t, head.short())
+	require.Equal(t, span{Start: time.Second * 2}, middle)
+	require.True(t, tail.short())
+
+	_, _, _, ok = splitKeyframes(keyframes, time.Second, time.Second*3)
+	require.False(t, ok, "one keyframe")
+	_, _, _, ok = splitKeyframes(keyframes, time.Second*7, 0)
+	require.False(t, ok, "no keyframes")
+}
+
+func TestParseKeyframes(t *testing.T) {
+	keyframes, err := parseKeyframes([]byte("1.500000,K__\n1.540000,___\nN/A,K__\n0.500000,K_\n"), time.Millisecond*500)
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{0, time.Second}, keyframes)
+
+	_, err = parseKeyframes([]byte("x,K__\n"), 0)
+	require.Error(t, err)
+}
+
+func TestMatchArgs(t *testing.T) {
+	require.Equal(t,
+		[]string{"-profile:v", "main", "-level:v", "3.1", "-refs", "4"},
+		matchArgs(ffmpeg.ProbeStream{Profile: "Main", Level: 31, Refs: 4}),
+	)
+	require.Equal(t,
+		[]string{"-profile:v", "baseline"},
+		matchArgs(ffmpeg.ProbeStream{Profile: "Constrained Baseline"}),
+	)
+	require.Empty(t, matchArgs(ffmpeg.ProbeStream{Profile: "High 10"}))
+}
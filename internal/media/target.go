@@ -0,0 +1,96 @@
+package media
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Target is kind of media produced by Clip.
+type Target int
+
+const (
+	// TargetVideo is H.264 and AAC mp4.
+	TargetVideo Target = iota
+	// TargetAnimation is muted H.264 mp4, played by telegram as GIF.
+	TargetAnimation
+	// TargetVideoNote is square H.264 and AAC mp4, played by telegram as
+	// round video message.
+	TargetVideoNote
+	// TargetSticker is VP9 webm without audio, used as video sticker.
+	TargetSticker
+)
+
+// Constraints of telegram.
+const (
+	VideoNoteSide        = 640
+	VideoNoteMaxDuration = time.Minute
+
+	StickerSide        = 512
+	StickerMaxDuration = time.Second * 3
+	StickerMaxSize     = 256 << 10
+)
+
+// Ext returns extension of output file, including dot.
+func (t Target) Ext() string {
+	if t == TargetSticker {
+		return ".webm"
+	}
+	return ".mp4"
+}
+
+// MaxDuration returns maximum duration of target, zero if unlimited.
+func (t Target) MaxDuration() time.Duration {
+	switch t {
+	case TargetVideoNote:
+		return VideoNoteMaxDuration
+	case TargetSticker:
+		return StickerMaxDuration
+	default:
+		return 0
+	}
+}
+
+// stickerBitrate fits maximum duration of sticker into maximum size with
+// margin for container overhead.
+const stickerBitrate = StickerMaxSize * 8 * 9 / 10 / 3
+
+// h264 are arguments of H.264 encoding that is playable everywhere.
+var h264 = []string{"-c:v", H264, "-preset", "veryfast", "-crf", "23", "-pix_fmt", "yuv420p"}
+
+// args returns output arguments of target, where videoBitrate is used
+// only by sticker.
+func (t Target) args(videoBitrate int64) []string {
+	var args []string
+	switch t {
+	case TargetAnimation:
+		args = append(args, "-an")
+		args = append(args, h264...)
+	case TargetVideoNote:
+		// Crop center square.
+		args = append(args, "-vf", fmt.Sprintf(
+			"crop='min(iw,ih)':'min(iw,ih)',scale=%[1]d:%[1]d,setsar=1", VideoNoteSide,
+		))
+		args = append(args, h264...)
+		args = append(args, "-c:a", AAC, "-b:a", "128k")
+	case TargetSticker:
+		if videoBitrate == 0 {
+			videoBitrate = stickerBitrate
+		}
+		// One side is exactly 512 and other is not larger.
+		return append(args,
+			"-an",
+			"-vf", fmt.Sprintf(
+				"scale='if(gte(iw,ih),%[1]d,-1)':'if(gte(iw,ih),-1,%[1]d)',fps=30", StickerSide,
+			),
+			"-c:v", "libvpx-vp9",
+			"-pix_fmt", "yuva420p",
+			"-b:v", strconv.FormatInt(videoBitrate, 10),
+			"-f", "webm",
+		)
+	default:
+		args = append(args, h264...)
+		args = append(args, "-c:a", AAC, "-b:a", "192k")
+	}
+	return append(args, "-movflags", "+faststart")
+}
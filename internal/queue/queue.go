@@ -0,0 +1,245 @@
+// Package queue implements job queue with global, per-user and per-chat
+// concurrency limits and fair scheduling between users.
+package queue
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-faster/errors"
+)
+
+// Options of Queue.
+type Options struct {
+	// Workers is count of concurrently running jobs.
+	Workers int
+	// PerUser limits concurrently running jobs of single user.
+	// Zero means no limit.
+	PerUser int
+	// PerChat limits concurrently running jobs in single chat.
+	// Zero means no limit.
+	PerChat int
+}
+
+func (o *Options) setDefaults() {
+	if o.Workers <= 0 {
+		o.Workers = 1
+	}
+}
+
+// Task describes job owner.
+type Task struct {
+	UserID int64
+	ChatID int64
+
+	// OnPosition is called with 1-based position in queue every time it
+	// changes while task is waiting.
+	OnPosition func(pos int)
+}
+
+type waiter struct {
+	task  Task
+	ready chan struct{}
+	moved chan struct{}
+	pos   int
+}
+
+// Queue of jobs.
+//
+// Waiting jobs of different users are started in round-robin order, jobs of
+// the same user are started in FIFO order.
+type Queue struct {
+	opt Options
+
+	mux     sync.Mutex
+	running int
+	users   map[int64]int // running per user
+	chats   map[int64]int // running per chat
+	waiting map[int64][]*waiter
+	ring    []int64 // users with waiting jobs, in round-robin order
+}
+
+// New creates new Queue.
+func New(opt Options) *Queue {
+	opt.setDefaults()
+
+	return &Queue{
+		opt:     opt,
+		users:   make(map[int64]int),
+		chats:   make(map[int64]int),
+		waiting: make(map[int64][]*waiter),
+	}
+}
+
+// ErrClosed is returned by Do if context is done before job started.
+var ErrClosed = errors.New("queue: context done before start")
+
+// Do waits for free slot and calls fn.
+//
+// If ctx is done before fn is called, Do returns error that wraps both
+// ErrClosed and context error.
+func (q *Queue) Do(ctx context.Context, t Task, fn func(ctx context.Context) error) error {
+	w := &waiter{
+		task:  t,
+		ready: make(chan struct{}),
+		moved: make(chan struct{}, 1),
+	}
+
+	q.mux.Lock()
+	if _, ok := q.waiting[t.UserID]; !ok {
+		q.ring = append(q.ring, t.UserID)
+	}
+	q.waiting[t.UserID] = append(q.waiting[t.UserID], w)
+	q.dispatch()
+	q.mux.Unlock()
+
+	for {
+		select {
+		case <-w.ready:
+			defer q.release(t)
+			return fn(ctx)
+		case <-w.moved:
+			q.mux.Lock()
+			pos := w.pos
+			q.mux.Unlock()
+			if pos > 0 && t.OnPosition != nil {
+				t.OnPosition(pos)
+			}
+		case <-ctx.Done():
+			q.mux.Lock()
+			select {
+			case <-w.ready:
+				// Started concurrently with cancellation.
+				q.mux.Unlock()
+				q.release(t)
+			default:
+				q.remove(w)
+				q.dispatch()
+				q.mux.Unlock()
+			}
+			return errors.Join(ErrClosed, ctx.Err())
+		}
+	}
+}
+
+// Len returns count of running and waiting jobs.
+func (q *Queue) Len() (running, waiting int) {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+
+	for _, ws := range q.waiting {
+		waiting += len(ws)
+	}
+
+	return q.running, waiting
+}
+
+func (q *Queue) release(t Task) {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+
+	q.running--
+	decrement(q.users, t.UserID)
+	decrement(q.chats, t.ChatID)
+	q.dispatch()
+}
+
+func decrement(m map[int64]int, k int64) {
+	m[k]--
+	if m[k] <= 0 {
+		delete(m, k)
+	}
+}
+
+func (q *Queue) allowed(t Task) bool {
+	if q.opt.PerUser > 0 && q.users[t.UserID] >= q.opt.PerUser {
+		return false
+	}
+	if q.opt.PerChat > 0 && q.chats[t.ChatID] >= q.opt.PerChat {
+		return false
+	}
+	return true
+}
+
+// remove waiter from queue. Must be called with lock held.
+func (q *Queue) remove(w *waiter) {
+	user := w.task.UserID
+	ws := q.waiting[user]
+	for i, v := range ws {
+		if v == w {
+			ws = append(ws[:i:i], ws[i+1:]...)
+			break
+		}
+	}
+	if len(ws) > 0 {
+		q.waiting[user] = ws
+		return
+	}
+	delete(q.waiting, user)
+	for i, id := range q.ring {
+		if id == user {
+			q.ring = append(q.ring[:i:i], q.ring[i+1:]...)
+			break
+		}
+	}
+}
+
+// dispatch starts waiting jobs while there are free slots and updates
+// positions of the rest. Must be called with lock held.
+func (q *Queue) dispatch() {
+	for q.running < q.opt.Workers {
+		started := false
+		for i, user := range q.ring {
+			w := q.waiting[user][0]
+			if !q.allowed(w.task) {
+				continue
+			}
+			q.running++
+			q.users[w.task.UserID]++
+			q.chats[w.task.ChatID]++
+			w.pos = 0
+			close(w.ready)
+			q.remove(w)
+			// Move user to the end of ring, so others go first next time.
+			if _, ok := q.waiting[user]; ok {
+				q.ring = append(append(q.ring[:i:i], q.ring[i+1:]...), user)
+			}
+			started = true
+			break
+		}
+		if !started {
+			break
+		}
+	}
+
+	q.updatePositions()
+}
+
+// updatePositions computes projected start order by interleaving queues of
+// users in ring order. Must be called with lock held.
+func (q *Queue) updatePositions() {
+	pos := 0
+	for depth := 0; ; depth++ {
+		found := false
+		for _, user := range q.ring {
+			ws := q.waiting[user]
+			if depth >= len(ws) {
+				continue
+			}
+			found = true
+			pos++
+			w := ws[depth]
+			if w.pos == pos {
+				continue
+			}
+			w.pos = pos
+			select {
+			case w.moved <- struct{}{}:
+			default:
+			}
+		}
+		if !found {
+			return
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func waitLen(t *testing.T, q *Queue, running, waiting int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		r, w := q.Len()
+		return r == running && w == waiting
+	}, time.Second, time.Millisecond)
+}
+
+func TestQueueFair(t *testing.T) {
+	q := New(Options{Workers: 1})
+
+	var (
+		mux   sync.Mutex
+		order []int64
+	)
+	block := make(chan struct{})
+	g, ctx := errgroup.WithContext(t.Context())
+	g.Go(func() error {
+		return q.Do(ctx, Task{UserID: 0}, func(ctx context.Context) error {
+			<-block
+			return nil
+		})
+	})
+	waitLen(t, q, 1, 0)
+
+	// User 1 submits three jobs before user 2 submits one.
+	submit := func(user int64, waiting int) {
+		g.Go(func() error {
+			return q.Do(ctx, Task{UserID: user}, func(ctx context.Context) error {
+				mux.Lock()
+				defer mux.Unlock()
+				order = append(order, user)
+				return nil
+			})
+		})
+		waitLen(t, q, 1, waiting)
+	}
+	submit(1, 1)
+	submit(1, 2)
+	submit(1, 3)
+	submit(2, 4)
+
+	close(block)
+	require.NoError(t, g.Wait())
+	require.Equal(t, []int64{1, 2, 1, 1}, order)
+}
+
+func TestQueueLimits(t *testing.T) {
+	q := New(Options{Workers: 3, PerUser: 1, PerChat: 2})
+
+	block := make(chan struct{})
+	g, ctx := errgroup.WithContext(t.Context())
+	for _, task := range []Task{
+		{UserID: 1, ChatID: 1},
+		{UserID: 1, ChatID: 1}, // per-user
+		{UserID: 2, ChatID: 1},
+		{UserID: 3, ChatID: 1}, // per-chat
+	} {
+		g.Go(func() error {
+			return q.Do(ctx, task, func(ctx context.Context) error {
+				<-block
+				return nil
+			})
+		})
+	}
+	waitLen(t, q, 2, 2)
+	close(block)
+	require.NoError(t, g.Wait())
+	waitLen(t, q, 0, 0)
+}
+
+func TestQueuePosition(t *testing.T) {
+	q := New(Options{Workers: 1})
+
+	block := make(chan struct{})
+	g, ctx := errgroup.WithContext(t.Context())
+	g.Go(func() error {
+		return q.Do(ctx, Task{UserID: 1}, func(ctx context.Context) error {
+			<-block
+			return nil
+		})
+	})
+	waitLen(t, q, 1, 0)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	canceled := make(chan error, 1)
+	go func() {
+		canceled <- q.Do(cancelCtx, Task{UserID: 2}, func(ctx context.Context) error {
+			return nil
+		})
+	}()
+	waitLen(t, q, 1, 1)
+
+	positions := make(chan int, 10)
+	g.Go(func() error {
+		return q.Do(ctx, Task{UserID: 3, OnPosition: func(pos int) {
+			positions <- pos
+		}}, func(ctx context.Context) error {
+			return nil
+		})
+	})
+	require.Equal(t, 2, <-positions)
+
+	cancel()
+	require.ErrorIs(t, <-canceled, ErrClosed)
+	require.Equal(t, 1, <-positions)
+
+	close(block)
+	require.NoError(t, g.Wait())
+}
@@ -0,0 +1,313 @@
+// Package settings implements inline keyboard menu of chat preferences.
+package settings
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/go-faster/errors"
+	"github.com/gotd/td/telegram/message/markup"
+	"github.com/gotd/td/tg"
+)
+
+// Prefix of callback data of settings buttons.
+const Prefix = "set:"
+
+// Default formats of links without command.
+const (
+	FormatAsk   = "ask"
+	FormatVideo = "video"
+	FormatAudio = "audio"
+)
+
+// Settings are preferences of chat.
+type Settings struct {
+	// Quality is maximum short side of video, unlimited if zero.
+	Quality int
+	// Codec is preferred video codec family, playable everywhere if empty.
+	Codec string
+	// Format is default format of links, FormatAsk if empty.
+	Format string
+	// Subtitles are languages of subtitles sent with video.
+	Subtitles []string
+	// Document sends video as file.
+	Document bool
+	// Language of bot messages, from telegram if empty.
+	Language string
+	// Caption is template of captions, that is set by command and is
+	// only shown in menu.
+	Caption string
+}
+
+// Keys of settings.
+const (
+	KeyQuality   = "quality"
+	KeyCodec     = "codec"
+	KeyFormat    = "format"
+	KeySubtitles = "subs"
+	KeyDocument  = "send"
+	KeyLanguage  = "lang"
+)
+
+type option struct {
+	Value string
+	// Label is catalog key of option label.
+	Label string
+	// Name is label that is not translated, like "H.264".
+	Name string
+}
+
+// text returns label of option in language of locale.
+func (o option) text(loc *i18n.Locale) string {
+	if o.Name != "" {
+		return o.Name
+	}
+	return loc.T(o.Label)
+}
+
+type setting struct {
+	Key string
+	// Title is catalog key of setting title.
+	Title   string
+	Options []option
+	// Multiple settings toggle options, first option clears them.
+	Multiple bool
+}
+
+var settings = []setting{
+	{
+		Key:   KeyQuality,
+		Title: "settings.quality",
+		Options: []option{
+			{Value: "0", Label: "settings.quality.best"},
+			{Value: "1080", Name: "1080p"},
+			{Value: "720", Name: "720p"},
+			{Value: "480", Name: "480p"},
+			{Value: "360", Name: "360p"},
+		},
+	},
+	{
+		Key:   KeyCodec,
+		Title: "settings.codec",
+		Options: []option{
+			{Value: "", Label: "settings.codec.compatible"},
+			{Value: "avc1", Name: "H.264"},
+			{Value: "vp9", Name: "VP9"},
+			{Value: "av01", Name: "AV1"},
+		},
+	},
+	{
+		Key:   KeyFormat,
+		Title: "settings.format",
+		Options: []option{
+			{Value: FormatAsk, Label: "settings.format.ask"},
+			{Value: FormatVideo, Label: "settings.format.video"},
+			{Value: FormatAudio, Label: "settings.format.audio"},
+		},
+	},
+	{
+		Key:   KeySubtitles,
+		Title: "settings.subtitles",
+		Options: []option{
+			{Value: "", Label: "settings.subtitles.off"},
+			{Value: "en", Label: "settings.subtitles.en"},
+			{Value: "ru", Label: "settings.subtitles.ru"},
+			{Value: "uk", Label: "settings.subtitles.uk"},
+			{Value: "es", Label: "settings.subtitles.es"},
+			{Value: "de", Label: "settings.subtitles.de"},
+			{Value: "fr", Label: "settings.subtitles.fr"},
+			{Value: "ja", Label: "settings.subtitles.ja"},
+		},
+		Multiple: true,
+	},
+	{
+		Key:   KeyDocument,
+		Title: "settings.send",
+		Options: []option{
+			{Value: "video", Label: "settings.send.video"},
+			{Value: "file", Label: "settings.send.file"},
+		},
+	},
+	{
+		Key:     KeyLanguage,
+		Title:   "settings.language",
+		Options: languageOptions(),
+	},
+}
+
+// languageOptions returns options of languages that have catalogs, named
+// in their own language.
+func languageOptions() []option {
+	options := []option{{Value: "", Label: "settings.language.auto"}}
+	for _, lang := range i18n.Languages() {
+		options = append(options, option{
+			Value: lang,
+			Name:  i18n.Lookup(lang).T("language.name"),
+		})
+	}
+	return options
+}
+
+func settingOf(key string) (setting, bool) {
+	i := slices.IndexFunc(settings, func(s setting) bool { return s.Key == key })
+	if i < 0 {
+		return setting{}, false
+	}
+	return settings[i], true
+}
+
+// values returns current values of setting.
+func (s Settings) values(key string) []string {
+	switch key {
+	case KeyQuality:
+		return []string{strconv.Itoa(s.Quality)}
+	case KeyCodec:
+		return []string{s.Codec}
+	case KeyFormat:
+		if s.Format == "" {
+			return []string{FormatAsk}
+		}
+		return []string{s.Format}
+	case KeySubtitles:
+		if len(s.Subtitles) == 0 {
+			return []string{""}
+		}
+		return s.Subtitles
+	case KeyDocument:
+		if s.Document {
+			return []string{"file"}
+		}
+		return []string{"video"}
+	case KeyLanguage:
+		return []string{s.Language}
+	default:
+		return nil
+	}
+}
+
+// Apply returns settings with option value of key chosen, where options of
+// subtitles are toggled.
+func (s Settings) Apply(key, value string) (Settings, error) {
+	st, ok := settingOf(key)
+	if !ok {
+		return s, errors.Errorf("unknown setting %q", key)
+	}
+	if !slices.ContainsFunc(st.Options, func(o option) bool { return o.Value == value }) {
+		return s, errors.Errorf("unknown %s %q", key, value)
+	}
+	switch key {
+	case KeyQuality:
+		s.Quality, _ = strconv.Atoi(value)
+	case KeyCodec:
+		s.Codec = value
+	case KeyFormat:
+		s.Format = value
+	case KeySubtitles:
+		switch {
+		case value == "":
+			s.Subtitles = nil
+		case slices.Contains(s.Subtitles, value):
+			s.Subtitles = slices.DeleteFunc(slices.Clone(s.Subtitles), func(v string) bool { return v == value })
+		default:
+			s.Subtitles = append(slices.Clone(s.Subtitles), value)
+		}
+	case KeyDocument:
+		s.Document = value == "file"
+	case KeyLanguage:
+		s.Language = value
+	}
+	return s, nil
+}
+
+// label returns labels of current values of setting.
+func (s Settings) label(loc *i18n.Locale, st setting) string {
+	var labels []string
+	for _, v := range s.values(st.Key) {
+		for _, o := range st.Options {
+			if o.Value == v {
+				labels = append(labels, o.text(loc))
+			}
+		}
+	}
+	if len(labels) == 0 {
+		// Value that is not in options, like language of subtitles set
+		// before options were changed.
+		return strings.Join(s.values(st.Key), ", ")
+	}
+	return strings.Join(labels, ", ")
+}
+
+// Text returns description of settings in language of locale.
+func (s Settings) Text(loc *i18n.Locale) string {
+	var b strings.Builder
+	b.WriteString(loc.T("settings.title") + "\n")
+	for _, st := range settings {
+		b.WriteString("\n" + loc.T(st.Title) + ": " + s.label(loc, st))
+	}
+	caption := s.Caption
+	if caption == "" {
+		caption = loc.T("settings.caption.default")
+	}
+	b.WriteString("\n" + loc.T("settings.caption", caption))
+	return b.String()
+}
+
+// Data returns callback data of button that opens setting of key, or main
+// menu if key is empty.
+func Data(key string) []byte {
+	return []byte(Prefix + key)
+}
+
+// ParseData parses callback data to key of setting and chosen value, where
+// set reports whether value is chosen.
+func ParseData(data []byte) (key, value string, set bool, err error) {
+	rest, ok := strings.CutPrefix(string(data), Prefix)
+	if !ok {
+		return "", "", false, errors.Errorf("bad settings data %q", data)
+	}
+	key, value, set = strings.Cut(rest, ":")
+	if _, ok := settingOf(key); !ok && key != "" {
+		return "", "", false, errors.Errorf("unknown setting %q", key)
+	}
+	return key, value, set, nil
+}
+
+// Markup returns keyboard of settings menu in language of locale, main one
+// if key is empty.
+func (s Settings) Markup(loc *i18n.Locale, key string) tg.ReplyMarkupClass {
+	st, ok := settingOf(key)
+	if !ok {
+		rows := make([]tg.KeyboardButtonRow, 0, len(settings))
+		for _, st := range settings {
+			rows = append(rows, markup.Row(
+				markup.Callback(loc.T(st.Title)+": "+s.label(loc, st), Data(st.Key)),
+			))
+		}
+		return markup.InlineKeyboard(rows...)
+	}
+
+	var (
+		current = s.values(st.Key)
+		rows    []tg.KeyboardButtonRow
+		row     []tg.KeyboardButtonClass
+	)
+	for _, o := range st.Options {
+		label := o.text(loc)
+		if slices.Contains(current, o.Value) {
+			label = "✓ " + label
+		}
+		row = append(row, markup.Callback(label, []byte(Prefix+st.Key+":"+o.Value)))
+		if len(row) == 2 {
+			rows = append(rows, markup.Row(row...))
+			row = nil
+		}
+	}
+	if len(row) > 0 {
+		rows = append(rows, markup.Row(row...))
+	}
+	rows = append(rows, markup.Row(markup.Callback("« "+loc.T("settings.back"), Data(""))))
+	return markup.InlineKeyboard(rows...)
+}
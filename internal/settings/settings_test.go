@@ -0,0 +1,105 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/ernado/tentacle/internal/i18n"
+
+	"github.com/gotd/td/tg"
+	"github.com/stretchr/testify/require"
+)
+
+// buttons returns labels and data of buttons of inline keyboard.
+func buttons(t *testing.T, m tg.ReplyMarkupClass) (labels, data []string) {
+	t.Helper()
+	keyboard, ok := m.(*tg.ReplyInlineMarkup)
+	require.True(t, ok)
+	for _, row := range keyboard.Rows {
+		for _, b := range row.Buttons {
+			cb, ok := b.(*tg.KeyboardButtonCallback)
+			require.True(t, ok)
+			labels = append(labels, cb.Text)
+			data = append(data, string(cb.Data))
+		}
+	}
+	return labels, data
+}
+
+func TestSettings(t *testing.T) {
+	en := i18n.Lookup("en")
+	var s Settings
+	s, err := s.Apply(KeyQuality, "720")
+	require.NoError(t, err)
+	s, err = s.Apply(KeySubtitles, "en")
+	require.NoError(t, err)
+	s, err = s.Apply(KeySubtitles, "ru")
+	require.NoError(t, err)
+	s, err = s.Apply(KeyDocument, "file")
+	require.NoError(t, err)
+	require.Equal(t, Settings{Quality: 720, Subtitles: []string{"en", "ru"}, Document: true}, s)
+
+	toggled, err := s.Apply(KeySubtitles, "en")
+	require.NoError(t, err)
+	require.Equal(t, []string{"ru"}, toggled.Subtitles)
+	require.Equal(t, []string{"en", "ru"}, s.Subtitles, "original is not changed")
+	cleared, err := s.Apply(KeySubtitles, "")
+	require.NoError(t, err)
+	require.Empty(t, cleared.Subtitles)
+
+	_, err = s.Apply(KeyQuality, "4320")
+	require.Error(t, err)
+	_, err = s.Apply("speed", "1")
+	require.Error(t, err)
+
+	require.Equal(t, "Settings of this chat:\n\n"+
+		"Quality: 720p\n"+
+		"Codec: Compatible\n"+
+		"Links: Ask format\n"+
+		"Subtitles: English, Russian\n"+
+		"Send as: File\n"+
+		"Language: Auto\n"+
+		"Caption: default (see /caption)", s.Text(en))
+
+	labels, data := buttons(t, s.Markup(en, ""))
+	require.Equal(t, []string{
+		"Quality: 720p",
+		"Codec: Compatible",
+		"Links: Ask format",
+		"Subtitles: English, Russian",
+		"Send as: File",
+		"Language: Auto",
+	}, labels)
+	require.Equal(t, "set:quality", data[0])
+
+	labels, data = buttons(t, s.Markup(en, KeyQuality))
+	require.Equal(t, []string{"Best", "1080p", "✓ 720p", "480p", "360p", "« Back"}, labels)
+	require.Equal(t, "set:quality:1080", data[1])
+	require.Equal(t, "set:", data[len(data)-1])
+
+	labels, _ = buttons(t, s.Markup(i18n.Lookup("ru"), KeyLanguage))
+	require.Equal(t, []string{"✓ Автоматически", "English", "Русский", "« Назад"}, labels)
+}
+
+func TestParseData(t *testing.T) {
+	for _, tt := range []struct {
+		Data  string
+		Key   string
+		Value string
+		Set   bool
+	}{
+		{Data: "set:"},
+		{Data: "set:codec", Key: KeyCodec},
+		{Data: "set:codec:", Key: KeyCodec, Set: true},
+		{Data: "set:quality:720", Key: KeyQuality, Value: "720", Set: true},
+	} {
+		key, value, set, err := ParseData([]byte(tt.Data))
+		require.NoError(t, err, tt.Data)
+		require.Equal(t, tt.Key, key, tt.Data)
+		require.Equal(t, tt.Value, value, tt.Data)
+		require.Equal(t, tt.Set, set, tt.Data)
+	}
+	for _, data := range []string{"pick:1:2", "set:speed:1"} {
+		_, _, _, err := ParseData([]byte(data))
+		require.Error(t, err, data)
+	}
+}
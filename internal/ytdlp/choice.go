@@ -0,0 +1,363 @@
+package ytdlp
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// Plan is a way to download formats of choice.
+type Plan int
+
+const (
+	// PlanPair downloads video only and audio only formats and muxes them.
+	PlanPair Plan = iota
+	// PlanProgressive downloads single format with both video and audio.
+	PlanProgressive
+	// PlanAudio downloads audio only.
+	PlanAudio
+)
+
+// Choice is a format option that can be presented to user.
+type Choice struct {
+	// Video format, zero for audio only. Progressive format, that has
+	// both video and audio, is video.
+	Video Format
+	// Audio format, zero for progressive.
+	Audio Format
+	// Size is estimated size in bytes, zero if unknown.
+	Size int64
+}
+
+// Plan returns download plan of choice.
+func (c Choice) Plan() Plan {
+	switch {
+	case c.Video.FormatID == "":
+		return PlanAudio
+	case c.Audio.FormatID == "":
+		return PlanProgressive
+	default:
+		return PlanPair
+	}
+}
+
+// AudioOnly reports whether choice has no video.
+func (c Choice) AudioOnly() bool {
+	return c.Plan() == PlanAudio
+}
+
+// Selector returns format selector of choice, like "137+140".
+func (c Choice) Selector() string {
+	switch c.Plan() {
+	case PlanAudio:
+		return c.Audio.FormatID
+	case PlanProgressive:
+		return c.Video.FormatID
+	default:
+		return c.Video.FormatID + "+" + c.Audio.FormatID
+	}
+}
+
+// Codec returns codec family, like "avc1" for "avc1.4d401f".
+func Codec(codec string) string {
+	family, _, _ := strings.Cut(codec, ".")
+	return family
+}
+
+// ShortSide returns shorter side of video frame, which is the "p" in 720p
+// both for horizontal and vertical videos.
+func (f Format) ShortSide() int {
+	if f.Width > 0 && f.Width < f.Height {
+		return f.Width
+	}
+	return f.Height
+}
+
+// EstimateSize returns approximate size of format for video of given
+// duration in seconds, zero if unknown.
+func (f Format) EstimateSize(duration float64) int64 {
+	switch {
+	case f.Filesize > 0:
+		return f.Filesize
+	case f.FilesizeApprox > 0:
+		return f.FilesizeApprox
+	case f.TBR > 0 && duration > 0:
+		// TBR is in kbit/s.
+		return int64(f.TBR * 1000 / 8 * duration)
+	default:
+		return 0
+	}
+}
+
+// downloadable reports whether format can be downloaded by DownloadChunked.
+func (f Format) downloadable() bool {
+	return f.URL != "" && (f.Protocol == "https" || f.Protocol == "http")
+}
+
+// imageExts are extensions of image formats.
+var imageExts = map[string]bool{
+	"jpg":  true,
+	"jpeg": true,
+	"png":  true,
+	"webp": true,
+	"avif": true,
+	"heic": true,
+}
+
+// Image reports whether format is still image, like photo of post.
+func (f Format) Image() bool {
+	return imageExts[strings.ToLower(f.Ext)]
+}
+
+// HasVideo reports whether format can have video stream. Codec can be
+// unknown, which is treated as present.
+func (f Format) HasVideo() bool {
+	return f.VCodec != "none" && !f.Image()
+}
+
+// HasAudio reports whether format can have audio stream. Codec can be
+// unknown, which is treated as present.
+func (f Format) HasAudio() bool {
+	return f.ACodec != "none" && !f.Image()
+}
+
+// Progressive reports whether format has both video and audio.
+func (f Format) Progressive() bool {
+	return f.HasVideo() && f.HasAudio()
+}
+
+// preferCodec is video codec that is preferred over others of the same
+// resolution, because every telegram client can play it.
+const preferCodec = "avc1"
+
+// sameCodec reports whether codec is of family, treating "vp09" as "vp9".
+func sameCodec(codec, family string) bool {
+	c := Codec(codec)
+	if c == "vp09" {
+		c = "vp9"
+	}
+	return c == family
+}
+
+// better reports whether format a is better choice than b of the same
+// resolution, where codec is preferred codec family.
+func better(a, b Format, codec string) bool {
+	if ap, bp := sameCodec(a.VCodec, codec), sameCodec(b.VCodec, codec); ap != bp {
+		return ap
+	}
+	return a.TBR > b.TBR
+}
+
+// bestAudioOnly returns downloadable audio-only format, preferring m4a with
+// the highest bitrate.
+func bestAudioOnly(formats []Format) Format {
+	var best Format
+	for _, f := range formats {
+		if f.HasVideo() || !f.HasAudio() || !f.downloadable() {
+			continue
+		}
+		if best.FormatID == "" {
+			best = f
+			continue
+		}
+		if am, bm := f.Ext == "m4a", best.Ext == "m4a"; am != bm {
+			if am {
+				best = f
+			}
+			continue
+		}
+		if f.TBR > best.TBR {
+			best = f
+		}
+	}
+	return best
+}
+
+// Choices returns deduplicated format choices for video, best first.
+//
+// For every resolution only one format is kept, preferring pair of video
+// only and audio only formats over progressive one and codec that is
+// playable everywhere. Audio only choice, if any, is always last.
+func Choices(v *Video) []Choice {
+	return PreferredChoices(v, Preference{})
+}
+
+// Preference of format choices.
+type Preference struct {
+	// MaxSide limits short side of video, zero if unlimited.
+	MaxSide int
+	// Codec is preferred video codec family, "avc1", "vp9" or "av01",
+	// codec that is playable everywhere if empty.
+	Codec string
+}
+
+// PreferredChoices returns Choices of video with preference applied, so
+// formats of preferred codec are kept and video larger than MaxSide is
+// dropped, unless there is no smaller video.
+func PreferredChoices(v *Video, p Preference) []Choice {
+	codec := p.Codec
+	if codec == "" {
+		codec = preferCodec
+	}
+	var (
+		audio       = bestAudioOnly(v.Formats)
+		pairs       = make(map[int]Format)
+		progressive = make(map[int]Format)
+	)
+	for _, f := range v.Formats {
+		if !f.HasVideo() || !f.downloadable() {
+			continue
+		}
+		side := f.ShortSide()
+		switch {
+		case f.Progressive():
+			if prev, ok := progressive[side]; !ok || better(f, prev, codec) {
+				progressive[side] = f
+			}
+		case audio.FormatID != "":
+			// Video only format is useful only with audio to mux.
+			if prev, ok := pairs[side]; !ok || better(f, prev, codec) {
+				pairs[side] = f
+			}
+		}
+	}
+
+	var choices []Choice
+	for _, f := range pairs {
+		choices = append(choices, Choice{
+			Video: f,
+			Audio: audio,
+			Size:  f.EstimateSize(v.Duration) + audio.EstimateSize(v.Duration),
+		})
+	}
+	for side, f := range progressive {
+		if _, ok := pairs[side]; ok {
+			continue
+		}
+		choices = append(choices, Choice{
+			Video: f,
+			Size:  f.EstimateSize(v.Duration),
+		})
+	}
+	sort.Slice(choices, func(i, j int) bool {
+		return choices[i].Video.ShortSide() > choices[j].Video.ShortSide()
+	})
+	if p.MaxSide > 0 {
+		i := sort.Search(len(choices), func(i int) bool {
+			return choices[i].Video.ShortSide() <= p.MaxSide
+		})
+		// Keep the smallest video if every one is larger.
+		choices = choices[min(i, max(len(choices)-1, 0)):]
+	}
+
+	if audio.FormatID != "" {
+		choices = append(choices, Choice{
+			Audio: audio,
+			Size:  audio.EstimateSize(v.Duration),
+		})
+	}
+
+	return choices
+}
+
+// BestImage returns downloadable image format with the largest resolution
+// if video has no video formats, which is the case for photo posts.
+func (v *Video) BestImage() (Format, bool) {
+	var best Format
+	for _, f := range v.Formats {
+		if f.HasVideo() && f.downloadable() {
+			return Format{}, false
+		}
+		if !f.Image() || !f.downloadable() {
+			continue
+		}
+		if best.FormatID == "" || f.Width*f.Height > best.Width*best.Height {
+			best = f
+		}
+	}
+	return best, best.FormatID != ""
+}
+
+// Select returns formats for selector returned by Choice.Selector.
+//
+// Empty selector or FormatBest selects the first of Choices,
+// FormatBestAudio selects best audio only format, falling back to
+// progressive one to extract audio from.
+func (v *Video) Select(selector string) (Choice, error) {
+	c, err := v.selectFormats(selector)
+	if err != nil {
+		return Choice{}, err
+	}
+	if !c.AudioOnly() {
+		c.Size = c.Video.EstimateSize(v.Duration)
+	}
+	c.Size += c.Audio.EstimateSize(v.Duration)
+
+	return c, nil
+}
+
+func (v *Video) selectFormats(selector string) (Choice, error) {
+	switch selector {
+	case "", FormatBest:
+		choices := Choices(v)
+		if len(choices) == 0 {
+			return Choice{}, errors.New("no downloadable formats")
+		}
+		return choices[0], nil
+	case FormatBestAudio:
+		audio := bestAudioOnly(v.Formats)
+		if audio.FormatID == "" {
+			// Audio is extracted from progressive format.
+			for _, f := range v.Formats {
+				if f.Progressive() && f.downloadable() && (audio.FormatID == "" || f.TBR > audio.TBR) {
+					audio = f
+				}
+			}
+		}
+		if audio.FormatID == "" {
+			return Choice{}, errors.New("no audio format")
+		}
+		return Choice{Audio: audio}, nil
+	}
+
+	byID := func(id string) (Format, error) {
+		for _, f := range v.Formats {
+			if f.FormatID == id {
+				return f, nil
+			}
+		}
+		return Format{}, errors.Errorf("format %q not found", id)
+	}
+
+	videoID, audioID, pair := strings.Cut(selector, "+")
+	if !pair {
+		f, err := byID(videoID)
+		if err != nil {
+			return Choice{}, err
+		}
+		if f.HasVideo() {
+			return Choice{Video: f}, nil
+		}
+		return Choice{Audio: f}, nil
+	}
+
+	video, err := byID(videoID)
+	if err != nil {
+		return Choice{}, err
+	}
+	audio, err := byID(audioID)
+	if err != nil {
+		return Choice{}, err
+	}
+
+	return Choice{Video: video, Audio: audio}, nil
+}
+
+// Format selectors that are not bound to format ids.
+const (
+	// FormatBest is the best choice.
+	FormatBest = "bestvideo+bestaudio"
+	// FormatBestAudio is best audio only format.
+	FormatBestAudio = "bestaudio"
+)
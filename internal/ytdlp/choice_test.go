@@ -0,0 +1,156 @@
+package ytdlp
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/stretchr/testify/require"
+)
+
+// describe returns short description of choice, like one of format picker.
+func describe(c Choice) string {
+	size := humanize.Bytes(uint64(c.Size))
+	if c.AudioOnly() {
+		return fmt.Sprintf("Audio %s ~%s", Codec(c.Audio.ACodec), size)
+	}
+	return fmt.Sprintf("%dp %s ~%s", c.Video.ShortSide(), Codec(c.Video.VCodec), size)
+}
+
+func TestChoices(t *testing.T) {
+	var video Video
+	require.NoError(t, json.Unmarshal(videoExample, &video))
+
+	choices := Choices(&video)
+	require.NotEmpty(t, choices)
+
+	var labels []string
+	for _, c := range choices {
+		labels = append(labels, describe(c))
+	}
+	require.Equal(t, []string{
+		"720p avc1 ~1.3 MB",
+		"608p vp9 ~546 kB",
+		"480p avc1 ~672 kB",
+		"360p avc1 ~418 kB",
+		"240p avc1 ~276 kB",
+		"144p avc1 ~220 kB",
+		"Audio mp4a ~151 kB",
+	}, labels)
+
+	last := choices[len(choices)-1]
+	require.True(t, last.AudioOnly())
+
+	for _, c := range choices {
+		selected, err := video.Select(c.Selector())
+		require.NoError(t, err)
+		require.Equal(t, c.Video.FormatID, selected.Video.FormatID)
+		require.Equal(t, c.Audio.FormatID, selected.Audio.FormatID)
+		require.Equal(t, c.Size, selected.Size)
+	}
+
+	audio, err := video.Select(FormatBestAudio)
+	require.NoError(t, err)
+	require.True(t, audio.AudioOnly())
+	require.Equal(t, last.Audio.FormatID, audio.Audio.FormatID)
+
+	_, err = video.Select("404+140")
+	require.Error(t, err)
+
+	labels = nil
+	for _, c := range PreferredChoices(&video, Preference{MaxSide: 480, Codec: "vp9"}) {
+		labels = append(labels, describe(c))
+	}
+	require.Equal(t, []string{
+		"480p avc1 ~672 kB",
+		"360p vp9 ~274 kB",
+		"240p vp9 ~231 kB",
+		"144p avc1 ~220 kB",
+		"Audio mp4a ~151 kB",
+	}, labels)
+
+	labels = nil
+	for _, c := range PreferredChoices(&video, Preference{MaxSide: 100}) {
+		labels = append(labels, describe(c))
+	}
+	require.Equal(t, []string{"144p avc1 ~220 kB", "Audio mp4a ~151 kB"}, labels)
+}
+
+func TestChoicesPlans(t *testing.T) {
+	var (
+		progressive360 = Format{
+			FormatID: "360", Protocol: "https", URL: "https://example.com/360",
+			VCodec: "avc1.42001E", ACodec: "mp4a.40.2", Width: 640, Height: 360, TBR: 500,
+		}
+		progressive720 = Format{
+			FormatID: "720", Protocol: "https", URL: "https://example.com/720",
+			VCodec: "avc1.64001F", ACodec: "mp4a.40.2", Width: 1280, Height: 720, TBR: 1500,
+		}
+		stream = Format{
+			FormatID: "hls", Protocol: "m3u8_native", URL: "https://example.com/hls",
+			VCodec: "avc1.64001F", ACodec: "mp4a.40.2", Width: 1920, Height: 1080,
+		}
+		mp3 = Format{
+			FormatID: "mp3", Protocol: "https", URL: "https://example.com/mp3",
+			VCodec: "none", ACodec: "mp3", Ext: "mp3", TBR: 128,
+		}
+	)
+	t.Run("Progressive", func(t *testing.T) {
+		v := &Video{Formats: []Format{progressive360, stream, progressive720}}
+		choices := Choices(v)
+		require.Len(t, choices, 2)
+		for _, c := range choices {
+			require.Equal(t, PlanProgressive, c.Plan())
+		}
+		require.Equal(t, "720", choices[0].Selector())
+
+		best, err := v.Select(FormatBest)
+		require.NoError(t, err)
+		require.Equal(t, PlanProgressive, best.Plan())
+		require.Equal(t, "720", best.Video.FormatID)
+
+		selected, err := v.Select("360")
+		require.NoError(t, err)
+		require.Equal(t, PlanProgressive, selected.Plan())
+
+		audio, err := v.Select(FormatBestAudio)
+		require.NoError(t, err)
+		require.Equal(t, PlanAudio, audio.Plan())
+		require.Equal(t, "720", audio.Audio.FormatID)
+	})
+	t.Run("Audio", func(t *testing.T) {
+		v := &Video{Formats: []Format{mp3}}
+		choices := Choices(v)
+		require.Len(t, choices, 1)
+		require.Equal(t, PlanAudio, choices[0].Plan())
+
+		best, err := v.Select(FormatBest)
+		require.NoError(t, err)
+		require.Equal(t, PlanAudio, best.Plan())
+		require.Equal(t, "mp3", best.Selector())
+	})
+	t.Run("Empty", func(t *testing.T) {
+		v := &Video{Formats: []Format{stream}}
+		require.Empty(t, Choices(v))
+		_, err := v.Select(FormatBest)
+		require.Error(t, err)
+
+		_, ok := v.BestImage()
+		require.False(t, ok)
+	})
+	t.Run("Image", func(t *testing.T) {
+		v := &Video{Formats: []Format{
+			{FormatID: "small", Protocol: "https", URL: "https://example.com/small", Ext: "jpg", Width: 320, Height: 320},
+			{FormatID: "large", Protocol: "https", URL: "https://example.com/large", Ext: "webp", Width: 1080, Height: 1080},
+		}}
+		require.Empty(t, Choices(v))
+		image, ok := v.BestImage()
+		require.True(t, ok)
+		require.Equal(t, "large", image.FormatID)
+
+		v.Formats = append(v.Formats, progressive360)
+		_, ok = v.BestImage()
+		require.False(t, ok)
+	})
+}
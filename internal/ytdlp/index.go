@@ -0,0 +1,243 @@
+package ytdlp
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ernado/tentacle/internal/ytio"
+	"github.com/go-faster/errors"
+)
+
+// Segment of fragmented mp4 referenced by index.
+type Segment struct {
+	ytio.Range
+	Start    time.Duration
+	Duration time.Duration
+}
+
+// Index of fragmented mp4, that allows to download only segments of time
+// range, like DASH formats of YouTube.
+type Index struct {
+	// Header are ranges of boxes that are needed to play segments, like
+	// ftyp and moov.
+	Header   []ytio.Range
+	Segments []Segment
+}
+
+// ErrNoIndex means that media has no index to download part of it.
+var ErrNoIndex = errors.New("no index")
+
+// incompleteError means that more data is needed to parse index.
+type incompleteError struct {
+	Need int64
+}
+
+func (e *incompleteError) Error() string {
+	return fmt.Sprintf("need %d bytes", e.Need)
+}
+
+// ParseIndex parses index from the beginning of fragmented mp4, which
+// is sidx box of ISO BMFF.
+func ParseIndex(data []byte) (*Index, error) {
+	var (
+		idx    Index
+		offset int64
+		size   = int64(len(data))
+	)
+	for {
+		if offset+8 > size {
+			return nil, &incompleteError{Need: offset + 16}
+		}
+		var (
+			boxSize = int64(binary.BigEndian.Uint32(data[offset:]))
+			boxType = string(data[offset+4 : offset+8])
+			header  = int64(8)
+		)
+		switch boxSize {
+		case 0:
+			// Box extends to the end of file, so there are no more boxes.
+			return nil, ErrNoIndex
+		case 1:
+			if offset+16 > size {
+				return nil, &incompleteError{Need: offset + 16}
+			}
+			boxSize = int64(binary.BigEndian.Uint64(data[offset+8:]))
+			header = 16
+		}
+		if boxSize < header {
+			return nil, errors.Errorf("bad size %d of box %q", boxSize, boxType)
+		}
+
+		switch boxType {
+		case "moof", "mdat":
+			// Media data before index.
+			return nil, ErrNoIndex
+		case "sidx":
+			if offset+boxSize > size {
+				return nil, &incompleteError{Need: offset + boxSize}
+			}
+			segments, err := parseSIDX(data[offset+header:offset+boxSize], offset+boxSize)
+			if err != nil {
+				return nil, errors.Wrap(err, "sidx")
+			}
+			idx.Segments = segments
+			return &idx, nil
+		default:
+			idx.Header = append(idx.Header, ytio.Range{Offset: offset, Size: boxSize})
+		}
+		offset += boxSize
+	}
+}
+
+// parseSIDX parses body of sidx box that ends at anchor.
+func parseSIDX(b []byte, anchor int64) ([]Segment, error) {
+	if len(b) < 12 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var (
+		version   = b[0]
+		timescale = int64(binary.BigEndian.Uint32(b[8:]))
+		earliest  int64
+		first     int64
+	)
+	b = b[12:]
+	if timescale == 0 {
+		return nil, errors.New("zero timescale")
+	}
+	if version == 0 {
+		if len(b) < 8 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		earliest = int64(binary.BigEndian.Uint32(b))
+		first = int64(binary.BigEndian.Uint32(b[4:]))
+		b = b[8:]
+	} else {
+		if len(b) < 16 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		earliest = int64(binary.BigEndian.Uint64(b))
+		first = int64(binary.BigEndian.Uint64(b[8:]))
+		b = b[16:]
+	}
+	if len(b) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	count := int(binary.BigEndian.Uint16(b[2:]))
+	b = b[4:]
+	if len(b) < count*12 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	var (
+		segments = make([]Segment, 0, count)
+		offset   = anchor + first
+		ts       = earliest
+	)
+	toDuration := func(v int64) time.Duration {
+		return time.Duration(v) * time.Second / time.Duration(timescale)
+	}
+	for i := 0; i < count; i++ {
+		ref := binary.BigEndian.Uint32(b[i*12:])
+		if ref>>31 == 1 {
+			return nil, errors.New("hierarchical index is not supported")
+		}
+		var (
+			size     = int64(ref & 0x7fffffff)
+			duration = int64(binary.BigEndian.Uint32(b[i*12+4:]))
+		)
+		segments = append(segments, Segment{
+			Range:    ytio.Range{Offset: offset, Size: size},
+			Start:    toDuration(ts),
+			Duration: toDuration(duration),
+		})
+		offset += size
+		ts += duration
+	}
+
+	return segments, nil
+}
+
+// Ranges returns byte ranges that are needed to play time range from start
+// to end, where zero end is the end of media, and the start of the first
+// segment in range.
+func (i *Index) Ranges(start, end time.Duration) (ranges []ytio.Range, from time.Duration) {
+	ranges = append(ranges, i.Header...)
+
+	var segment *ytio.Range
+	for _, s := range i.Segments {
+		if s.Start+s.Duration <= start || (end > 0 && s.Start >= end) {
+			continue
+		}
+		if segment == nil {
+			segment = &ytio.Range{Offset: s.Offset}
+			from = s.Start
+		}
+		// Segments are adjacent.
+		segment.Size = s.End() - segment.Offset
+	}
+	if segment != nil {
+		ranges = append(ranges, *segment)
+	}
+
+	return ranges, from
+}
+
+// Indexed reports whether format can have index, which is true for mp4
+// files that are downloaded directly, like DASH formats of YouTube.
+func (f Format) Indexed() bool {
+	return f.Protocol == "https" && (f.Ext == "mp4" || f.Ext == "m4a")
+}
+
+// maxIndexSize limits size of data fetched to parse index.
+const maxIndexSize = 4 << 20
+
+// FetchIndex fetches index of format, returning ErrNoIndex if format has
+// no index.
+func FetchIndex(ctx context.Context, format Format, httpClient *http.Client) (*Index, error) {
+	size := int64(64 << 10)
+	for {
+		data, err := fetchRange(ctx, format, ytio.Range{Size: size}, httpClient)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch")
+		}
+		idx, err := ParseIndex(data)
+		var incomplete *incompleteError
+		if !errors.As(err, &incomplete) {
+			return idx, err
+		}
+		if int64(len(data)) < size || incomplete.Need > maxIndexSize {
+			// File is shorter than needed or index is too large.
+			return nil, ErrNoIndex
+		}
+		size = incomplete.Need
+	}
+}
+
+func fetchRange(ctx context.Context, format Format, r ytio.Range, httpClient *http.Client) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", format.URL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "create request")
+	}
+	for k, v := range format.HTTPHeaders {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", r.Offset, r.End()-1))
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "do request")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, errors.Errorf("bad status: %s: %q", res.Status, body)
+	}
+
+	return io.ReadAll(io.LimitReader(res.Body, r.Size))
+}
@@ -0,0 +1,95 @@
+package ytdlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ernado/tentacle/internal/ytio"
+	"github.com/stretchr/testify/require"
+)
+
+func box(typ string, body []byte) []byte {
+	b := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+	b = append(b, typ...)
+	return append(b, body...)
+}
+
+// sampleMP4 returns header of fragmented mp4 with sidx that references
+// segments of 5 seconds with sizes, followed by segment data.
+func sampleMP4(sizes ...uint32) []byte {
+	var sidx []byte
+	sidx = append(sidx, 0, 0, 0, 0)                  // version and flags
+	sidx = binary.BigEndian.AppendUint32(sidx, 1)    // reference id
+	sidx = binary.BigEndian.AppendUint32(sidx, 1000) // timescale
+	sidx = binary.BigEndian.AppendUint32(sidx, 0)    // earliest presentation time
+	sidx = binary.BigEndian.AppendUint32(sidx, 0)    // first offset
+	sidx = binary.BigEndian.AppendUint16(sidx, 0)    // reserved
+	sidx = binary.BigEndian.AppendUint16(sidx, uint16(len(sizes)))
+	for _, size := range sizes {
+		sidx = binary.BigEndian.AppendUint32(sidx, size)
+		sidx = binary.BigEndian.AppendUint32(sidx, 5000)
+		sidx = binary.BigEndian.AppendUint32(sidx, 1<<31) // starts with SAP
+	}
+
+	var data []byte
+	data = append(data, box("ftyp", []byte("dash\x00\x00\x00\x00"))...)
+	data = append(data, box("moov", make([]byte, 20))...)
+	data = append(data, box("sidx", sidx)...)
+	for _, size := range sizes {
+		data = append(data, bytes.Repeat([]byte{1}, int(size))...)
+	}
+	return data
+}
+
+func TestParseIndex(t *testing.T) {
+	data := sampleMP4(100, 200, 300)
+	header := int64(len(data) - 600)
+
+	idx, err := ParseIndex(data)
+	require.NoError(t, err)
+	require.Equal(t, []ytio.Range{
+		{Offset: 0, Size: 16},
+		{Offset: 16, Size: 28},
+	}, idx.Header)
+	require.Equal(t, []Segment{
+		{Range: ytio.Range{Offset: header, Size: 100}, Start: 0, Duration: time.Second * 5},
+		{Range: ytio.Range{Offset: header + 100, Size: 200}, Start: time.Second * 5, Duration: time.Second * 5},
+		{Range: ytio.Range{Offset: header + 300, Size: 300}, Start: time.Second * 10, Duration: time.Second * 5},
+	}, idx.Segments)
+
+	ranges, from := idx.Ranges(time.Second*6, time.Second*9)
+	require.Equal(t, time.Second*5, from)
+	require.Equal(t, []ytio.Range{
+		{Offset: 0, Size: 16},
+		{Offset: 16, Size: 28},
+		{Offset: header + 100, Size: 200},
+	}, ranges)
+
+	ranges, from = idx.Ranges(time.Second*4, 0)
+	require.Zero(t, from)
+	require.Equal(t, ytio.Range{Offset: header, Size: 600}, ranges[len(ranges)-1])
+
+	_, err = ParseIndex(data[:30])
+	var incomplete *incompleteError
+	require.ErrorAs(t, err, &incomplete)
+
+	_, err = ParseIndex(box("moof", nil))
+	require.ErrorIs(t, err, ErrNoIndex)
+}
+
+func TestFetchIndex(t *testing.T) {
+	data := sampleMP4(100, 200, 300)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "video.mp4", time.Time{}, bytes.NewReader(data))
+	}))
+	defer srv.Close()
+
+	idx, err := FetchIndex(context.Background(), Format{URL: srv.URL}, srv.Client())
+	require.NoError(t, err)
+	require.Len(t, idx.Segments, 3)
+}
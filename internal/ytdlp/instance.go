@@ -4,21 +4,57 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"os/exec"
+	"strconv"
 
 	"github.com/go-faster/errors"
 )
 
+// Error of failed yt-dlp run.
+type Error struct {
+	Err error
+	// Stderr is output of yt-dlp, that describes reason of failure.
+	Stderr string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("yt-dlp: %s: %s", e.Stderr, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
 type Instance struct {
 	CookiesFilePath string
 	Proxy           string
 }
 
+// MaxEntries limits count of entries of post or playlist.
+const MaxEntries = 20
+
+// Video returns info of single video at uri, which is the first entry if
+// uri has multiple.
 func (i *Instance) Video(ctx context.Context, uri string) (*Video, error) {
+	entries, err := i.Entries(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	return entries[0], nil
+}
+
+// Entries returns info of every media item at uri, like videos of post
+// with multiple items, up to MaxEntries.
+func (i *Instance) Entries(ctx context.Context, uri string) ([]*Video, error) {
 	buf := new(bytes.Buffer)
 	stderr := new(bytes.Buffer)
 	args := []string{
 		"-j",
+		// Video with playlist parameter is only the video.
+		"--no-playlist",
+		"--playlist-end", strconv.Itoa(MaxEntries),
 		uri,
 	}
 	if i.CookiesFilePath != "" {
@@ -37,13 +73,31 @@ func (i *Instance) Video(ctx context.Context, uri string) (*Video, error) {
 	cmd.Stdout = buf
 	cmd.Stderr = stderr
 	if err := cmd.Run(); err != nil {
-		return nil, errors.Wrapf(err, "yt-dlp: %s", stderr.String())
+		return nil, &Error{Err: err, Stderr: stderr.String()}
 	}
 
-	var video Video
-	if err := json.Unmarshal(buf.Bytes(), &video); err != nil {
-		return nil, err
+	return decodeEntries(buf)
+}
+
+// decodeEntries decodes entries that yt-dlp prints as JSON object per
+// line.
+func decodeEntries(r io.Reader) ([]*Video, error) {
+	var (
+		d       = json.NewDecoder(r)
+		entries []*Video
+	)
+	for {
+		video := new(Video)
+		if err := d.Decode(video); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "decode entry")
+		}
+		entries = append(entries, video)
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no entries")
 	}
 
-	return &video, nil
+	return entries, nil
 }
@@ -0,0 +1,67 @@
+package ytdlp
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Subtitle is subtitle file of video.
+type Subtitle struct {
+	Ext  string `json:"ext"`
+	URL  string `json:"url"`
+	Name string `json:"name"`
+	// Lang is language code, set by SubtitlesOf.
+	Lang string `json:"-"`
+}
+
+// subtitleExts are subtitle formats that can be opened by most players,
+// most preferred first.
+var subtitleExts = []string{"srt", "vtt"}
+
+// SubtitlesOf returns subtitles of languages, one per language that video
+// has. Language matches its code or regional variants, like "en-US" for
+// "en".
+func (v *Video) SubtitlesOf(langs []string) []Subtitle {
+	var result []Subtitle
+	for _, lang := range langs {
+		var candidates []string
+		for code := range v.Subtitles {
+			if code == lang || strings.HasPrefix(code, lang+"-") {
+				candidates = append(candidates, code)
+			}
+		}
+		// Exact code sorts before its variants.
+		sort.Strings(candidates)
+		for _, code := range candidates {
+			if s, ok := bestSubtitle(v.Subtitles[code]); ok {
+				s.Lang = code
+				result = append(result, s)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// bestSubtitle returns subtitle of the most preferred format.
+func bestSubtitle(list []Subtitle) (Subtitle, bool) {
+	var (
+		best Subtitle
+		rank = len(subtitleExts)
+	)
+	for _, s := range list {
+		i := slices.Index(subtitleExts, s.Ext)
+		if i >= 0 && i < rank && s.URL != "" {
+			best, rank = s, i
+		}
+	}
+	return best, rank < len(subtitleExts)
+}
+
+// DownloadSubtitle downloads subtitle file to path.
+func DownloadSubtitle(ctx context.Context, s Subtitle, path string, httpClient *http.Client) error {
+	return downloadFile(ctx, s.URL, path, httpClient)
+}
@@ -0,0 +1,25 @@
+package ytdlp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubtitlesOf(t *testing.T) {
+	v := &Video{Subtitles: map[string][]Subtitle{
+		"en-US": {
+			{Ext: "json3", URL: "https://example.com/en.json3"},
+			{Ext: "vtt", URL: "https://example.com/en.vtt"},
+			{Ext: "srt", URL: "https://example.com/en.srt"},
+		},
+		"de":    {{Ext: "ttml", URL: "https://example.com/de.ttml"}},
+		"ru":    {{Ext: "vtt", URL: "https://example.com/ru.vtt"}},
+		"ru-RU": {{Ext: "srt", URL: "https://example.com/ru-RU.srt"}},
+	}}
+	require.Equal(t, []Subtitle{
+		{Ext: "vtt", URL: "https://example.com/ru.vtt", Lang: "ru"},
+		{Ext: "srt", URL: "https://example.com/en.srt", Lang: "en-US"},
+	}, v.SubtitlesOf([]string{"ru", "de", "fr", "en"}))
+	require.Empty(t, v.SubtitlesOf(nil))
+}
@@ -0,0 +1,127 @@
+package ytdlp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// ParseTimestamp parses position in video, like "1:20", "1:02:03", "80",
+// "80s" or "1h2m3s".
+func ParseTimestamp(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, errors.New("empty timestamp")
+	}
+	if strings.Contains(s, ":") {
+		parts := strings.Split(s, ":")
+		if len(parts) > 3 {
+			return 0, errors.Errorf("bad timestamp %q", s)
+		}
+		// Hours and minutes are whole, seconds can be fractional.
+		var minutes int
+		for i, p := range parts[:len(parts)-1] {
+			n, err := strconv.Atoi(p)
+			if err != nil || n < 0 || (i > 0 && n >= 60) {
+				return 0, errors.Errorf("bad timestamp %q", s)
+			}
+			minutes = minutes*60 + n
+		}
+		sec, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+		if err != nil || sec < 0 || sec >= 60 {
+			return 0, errors.Errorf("bad seconds in %q", s)
+		}
+		return time.Duration(minutes)*time.Minute + time.Duration(sec*float64(time.Second)), nil
+	}
+	if sec, err := strconv.ParseFloat(strings.TrimSuffix(s, "s"), 64); err == nil {
+		if sec < 0 {
+			return 0, errors.Errorf("negative timestamp %q", s)
+		}
+		return time.Duration(sec * float64(time.Second)), nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, errors.Errorf("bad timestamp %q", s)
+	}
+	return d, nil
+}
+
+// FormatTimestamp formats position in video as "1:20" or "1:02:03".
+func FormatTimestamp(d time.Duration) string {
+	d = d.Round(time.Second)
+	h := d / time.Hour
+	m := (d % time.Hour) / time.Minute
+	s := (d % time.Minute) / time.Second
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
+
+// ParseRange parses time range like "1:20-3:05". Either side can be
+// omitted, "1:20-" is from 1:20 to the end, where end is zero.
+func ParseRange(s string) (start, end time.Duration, err error) {
+	from, to, ok := strings.Cut(s, "-")
+	if !ok {
+		return 0, 0, errors.Errorf("no range in %q", s)
+	}
+	if from != "" {
+		if start, err = ParseTimestamp(from); err != nil {
+			return 0, 0, errors.Wrap(err, "start")
+		}
+	}
+	if to != "" {
+		if end, err = ParseTimestamp(to); err != nil {
+			return 0, 0, errors.Wrap(err, "end")
+		}
+		if end <= start {
+			return 0, 0, errors.Errorf("end %s is not after start %s", to, from)
+		}
+	}
+	if start == 0 && end == 0 {
+		return 0, 0, errors.Errorf("empty range %q", s)
+	}
+	return start, end, nil
+}
+
+// URLAt returns uri that starts playback at position d, using "t"
+// parameter that is understood by most video hostings.
+func URLAt(uri string, d time.Duration) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	q := u.Query()
+	q.Set("t", strconv.Itoa(int(d/time.Second)))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// StartFromURL returns start position from "t" parameter of uri query or
+// fragment, like "?t=80" or "#t=1m20s".
+func StartFromURL(uri string) (time.Duration, bool) {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil {
+		return 0, false
+	}
+	t := u.Query().Get("t")
+	if t == "" {
+		fragment, err := url.ParseQuery(u.Fragment)
+		if err != nil {
+			return 0, false
+		}
+		t = fragment.Get("t")
+	}
+	if t == "" {
+		return 0, false
+	}
+	d, err := ParseTimestamp(t)
+	if err != nil || d == 0 {
+		return 0, false
+	}
+	return d, true
+}
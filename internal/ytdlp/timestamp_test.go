@@ -0,0 +1,87 @@
+package ytdlp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	for _, tt := range []struct {
+		Input    string
+		Duration time.Duration
+	}{
+		{"80", time.Second * 80},
+		{"80s", time.Second * 80},
+		{"1.5", time.Millisecond * 1500},
+		{"1:20", time.Second * 80},
+		{"01:02:03", time.Hour + time.Minute*2 + time.Second*3},
+		{"90:00", time.Minute * 90},
+		{"1m20s", time.Second * 80},
+		{"1h2m3s", time.Hour + time.Minute*2 + time.Second*3},
+	} {
+		d, err := ParseTimestamp(tt.Input)
+		require.NoError(t, err, tt.Input)
+		require.Equal(t, tt.Duration, d, tt.Input)
+	}
+	for _, s := range []string{"", "-1", "1:60", "1:-1:00", "1:2:3:4", "abc"} {
+		_, err := ParseTimestamp(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	require.Equal(t, "0:00", FormatTimestamp(0))
+	require.Equal(t, "1:20", FormatTimestamp(time.Second*80))
+	require.Equal(t, "1:02:03", FormatTimestamp(time.Hour+time.Minute*2+time.Second*3))
+}
+
+func TestParseRange(t *testing.T) {
+	start, end, err := ParseRange("1:20-3:05")
+	require.NoError(t, err)
+	require.Equal(t, time.Second*80, start)
+	require.Equal(t, time.Second*185, end)
+
+	start, end, err = ParseRange("1:20-")
+	require.NoError(t, err)
+	require.Equal(t, time.Second*80, start)
+	require.Zero(t, end)
+
+	start, end, err = ParseRange("-0:30")
+	require.NoError(t, err)
+	require.Zero(t, start)
+	require.Equal(t, time.Second*30, end)
+
+	for _, s := range []string{"1:20", "3:05-1:20", "-", "a-b"} {
+		_, _, err := ParseRange(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestStartFromURL(t *testing.T) {
+	for _, tt := range []struct {
+		URL   string
+		Start time.Duration
+		OK    bool
+	}{
+		{"https://youtu.be/id?t=80", time.Second * 80, true},
+		{"https://www.youtube.com/watch?v=id&t=1m20s", time.Second * 80, true},
+		{"https://vimeo.com/123#t=90s", time.Second * 90, true},
+		{"https://www.youtube.com/watch?v=id", 0, false},
+		{"https://www.youtube.com/watch?v=id&t=0", 0, false},
+	} {
+		start, ok := StartFromURL(tt.URL)
+		require.Equal(t, tt.OK, ok, tt.URL)
+		require.Equal(t, tt.Start, start, tt.URL)
+	}
+}
+
+func TestURLAt(t *testing.T) {
+	uri := URLAt("https://www.youtube.com/watch?v=id", time.Second*80)
+	require.Equal(t, "https://www.youtube.com/watch?t=80&v=id", uri)
+
+	start, ok := StartFromURL(uri)
+	require.True(t, ok)
+	require.Equal(t, time.Second*80, start)
+}
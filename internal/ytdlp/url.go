@@ -0,0 +1,65 @@
+package ytdlp
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// trackingParams are query parameters that do not change the resource.
+var trackingParams = map[string]struct{}{
+	"si":      {},
+	"feature": {},
+	"fbclid":  {},
+	"gclid":   {},
+	"igshid":  {},
+	"ref":     {},
+	"ref_src": {},
+
+	// Start position, see StartFromURL.
+	"t": {},
+}
+
+// CanonicalURL normalizes uri, so links to the same resource are equal.
+func CanonicalURL(uri string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil {
+		return "", errors.Wrap(err, "parse url")
+	}
+	if u.Host == "" {
+		return "", errors.Errorf("no host in %q", uri)
+	}
+
+	u.Scheme = "https"
+	u.User = nil
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.Host = strings.ToLower(u.Host)
+	for _, prefix := range []string{"www.", "m.", "mobile."} {
+		u.Host = strings.TrimPrefix(u.Host, prefix)
+	}
+
+	q := u.Query()
+	for k := range q {
+		if _, ok := trackingParams[k]; ok || strings.HasPrefix(k, "utm_") {
+			q.Del(k)
+		}
+	}
+
+	if u.Host == "youtu.be" {
+		// Short link: https://youtu.be/ID.
+		q.Set("v", strings.Trim(u.Path, "/"))
+		u.Host = "youtube.com"
+		u.Path = "/watch"
+	}
+	if u.Path != "/" {
+		u.Path = strings.TrimSuffix(u.Path, "/")
+	}
+	u.RawPath = ""
+
+	// Encode sorts by key.
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
@@ -0,0 +1,29 @@
+package ytdlp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalURL(t *testing.T) {
+	for _, tt := range []struct {
+		Input  string
+		Output string
+	}{
+		{"https://www.youtube.com/watch?v=dQw4w9WgXcQ", "https://youtube.com/watch?v=dQw4w9WgXcQ"},
+		{"http://m.YouTube.com/watch?v=dQw4w9WgXcQ&utm_source=x", "https://youtube.com/watch?v=dQw4w9WgXcQ"},
+		{"https://youtu.be/dQw4w9WgXcQ?si=abc", "https://youtube.com/watch?v=dQw4w9WgXcQ"},
+		{"https://youtu.be/dQw4w9WgXcQ?t=80", "https://youtube.com/watch?v=dQw4w9WgXcQ"},
+		{"https://example.com/video/?b=2&a=1#comments", "https://example.com/video?a=1&b=2"},
+	} {
+		t.Run(tt.Input, func(t *testing.T) {
+			out, err := CanonicalURL(tt.Input)
+			require.NoError(t, err)
+			require.Equal(t, tt.Output, out)
+		})
+	}
+
+	_, err := CanonicalURL("not a link")
+	require.Error(t, err)
+}
@@ -48,16 +48,43 @@ type Format struct {
 	TBR               float64           `json:"tbr"`
 	Resolution        string            `json:"resolution"`
 	AspectRatio       float64           `json:"aspect_ratio"`
+	Filesize          int64             `json:"filesize"`
 	FilesizeApprox    int64             `json:"filesize_approx"`
 	HTTPHeaders       map[string]string `json:"http_headers"`
 	Format            string            `json:"format"`
 	DownloaderOptions DownloaderOptions `json:"downloader_options"`
 }
 
+// Chapter of video, positions are in seconds.
+type Chapter struct {
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+	Title     string  `json:"title"`
+}
+
+// Start returns start position of chapter.
+func (c Chapter) Start() time.Duration {
+	return time.Duration(c.StartTime * float64(time.Second))
+}
+
+// End returns end position of chapter.
+func (c Chapter) End() time.Duration {
+	return time.Duration(c.EndTime * float64(time.Second))
+}
+
 type Video struct {
-	ID      string   `json:"id"`
-	Title   string   `json:"title"`
-	Formats []Format `json:"formats"`
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Uploader    string `json:"uploader"`
+	Description string `json:"description"`
+	// UploadDate is date in YYYYMMDD format.
+	UploadDate string    `json:"upload_date"`
+	Thumbnail  string    `json:"thumbnail"`
+	Duration   float64   `json:"duration"`
+	Chapters   []Chapter `json:"chapters"`
+	Formats    []Format  `json:"formats"`
+	// Subtitles are subtitle files by language.
+	Subtitles map[string][]Subtitle `json:"subtitles"`
 }
 
 func BestVideo(formats []Format) Format {
@@ -144,6 +171,46 @@ func FormatExactSize(ctx context.Context, format Format, httpClient *http.Client
 	return res.ContentLength, nil
 }
 
+// DownloadThumbnail saves thumbnail of video to path.
+func DownloadThumbnail(ctx context.Context, v *Video, path string, httpClient *http.Client) error {
+	if v.Thumbnail == "" {
+		return errors.New("no thumbnail")
+	}
+	return downloadFile(ctx, v.Thumbnail, path, httpClient)
+}
+
+// downloadFile downloads small file at url to path.
+func downloadFile(ctx context.Context, u, path string, httpClient *http.Client) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return errors.Wrap(err, "create request")
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "do request")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return errors.Errorf("bad status: %s: %q", res.Status, body)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "create file")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err := io.Copy(f, res.Body); err != nil {
+		return errors.Wrap(err, "copy data")
+	}
+
+	return f.Close()
+}
+
 func DownloadPart(ctx context.Context, format Format, part *ytio.Part, httpClient *http.Client) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
@@ -206,20 +273,46 @@ func DownloadPart(ctx context.Context, format Format, part *ytio.Part, httpClien
 }
 
 func DownloadChunked(ctx context.Context, format Format, file *ytio.File, httpClient *http.Client) error {
+	return DownloadRanges(ctx, format, file, nil, httpClient)
+}
+
+// DownloadRanges downloads only parts of file that overlap ranges, or the
+// whole file if there are no ranges. Other parts are left zeroed.
+func DownloadRanges(ctx context.Context, format Format, file *ytio.File, ranges []ytio.Range, httpClient *http.Client) error {
 	exactSize, err := FormatExactSize(ctx, format, httpClient)
 	if err != nil {
 		return errors.Wrap(err, "get exact size")
 	}
 
 	file.Size = exactSize
-	if err := file.Allocate(); err != nil {
-		return errors.Wrap(err, "allocate video file")
+	file.Split(format.DownloaderOptions.HTTPChunkSize)
+
+	needed := func(part *ytio.Part) bool {
+		return len(ranges) == 0 || part.Range().Overlaps(ranges)
+	}
+	for _, part := range file.Parts {
+		if needed(part) {
+			file.Progress.AddTotal(part.Size)
+		}
 	}
 
-	file.Split(format.DownloaderOptions.HTTPChunkSize)
+	// Reuse parts downloaded by previous attempt, if any.
+	restored, err := file.Restore(ranges)
+	if err != nil {
+		return errors.Wrap(err, "restore file")
+	}
+	if restored > 0 {
+		zctx.From(ctx).Info("Restored parts",
+			zap.Int("restored", restored),
+			zap.Int("total", len(file.Parts)),
+		)
+	}
 
 	parts := make(chan *ytio.Part, len(file.Parts))
 	for _, part := range file.Parts {
+		if part.IsAvailable() || !needed(part) {
+			continue
+		}
 		parts <- part
 	}
 	close(parts)
@@ -235,8 +328,11 @@ func DownloadChunked(ctx context.Context, format Format, file *ytio.File, httpCl
 						zctx.From(ctx).Error("Failed to download part", zap.Error(err))
 						return errors.Wrap(err, "download part")
 					}
+					if err := file.Commit(part); err != nil {
+						return backoff.Permanent(errors.Wrap(err, "commit part"))
+					}
 					return nil
-				}, backoff.WithMaxRetries(bo, 10)); err != nil {
+				}, backoff.WithContext(backoff.WithMaxRetries(bo, 10), gCtx)); err != nil {
 					return errors.Wrap(err, "download part with retry")
 				}
 			}
@@ -1,6 +1,7 @@
 package ytdlp
 
 import (
+	"bytes"
 	_ "embed"
 	"encoding/json"
 	"testing"
@@ -15,3 +16,13 @@ func TestVideo(t *testing.T) {
 	var video Video
 	require.NoError(t, json.Unmarshal(videoExample, &video))
 }
+
+func TestDecodeEntries(t *testing.T) {
+	entries, err := decodeEntries(bytes.NewReader(append(append(videoExample, '\n'), videoExample...)))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.NotEmpty(t, entries[1].Formats)
+
+	_, err = decodeEntries(bytes.NewReader(nil))
+	require.Error(t, err)
+}
@@ -0,0 +1,54 @@
+package ytio
+
+import (
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// Counter counts bytes of response bodies read through HTTP clients, that
+// is traffic of downloads. Safe for concurrent use.
+type Counter struct {
+	n atomic.Int64
+}
+
+// Bytes returns count of read bytes.
+func (c *Counter) Bytes() int64 {
+	return c.n.Load()
+}
+
+// Client returns copy of client that counts bytes of response bodies.
+func (c *Counter) Client(client *http.Client) *http.Client {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	counted := *client
+	counted.Transport = &countingTransport{next: next, n: &c.n}
+	return &counted
+}
+
+type countingTransport struct {
+	next http.RoundTripper
+	n    *atomic.Int64
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = &countingBody{ReadCloser: res.Body, n: t.n}
+	return res, nil
+}
+
+type countingBody struct {
+	io.ReadCloser
+	n *atomic.Int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n.Add(int64(n))
+	return n, err
+}
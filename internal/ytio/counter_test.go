@@ -0,0 +1,32 @@
+package ytio
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, strings.Repeat("x", 1000))
+	}))
+	defer srv.Close()
+
+	var (
+		c      Counter
+		client = c.Client(srv.Client())
+	)
+	for _, limit := range []int64{1000, 100} {
+		res, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		_, err = io.Copy(io.Discard, io.LimitReader(res.Body, limit))
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+	}
+	require.Equal(t, int64(1100), c.Bytes())
+	require.Nil(t, http.DefaultClient.Transport)
+}
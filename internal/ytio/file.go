@@ -1,10 +1,12 @@
 package ytio
 
 import (
+	"bufio"
 	"context"
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -32,11 +34,19 @@ func (p *Part) IsAvailable() bool {
 	return p.Available
 }
 
+// Range returns bytes of part in file.
+func (p *Part) Range() Range {
+	return Range{Offset: p.Offset, Size: p.Size}
+}
+
 // File is partially downloaded file.
 type File struct {
 	Path  string
 	Size  int64
 	Parts []*Part
+
+	// Progress is optional download progress, can be shared between files.
+	Progress *Progress
 }
 
 func (f *File) PartAt(offset int64) *Part {
@@ -124,6 +134,9 @@ func (f *File) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 // Allocate creates file on disk with specified size.
 func (f *File) Allocate() error {
+	if err := os.Remove(f.journalPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove journal")
+	}
 	file, err := os.Create(f.Path)
 	if err != nil {
 		return errors.Wrap(err, "create file")
@@ -139,3 +152,87 @@ func (f *File) Allocate() error {
 	}
 	return nil
 }
+
+func (f *File) journalPath() string {
+	return f.Path + ".parts"
+}
+
+// Restore marks parts that were committed to journal by previous attempt as
+// available, so they are not downloaded again. Progress counts only restored
+// parts that overlap ranges, or every part if there are no ranges.
+//
+// If file on disk does not match expected size, it is allocated again and
+// nothing is restored. Should be called after Split.
+func (f *File) Restore(ranges []Range) (restored int, err error) {
+	if stat, err := os.Stat(f.Path); err != nil || stat.Size() != f.Size {
+		return 0, f.Allocate()
+	}
+
+	journal, err := os.Open(f.journalPath())
+	if os.IsNotExist(err) {
+		return 0, f.Allocate()
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "open journal")
+	}
+	defer func() {
+		_ = journal.Close()
+	}()
+
+	s := bufio.NewScanner(journal)
+	for s.Scan() {
+		offset, err := strconv.ParseInt(s.Text(), 10, 64)
+		if err != nil {
+			// Probably partially written line.
+			continue
+		}
+		if p := f.PartAt(offset); p != nil && !p.IsAvailable() {
+			p.SetAvailable()
+			if len(ranges) == 0 || p.Range().Overlaps(ranges) {
+				f.Progress.Add(p.Size)
+			}
+			restored++
+		}
+	}
+	if err := s.Err(); err != nil {
+		return 0, errors.Wrap(err, "read journal")
+	}
+
+	return restored, nil
+}
+
+// Commit records part as downloaded in journal. Data of file is synced
+// first, so journal does not list parts lost by crash.
+func (f *File) Commit(p *Part) error {
+	f.Progress.Add(p.Size)
+
+	data, err := os.OpenFile(f.Path, os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "open file")
+	}
+	defer func() {
+		_ = data.Close()
+	}()
+	if err := data.Sync(); err != nil {
+		return errors.Wrap(err, "sync file")
+	}
+
+	journal, err := os.OpenFile(f.journalPath(), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "open journal")
+	}
+	defer func() {
+		_ = journal.Close()
+	}()
+	if _, err := journal.WriteString(strconv.FormatInt(p.Offset, 10) + "\n"); err != nil {
+		return errors.Wrap(err, "write journal")
+	}
+	if err := journal.Sync(); err != nil {
+		return errors.Wrap(err, "sync journal")
+	}
+	if err := journal.Close(); err != nil {
+		return errors.Wrap(err, "close journal")
+	}
+
+	return nil
+}
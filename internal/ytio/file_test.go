@@ -92,3 +92,43 @@ func TestFile(t *testing.T) {
 	finalHash := calculateHash(t, file.Path)
 	t.Logf("Final hash: %s", finalHash)
 }
+
+func TestFileRestore(t *testing.T) {
+	file := &File{
+		Path: t.TempDir() + "/test.bin",
+		Size: 10*1024 + 1,
+	}
+	file.Split(1024)
+
+	restored, err := file.Restore(nil)
+	require.NoError(t, err)
+	require.Zero(t, restored, "nothing to restore on first attempt")
+
+	require.NoError(t, file.Commit(file.Parts[0]))
+	require.NoError(t, file.Commit(file.Parts[3]))
+
+	// Next attempt.
+	file.Split(1024)
+	restored, err = file.Restore(nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, restored)
+	require.True(t, file.Parts[0].IsAvailable())
+	require.False(t, file.Parts[1].IsAvailable())
+	require.True(t, file.Parts[3].IsAvailable())
+
+	// Only restored parts in ranges are progress.
+	file.Split(1024)
+	file.Progress = new(Progress)
+	restored, err = file.Restore([]Range{{Offset: 2048, Size: 2048}})
+	require.NoError(t, err)
+	require.Equal(t, 2, restored)
+	done, _ := file.Progress.Load()
+	require.Equal(t, int64(1024), done)
+
+	// Size changed, so file should be allocated again.
+	file.Size++
+	file.Split(1024)
+	restored, err = file.Restore(nil)
+	require.NoError(t, err)
+	require.Zero(t, restored)
+}
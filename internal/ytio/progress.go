@@ -0,0 +1,44 @@
+package ytio
+
+import "sync/atomic"
+
+// Progress of transfer, safe for concurrent use.
+//
+// Nil Progress is valid and ignores updates.
+type Progress struct {
+	done  atomic.Int64
+	total atomic.Int64
+}
+
+// Add n transferred bytes.
+func (p *Progress) Add(n int64) {
+	if p == nil {
+		return
+	}
+	p.done.Add(n)
+}
+
+// AddTotal adds n bytes to expected total.
+func (p *Progress) AddTotal(n int64) {
+	if p == nil {
+		return
+	}
+	p.total.Add(n)
+}
+
+// Set transferred and total bytes.
+func (p *Progress) Set(done, total int64) {
+	if p == nil {
+		return
+	}
+	p.done.Store(done)
+	p.total.Store(total)
+}
+
+// Load returns transferred and total bytes.
+func (p *Progress) Load() (done, total int64) {
+	if p == nil {
+		return 0, 0
+	}
+	return p.done.Load(), p.total.Load()
+}
@@ -0,0 +1,56 @@
+package ytio
+
+import (
+	"io"
+	"os"
+
+	"github.com/go-faster/errors"
+)
+
+// Range of bytes in file.
+type Range struct {
+	Offset int64
+	Size   int64
+}
+
+// End returns offset right after the range.
+func (r Range) End() int64 {
+	return r.Offset + r.Size
+}
+
+// Overlaps reports whether range overlaps any of ranges.
+func (r Range) Overlaps(ranges []Range) bool {
+	for _, o := range ranges {
+		if r.Offset < o.End() && o.Offset < r.End() {
+			return true
+		}
+	}
+	return false
+}
+
+// Extract copies ranges of src file to dst file one after another.
+func Extract(src, dst string, ranges []Range) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return errors.Wrap(err, "open source")
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return errors.Wrap(err, "create destination")
+	}
+	defer func() {
+		_ = out.Close()
+	}()
+
+	for _, r := range ranges {
+		if _, err := io.Copy(out, io.NewSectionReader(in, r.Offset, r.Size)); err != nil {
+			return errors.Wrapf(err, "copy range at %d", r.Offset)
+		}
+	}
+
+	return out.Close()
+}
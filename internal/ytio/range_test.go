@@ -0,0 +1,34 @@
+package ytio
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeOverlaps(t *testing.T) {
+	ranges := []Range{{Offset: 0, Size: 10}, {Offset: 100, Size: 50}}
+	require.True(t, Range{Offset: 5, Size: 10}.Overlaps(ranges))
+	require.True(t, Range{Offset: 90, Size: 20}.Overlaps(ranges))
+	require.True(t, Range{Offset: 149, Size: 1}.Overlaps(ranges))
+	require.False(t, Range{Offset: 10, Size: 90}.Overlaps(ranges))
+	require.False(t, Range{Offset: 150, Size: 10}.Overlaps(ranges))
+	require.False(t, Range{Offset: 0, Size: 10}.Overlaps(nil))
+}
+
+func TestExtract(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	dst := filepath.Join(dir, "dst")
+	require.NoError(t, os.WriteFile(src, []byte("0123456789abcdef"), 0o600))
+
+	require.NoError(t, Extract(src, dst, []Range{
+		{Offset: 0, Size: 2},
+		{Offset: 10, Size: 3},
+	}))
+	data, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	require.Equal(t, "01abc", string(data))
+}